@@ -58,8 +58,9 @@ type DeltaFIFO[Key comparable, Object any] struct {
 	keyFunc KeyFunc[Key, Object]
 	logger  logr.Logger
 
-	in  chan deltaFIFOInput
-	out chan DeltaFIFOEvent[Key]
+	in   chan deltaFIFOInput
+	out  chan DeltaFIFOEvent[Key]
+	done chan struct{}
 }
 
 type DeltaFIFOOptions struct {
@@ -76,8 +77,9 @@ func NewDeltaFIFO[Key comparable, Object any](keyFunc KeyFunc[Key, Object], opts
 		keyFunc: keyFunc,
 		logger:  logger,
 
-		in:  make(chan deltaFIFOInput),
-		out: make(chan DeltaFIFOEvent[Key]),
+		in:   make(chan deltaFIFOInput),
+		out:  make(chan DeltaFIFOEvent[Key]),
+		done: make(chan struct{}),
 	}
 }
 
@@ -108,6 +110,8 @@ func (f *DeltaFIFO[Key, Object]) submitInput(ctx context.Context, input deltaFIF
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
+	case <-f.done:
+		return ErrFIFOClosed
 	case f.in <- input:
 		return nil
 	}
@@ -172,15 +176,6 @@ func (f *deltaFIFOProcessor[Key, Object]) submitDelta(delta Delta[Object]) bool
 func (f *deltaFIFOProcessor[Key, Object]) loop() {
 	defer close(f.events)
 
-	defer func() {
-		// Drain remaining inputs
-		for in := range f.inputs {
-			if p, ok := in.(deltaFIFOInputPop[Key, Object]); ok {
-				close(p.resp)
-			}
-		}
-	}()
-
 	var populated bool
 	for {
 		select {
@@ -294,7 +289,8 @@ func (f *DeltaFIFO[Key, Object]) Run(ctx context.Context) error {
 
 	f.lock.Lock()
 	f.state = deltaFIFOStateStopping
-	close(f.in)
+	// Senders may still be submitting inputs, so signal them via done instead of closing the inputs.
+	close(f.done)
 	f.lock.Unlock()
 
 	wg.Wait()
@@ -13,3 +13,17 @@ type ListerWatcher[Object any] interface {
 	List(ctx context.Context) ([]Object, error)
 	Watch(context.Context) (watch.Watch[Object], error)
 }
+
+// ListWatch implements ListerWatcher using the provided functions.
+type ListWatch[Object any] struct {
+	ListFunc  func(ctx context.Context) ([]Object, error)
+	WatchFunc func(ctx context.Context) (watch.Watch[Object], error)
+}
+
+func (lw *ListWatch[Object]) List(ctx context.Context) ([]Object, error) {
+	return lw.ListFunc(ctx)
+}
+
+func (lw *ListWatch[Object]) Watch(ctx context.Context) (watch.Watch[Object], error) {
+	return lw.WatchFunc(ctx)
+}
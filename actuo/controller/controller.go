@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/go-logr/logr"
 	"k8s.io/client-go/util/workqueue"
 	"spheric.cloud/spheric/actuo/reconcile"
 	"spheric.cloud/spheric/actuo/source"
@@ -35,7 +36,19 @@ type controller[Request comparable] struct {
 	startWatches []source.Source[Request]
 }
 
-func New[Request comparable](name string, reconciler reconcile.Reconciler[Request]) (Controller[Request], error) {
+type Options struct {
+	// MaxConcurrentReconciles is the maximum number of concurrent reconciles that can be run.
+	// Defaults to 1.
+	MaxConcurrentReconciles int
+}
+
+func (o *Options) defaults() {
+	if o.MaxConcurrentReconciles <= 0 {
+		o.MaxConcurrentReconciles = 1
+	}
+}
+
+func New[Request comparable](name string, reconciler reconcile.Reconciler[Request], opts Options) (Controller[Request], error) {
 	if name == "" {
 		return nil, fmt.Errorf("must specify name")
 	}
@@ -43,6 +56,8 @@ func New[Request comparable](name string, reconciler reconcile.Reconciler[Reques
 		return nil, fmt.Errorf("must specify reconciler")
 	}
 
+	opts.defaults()
+
 	rateLimiter := workqueue.DefaultTypedControllerRateLimiter[Request]()
 	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
 		rateLimiter,
@@ -52,9 +67,10 @@ func New[Request comparable](name string, reconciler reconcile.Reconciler[Reques
 	)
 
 	return &controller[Request]{
-		name:       name,
-		reconciler: reconciler,
-		queue:      queue,
+		name:                    name,
+		reconciler:              reconciler,
+		queue:                   queue,
+		maxConcurrentReconciles: opts.MaxConcurrentReconciles,
 	}, nil
 }
 
@@ -62,6 +78,7 @@ func (c *controller[Request]) reconcileHandler(ctx context.Context, req Request)
 	res, err := c.reconciler.Reconcile(ctx, req)
 	switch {
 	case err != nil:
+		logr.FromContextOrDiscard(ctx).Error(err, "Reconciler error", "Controller", c.name, "Request", req)
 		c.queue.AddRateLimited(req)
 	case res.RequeueAfter > 0:
 		c.queue.Forget(req)
@@ -149,6 +166,7 @@ func (c *controller[Request]) Start(ctx context.Context) error {
 	}
 
 	<-ctx.Done()
+	c.queue.ShutDown()
 	wg.Wait()
 	return nil
 }
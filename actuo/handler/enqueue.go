@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+	"spheric.cloud/spheric/actuo/event"
+)
+
+type enqueueRequestForObject[Object any, Request comparable] struct {
+	toRequest func(Object) Request
+}
+
+// EnqueueRequestForObject returns an EventHandler that enqueues the Request
+// returned by toRequest for the object of every event.
+func EnqueueRequestForObject[Object any, Request comparable](toRequest func(Object) Request) EventHandler[Object, Request] {
+	return enqueueRequestForObject[Object, Request]{toRequest: toRequest}
+}
+
+func (e enqueueRequestForObject[Object, Request]) Create(_ context.Context, evt event.CreateEvent[Object], q workqueue.TypedRateLimitingInterface[Request]) {
+	q.Add(e.toRequest(evt.Object))
+}
+
+func (e enqueueRequestForObject[Object, Request]) Update(_ context.Context, evt event.UpdateEvent[Object], q workqueue.TypedRateLimitingInterface[Request]) {
+	q.Add(e.toRequest(evt.ObjectNew))
+}
+
+func (e enqueueRequestForObject[Object, Request]) Delete(_ context.Context, evt event.DeleteEvent[Object], q workqueue.TypedRateLimitingInterface[Request]) {
+	q.Add(e.toRequest(evt.Object))
+}
+
+func (e enqueueRequestForObject[Object, Request]) Generic(_ context.Context, evt event.GenericEvent[Object], q workqueue.TypedRateLimitingInterface[Request]) {
+	q.Add(e.toRequest(evt.Object))
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+type channel[Request comparable] struct {
+	source  <-chan Request
+	started chan error
+}
+
+// NewChannel returns a Source that enqueues every Request received from the given channel.
+func NewChannel[Request comparable](source <-chan Request) Source[Request] {
+	return &channel[Request]{
+		source:  source,
+		started: make(chan error),
+	}
+}
+
+func (s *channel[Request]) Start(ctx context.Context, q workqueue.TypedRateLimitingInterface[Request]) error {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req, ok := <-s.source:
+				if !ok {
+					return
+				}
+				q.Add(req)
+			}
+		}
+	}()
+	close(s.started)
+	return nil
+}
+
+func (s *channel[Request]) Started() <-chan error {
+	return s.started
+}
@@ -17,6 +17,14 @@ type DiskSpec struct {
 	InstanceRef *LocalUIDReference `json:"instanceRef,omitempty"`
 	// Resources is a description of the Disk's resources and capacity.
 	Resources ResourceList `json:"resources,omitempty"`
+	// DataSource is the source to populate the Disk with on creation.
+	DataSource *DiskDataSource `json:"dataSource,omitempty"`
+}
+
+// DiskDataSource specifies the source to populate a Disk with.
+type DiskDataSource struct {
+	// SnapshotRef references the DiskSnapshot to restore the Disk from.
+	SnapshotRef *LocalObjectReference `json:"snapshotRef,omitempty"`
 }
 
 // DiskStatus defines the observed state of Disk
@@ -6,6 +6,8 @@
 package v1alpha1
 
 import (
+	"slices"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -29,6 +31,52 @@ type FleetStatus struct {
 	Capacity ResourceList `json:"capacity,omitempty"`
 	// Allocatable represents the resources of a fleet that are available for scheduling.
 	Allocatable ResourceList `json:"allocatable,omitempty"`
+	// RuntimeInfo describes the instance runtime of the fleet.
+	RuntimeInfo *FleetRuntimeInfo `json:"runtimeInfo,omitempty"`
+}
+
+// FleetRuntimeInfo describes the instance runtime of a Fleet.
+type FleetRuntimeInfo struct {
+	// RuntimeName is the name of the instance runtime.
+	RuntimeName string `json:"runtimeName,omitempty"`
+	// RuntimeVersion is the version of the instance runtime.
+	RuntimeVersion string `json:"runtimeVersion,omitempty"`
+	// Capabilities are the optional features supported by the instance runtime.
+	Capabilities []FleetCapability `json:"capabilities,omitempty"`
+}
+
+// FleetCapability is an optional feature an instance runtime may support.
+type FleetCapability string
+
+const (
+	// FleetCapabilityHotplugDisks indicates disks can be attached to and detached from running instances.
+	FleetCapabilityHotplugDisks FleetCapability = "HotplugDisks"
+	// FleetCapabilityHotplugNetworkInterfaces indicates network interfaces can be attached to and detached
+	// from running instances.
+	FleetCapabilityHotplugNetworkInterfaces FleetCapability = "HotplugNetworkInterfaces"
+	// FleetCapabilityWatchInstances indicates instance changes are streamed instead of polled.
+	FleetCapabilityWatchInstances FleetCapability = "WatchInstances"
+	// FleetCapabilityInstanceStats indicates instance statistics are available.
+	FleetCapabilityInstanceStats FleetCapability = "InstanceStats"
+	// FleetCapabilityExec indicates instance consoles can be streamed.
+	FleetCapabilityExec FleetCapability = "Exec"
+	// FleetCapabilityReboot indicates instances can be restarted.
+	FleetCapabilityReboot FleetCapability = "Reboot"
+	// FleetCapabilitySuspend indicates instances can be suspended.
+	FleetCapabilitySuspend FleetCapability = "Suspend"
+	// FleetCapabilityImages indicates images can be pulled ahead of time and are garbage collected.
+	FleetCapabilityImages FleetCapability = "Images"
+	// FleetCapabilityPortForward indicates instance ports can be forwarded.
+	FleetCapabilityPortForward FleetCapability = "PortForward"
+	// FleetCapabilityConsoleLog indicates the serial console logs of instances can be read.
+	FleetCapabilityConsoleLog FleetCapability = "ConsoleLog"
+	// FleetCapabilityResize indicates the resources of existing instances can be updated.
+	FleetCapabilityResize FleetCapability = "Resize"
+)
+
+// HasCapability reports whether the runtime supports the given capability.
+func (i *FleetRuntimeInfo) HasCapability(capability FleetCapability) bool {
+	return i != nil && slices.Contains(i.Capabilities, capability)
 }
 
 // FleetDaemonEndpoints lists ports opened by daemons running on the Fleet.
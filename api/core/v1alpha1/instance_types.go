@@ -49,6 +49,12 @@ type InstanceSpec struct {
 	// Tolerations define tolerations the Instance has. Only fleets whose taints
 	// covered by Tolerations will be considered to run the Instance.
 	Tolerations []Toleration `json:"tolerations,omitempty"`
+	// RestartGeneration is increased to request a restart of the instance.
+	// The instance is restarted once per increase while it is powered on.
+	RestartGeneration int64 `json:"restartGeneration,omitempty"`
+	// RestartType is the kind of restart performed when RestartGeneration is increased.
+	// Defaults to RestartGraceful.
+	RestartType RestartType `json:"restartType,omitempty"`
 }
 
 // Power is the desired power state of a Instance.
@@ -59,6 +65,18 @@ const (
 	PowerOn Power = "On"
 	// PowerOff indicates that a Instance should be powered off.
 	PowerOff Power = "Off"
+	// PowerSuspended indicates that a running Instance should be paused, keeping its memory state.
+	PowerSuspended Power = "Suspended"
+)
+
+// RestartType is the kind of restart performed on an Instance.
+type RestartType string
+
+const (
+	// RestartGraceful restarts the Instance by asking its operating system to reboot.
+	RestartGraceful RestartType = "Graceful"
+	// RestartHard resets the Instance without involving its operating system.
+	RestartHard RestartType = "Hard"
 )
 
 // EFIVar is a variable to pass to EFI while booting up.
@@ -186,12 +204,47 @@ type InstanceStatus struct {
 	InstanceID string `json:"instanceID,omitempty"`
 	// ObservedGeneration is the last generation the Fleet observed of the Instance.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ObservedRestartGeneration is the last RestartGeneration the Fleet carried out for the Instance.
+	ObservedRestartGeneration int64 `json:"observedRestartGeneration,omitempty"`
 	// State is the infrastructure state of the instance.
 	State InstanceState `json:"state,omitempty"`
+	// InstanceTypeRef references the instance type whose resources are currently applied to the instance.
+	// It differs from the instance type of the spec while the instance is not resized yet.
+	InstanceTypeRef *LocalObjectReference `json:"instanceTypeRef,omitempty"`
 	// NetworkInterfaces is the list of network interface states for the instance.
 	NetworkInterfaces []NetworkInterfaceStatus `json:"networkInterfaces,omitempty"`
 	// Disks is the list of disk states for the instance.
 	Disks []AttachedDiskStatus `json:"disks,omitempty"`
+	// Conditions are the conditions of the instance.
+	Conditions []InstanceCondition `json:"conditions,omitempty"`
+}
+
+// InstanceConditionType is a type an InstanceCondition can have.
+type InstanceConditionType string
+
+const (
+	// InstanceOfflineResizeRequired is True if the resources of the instance type cannot be applied to the
+	// running instance, so the instance has to be powered off to be resized.
+	InstanceOfflineResizeRequired InstanceConditionType = "OfflineResizeRequired"
+	// InstanceRescheduleRequired is True if the fleet of the instance has no capacity left for its instance type,
+	// so the instance has to be moved to another fleet to be resized.
+	InstanceRescheduleRequired InstanceConditionType = "RescheduleRequired"
+)
+
+// InstanceCondition is one of the conditions of an instance.
+type InstanceCondition struct {
+	// Type is the type of the condition.
+	Type InstanceConditionType `json:"type"`
+	// Status is the status of the condition.
+	Status corev1.ConditionStatus `json:"status"`
+	// Reason is a machine-readable indication of why the condition is in a certain state.
+	Reason string `json:"reason"`
+	// Message is a human-readable explanation of why the condition has a certain reason / state.
+	Message string `json:"message"`
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the last time the status of a condition has transitioned from one state to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // InstanceState is the state of a instance.
@@ -207,6 +260,8 @@ const (
 	InstanceStateRunning InstanceState = "Running"
 	// InstanceStateShutdown means the instance is shut down.
 	InstanceStateShutdown InstanceState = "Shutdown"
+	// InstanceStateSuspended means the instance is paused, keeping its memory state.
+	InstanceStateSuspended InstanceState = "Suspended"
 	// InstanceStateTerminated means the instance has been permanently stopped and cannot be started.
 	InstanceStateTerminated InstanceState = "Terminated"
 )
@@ -240,3 +295,39 @@ type InstanceExecOptions struct {
 	metav1.TypeMeta              `json:",inline"`
 	InsecureSkipTLSVerifyBackend bool `json:"insecureSkipTLSVerifyBackend,omitempty"`
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:conversion-gen:explicit-from=net/url.Values
+
+// InstanceLogOptions is the query options to a Instance's log call
+type InstanceLogOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Follow the serial console log stream of the instance.
+	Follow bool `json:"follow,omitempty"`
+	// TailLines is the number of lines from the end of the log to show. If not specified,
+	// the whole log is shown.
+	TailLines *int64 `json:"tailLines,omitempty"`
+	// SinceSeconds is a relative time in seconds before the current time from which to show logs.
+	SinceSeconds                 *int64 `json:"sinceSeconds,omitempty"`
+	InsecureSkipTLSVerifyBackend bool   `json:"insecureSkipTLSVerifyBackend,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:conversion-gen:explicit-from=net/url.Values
+
+// InstancePortForwardOptions is the query options to a Instance's port forward call
+type InstancePortForwardOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Ports are the ports of the instance to forward.
+	Ports                        []int32 `json:"ports,omitempty"`
+	InsecureSkipTLSVerifyBackend bool    `json:"insecureSkipTLSVerifyBackend,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:conversion-gen:explicit-from=net/url.Values
+
+// InstanceStatsOptions is the query options to a Instance's stats call
+type InstanceStatsOptions struct {
+	metav1.TypeMeta              `json:",inline"`
+	InsecureSkipTLSVerifyBackend bool `json:"insecureSkipTLSVerifyBackend,omitempty"`
+}
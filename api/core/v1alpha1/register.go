@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Disk{},
 		&DiskList{},
+		&DiskSnapshot{},
+		&DiskSnapshotList{},
 		&DiskType{},
 		&DiskTypeList{},
 		&Fleet{},
@@ -48,6 +50,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Instance{},
 		&InstanceList{},
 		&InstanceExecOptions{},
+		&InstanceLogOptions{},
+		&InstancePortForwardOptions{},
+		&InstanceStatsOptions{},
 		&InstanceType{},
 		&InstanceTypeList{},
 		&Network{},
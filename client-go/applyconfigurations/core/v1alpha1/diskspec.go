@@ -15,6 +15,7 @@ type DiskSpecApplyConfiguration struct {
 	TypeRef     *LocalObjectReferenceApplyConfiguration `json:"typeRef,omitempty"`
 	InstanceRef *LocalUIDReferenceApplyConfiguration    `json:"instanceRef,omitempty"`
 	Resources   *corev1alpha1.ResourceList              `json:"resources,omitempty"`
+	DataSource  *DiskDataSourceApplyConfiguration       `json:"dataSource,omitempty"`
 }
 
 // DiskSpecApplyConfiguration constructs a declarative configuration of the DiskSpec type for use with
@@ -46,3 +47,11 @@ func (b *DiskSpecApplyConfiguration) WithResources(value corev1alpha1.ResourceLi
 	b.Resources = &value
 	return b
 }
+
+// WithDataSource sets the DataSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DataSource field is set to the value of the last call.
+func (b *DiskSpecApplyConfiguration) WithDataSource(value *DiskDataSourceApplyConfiguration) *DiskSpecApplyConfiguration {
+	b.DataSource = value
+	return b
+}
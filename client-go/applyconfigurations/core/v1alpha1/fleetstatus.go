@@ -18,6 +18,7 @@ type FleetStatusApplyConfiguration struct {
 	DaemonEndpoints *FleetDaemonEndpointsApplyConfiguration `json:"daemonEndpoints,omitempty"`
 	Capacity        *v1alpha1.ResourceList                  `json:"capacity,omitempty"`
 	Allocatable     *v1alpha1.ResourceList                  `json:"allocatable,omitempty"`
+	RuntimeInfo     *FleetRuntimeInfoApplyConfiguration     `json:"runtimeInfo,omitempty"`
 }
 
 // FleetStatusApplyConfiguration constructs a declarative configuration of the FleetStatus type for use with
@@ -83,3 +84,11 @@ func (b *FleetStatusApplyConfiguration) WithAllocatable(value v1alpha1.ResourceL
 	b.Allocatable = &value
 	return b
 }
+
+// WithRuntimeInfo sets the RuntimeInfo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeInfo field is set to the value of the last call.
+func (b *FleetStatusApplyConfiguration) WithRuntimeInfo(value *FleetRuntimeInfoApplyConfiguration) *FleetStatusApplyConfiguration {
+	b.RuntimeInfo = value
+	return b
+}
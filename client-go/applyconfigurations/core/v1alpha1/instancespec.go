@@ -23,6 +23,8 @@ type InstanceSpecApplyConfiguration struct {
 	IgnitionRef        *SecretKeySelectorApplyConfiguration    `json:"ignitionRef,omitempty"`
 	EFIVars            []EFIVarApplyConfiguration              `json:"efiVars,omitempty"`
 	Tolerations        []TolerationApplyConfiguration          `json:"tolerations,omitempty"`
+	RestartGeneration  *int64                                  `json:"restartGeneration,omitempty"`
+	RestartType        *corev1alpha1.RestartType               `json:"restartType,omitempty"`
 }
 
 // InstanceSpecApplyConfiguration constructs a declarative configuration of the InstanceSpec type for use with
@@ -144,3 +146,19 @@ func (b *InstanceSpecApplyConfiguration) WithTolerations(values ...*TolerationAp
 	}
 	return b
 }
+
+// WithRestartGeneration sets the RestartGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartGeneration field is set to the value of the last call.
+func (b *InstanceSpecApplyConfiguration) WithRestartGeneration(value int64) *InstanceSpecApplyConfiguration {
+	b.RestartGeneration = &value
+	return b
+}
+
+// WithRestartType sets the RestartType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartType field is set to the value of the last call.
+func (b *InstanceSpecApplyConfiguration) WithRestartType(value corev1alpha1.RestartType) *InstanceSpecApplyConfiguration {
+	b.RestartType = &value
+	return b
+}
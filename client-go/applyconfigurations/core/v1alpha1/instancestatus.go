@@ -12,11 +12,14 @@ import (
 // InstanceStatusApplyConfiguration represents a declarative configuration of the InstanceStatus type for use
 // with apply.
 type InstanceStatusApplyConfiguration struct {
-	InstanceID         *string                                    `json:"instanceID,omitempty"`
-	ObservedGeneration *int64                                     `json:"observedGeneration,omitempty"`
-	State              *v1alpha1.InstanceState                    `json:"state,omitempty"`
-	NetworkInterfaces  []NetworkInterfaceStatusApplyConfiguration `json:"networkInterfaces,omitempty"`
-	Disks              []AttachedDiskStatusApplyConfiguration     `json:"disks,omitempty"`
+	InstanceID                *string                                    `json:"instanceID,omitempty"`
+	ObservedGeneration        *int64                                     `json:"observedGeneration,omitempty"`
+	ObservedRestartGeneration *int64                                     `json:"observedRestartGeneration,omitempty"`
+	State                     *v1alpha1.InstanceState                    `json:"state,omitempty"`
+	InstanceTypeRef           *LocalObjectReferenceApplyConfiguration    `json:"instanceTypeRef,omitempty"`
+	NetworkInterfaces         []NetworkInterfaceStatusApplyConfiguration `json:"networkInterfaces,omitempty"`
+	Disks                     []AttachedDiskStatusApplyConfiguration     `json:"disks,omitempty"`
+	Conditions                []InstanceConditionApplyConfiguration      `json:"conditions,omitempty"`
 }
 
 // InstanceStatusApplyConfiguration constructs a declarative configuration of the InstanceStatus type for use with
@@ -41,6 +44,14 @@ func (b *InstanceStatusApplyConfiguration) WithObservedGeneration(value int64) *
 	return b
 }
 
+// WithObservedRestartGeneration sets the ObservedRestartGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedRestartGeneration field is set to the value of the last call.
+func (b *InstanceStatusApplyConfiguration) WithObservedRestartGeneration(value int64) *InstanceStatusApplyConfiguration {
+	b.ObservedRestartGeneration = &value
+	return b
+}
+
 // WithState sets the State field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the State field is set to the value of the last call.
@@ -49,6 +60,14 @@ func (b *InstanceStatusApplyConfiguration) WithState(value v1alpha1.InstanceStat
 	return b
 }
 
+// WithInstanceTypeRef sets the InstanceTypeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InstanceTypeRef field is set to the value of the last call.
+func (b *InstanceStatusApplyConfiguration) WithInstanceTypeRef(value *LocalObjectReferenceApplyConfiguration) *InstanceStatusApplyConfiguration {
+	b.InstanceTypeRef = value
+	return b
+}
+
 // WithNetworkInterfaces adds the given value to the NetworkInterfaces field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the NetworkInterfaces field.
@@ -74,3 +93,16 @@ func (b *InstanceStatusApplyConfiguration) WithDisks(values ...*AttachedDiskStat
 	}
 	return b
 }
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *InstanceStatusApplyConfiguration) WithConditions(values ...*InstanceConditionApplyConfiguration) *InstanceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -105,9 +105,67 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: secretRef
       type:
         namedType: cloud.spheric.spheric.api.core.v1alpha1.LocalObjectReference
+- name: cloud.spheric.spheric.api.core.v1alpha1.DiskDataSource
+  map:
+    fields:
+    - name: snapshotRef
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.LocalObjectReference
+- name: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshot
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotSpec
+      default: {}
+    - name: status
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotStatus
+      default: {}
+- name: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotCondition
+  map:
+    fields:
+    - name: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotSpec
+  map:
+    fields:
+    - name: diskRef
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.LocalObjectReference
+      default: {}
+- name: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: cloud.spheric.spheric.api.core.v1alpha1.DiskSnapshotCondition
+          elementRelationship: atomic
+    - name: creationTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: readyToUse
+      type:
+        scalar: boolean
+    - name: size
+      type:
+        namedType: io.k8s.apimachinery.pkg.api.resource.Quantity
 - name: cloud.spheric.spheric.api.core.v1alpha1.DiskSpec
   map:
     fields:
+    - name: dataSource
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.DiskDataSource
     - name: instanceRef
       type:
         namedType: cloud.spheric.spheric.api.core.v1alpha1.LocalUIDReference
@@ -247,6 +305,21 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: cloud.spheric.spheric.api.core.v1alpha1.DaemonEndpoint
       default: {}
+- name: cloud.spheric.spheric.api.core.v1alpha1.FleetRuntimeInfo
+  map:
+    fields:
+    - name: capabilities
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: runtimeName
+      type:
+        scalar: string
+    - name: runtimeVersion
+      type:
+        scalar: string
 - name: cloud.spheric.spheric.api.core.v1alpha1.FleetSpec
   map:
     fields:
@@ -289,6 +362,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: cloud.spheric.spheric.api.core.v1alpha1.FleetDaemonEndpoints
       default: {}
+    - name: runtimeInfo
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.FleetRuntimeInfo
     - name: state
       type:
         scalar: string
@@ -313,6 +389,31 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: cloud.spheric.spheric.api.core.v1alpha1.InstanceStatus
       default: {}
+- name: cloud.spheric.spheric.api.core.v1alpha1.InstanceCondition
+  map:
+    fields:
+    - name: lastTransitionTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: message
+      type:
+        scalar: string
+      default: ""
+    - name: observedGeneration
+      type:
+        scalar: numeric
+    - name: reason
+      type:
+        scalar: string
+      default: ""
+    - name: status
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
+      default: ""
 - name: cloud.spheric.spheric.api.core.v1alpha1.InstanceSpec
   map:
     fields:
@@ -364,6 +465,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: power
       type:
         scalar: string
+    - name: restartGeneration
+      type:
+        scalar: numeric
+    - name: restartType
+      type:
+        scalar: string
     - name: tolerations
       type:
         list:
@@ -373,6 +480,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: cloud.spheric.spheric.api.core.v1alpha1.InstanceStatus
   map:
     fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: cloud.spheric.spheric.api.core.v1alpha1.InstanceCondition
+          elementRelationship: atomic
     - name: disks
       type:
         list:
@@ -382,6 +495,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: instanceID
       type:
         scalar: string
+    - name: instanceTypeRef
+      type:
+        namedType: cloud.spheric.spheric.api.core.v1alpha1.LocalObjectReference
     - name: networkInterfaces
       type:
         list:
@@ -391,6 +507,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: observedGeneration
       type:
         scalar: numeric
+    - name: observedRestartGeneration
+      type:
+        scalar: numeric
     - name: state
       type:
         scalar: string
@@ -31,6 +31,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.DiskApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DiskAccess"):
 		return &corev1alpha1.DiskAccessApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskDataSource"):
+		return &corev1alpha1.DiskDataSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskSnapshot"):
+		return &corev1alpha1.DiskSnapshotApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskSnapshotCondition"):
+		return &corev1alpha1.DiskSnapshotConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskSnapshotSpec"):
+		return &corev1alpha1.DiskSnapshotSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DiskSnapshotStatus"):
+		return &corev1alpha1.DiskSnapshotStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DiskSpec"):
 		return &corev1alpha1.DiskSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DiskStatus"):
@@ -53,12 +63,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &corev1alpha1.FleetConditionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FleetDaemonEndpoints"):
 		return &corev1alpha1.FleetDaemonEndpointsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FleetRuntimeInfo"):
+		return &corev1alpha1.FleetRuntimeInfoApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FleetSpec"):
 		return &corev1alpha1.FleetSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("FleetStatus"):
 		return &corev1alpha1.FleetStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Instance"):
 		return &corev1alpha1.InstanceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("InstanceCondition"):
+		return &corev1alpha1.InstanceConditionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InstanceSpec"):
 		return &corev1alpha1.InstanceSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InstanceStatus"):
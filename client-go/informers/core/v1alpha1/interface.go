@@ -13,6 +13,8 @@ import (
 type Interface interface {
 	// Disks returns a DiskInformer.
 	Disks() DiskInformer
+	// DiskSnapshots returns a DiskSnapshotInformer.
+	DiskSnapshots() DiskSnapshotInformer
 	// DiskTypes returns a DiskTypeInformer.
 	DiskTypes() DiskTypeInformer
 	// Fleets returns a FleetInformer.
@@ -43,6 +45,11 @@ func (v *version) Disks() DiskInformer {
 	return &diskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DiskSnapshots returns a DiskSnapshotInformer.
+func (v *version) DiskSnapshots() DiskSnapshotInformer {
+	return &diskSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DiskTypes returns a DiskTypeInformer.
 func (v *version) DiskTypes() DiskTypeInformer {
 	return &diskTypeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -42,6 +42,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=core.spheric.cloud, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("disks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Disks().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("disksnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().DiskSnapshots().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("disktypes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().DiskTypes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("fleets"):
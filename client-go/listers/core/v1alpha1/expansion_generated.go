@@ -13,6 +13,14 @@ type DiskListerExpansion interface{}
 // DiskNamespaceLister.
 type DiskNamespaceListerExpansion interface{}
 
+// DiskSnapshotListerExpansion allows custom methods to be added to
+// DiskSnapshotLister.
+type DiskSnapshotListerExpansion interface{}
+
+// DiskSnapshotNamespaceListerExpansion allows custom methods to be added to
+// DiskSnapshotNamespaceLister.
+type DiskSnapshotNamespaceListerExpansion interface{}
+
 // DiskTypeListerExpansion allows custom methods to be added to
 // DiskTypeLister.
 type DiskTypeListerExpansion interface{}
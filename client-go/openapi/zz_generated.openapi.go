@@ -17,109 +17,120 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                  schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":               schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                  schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":              schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":               schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":           schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":               schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":              schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                 schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":             schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":             schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                  schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":  schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                  schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                 schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":             schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":              schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":             schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":             schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                      schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                  schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":               schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                 schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":            schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":     schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList": schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                     schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":              schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":             schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                 schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR": schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                    schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":               schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":             schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                     schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":     schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":              schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                  schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":         schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                      schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                 schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                  schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":             schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                           schema_k8sio_apimachinery_pkg_version_Info(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDisk":           schema_spheric_api_core_v1alpha1_AttachedDisk(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskSource":     schema_spheric_api_core_v1alpha1_AttachedDiskSource(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskStatus":     schema_spheric_api_core_v1alpha1_AttachedDiskStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.ConfigMapKeySelector":   schema_spheric_api_core_v1alpha1_ConfigMapKeySelector(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DaemonEndpoint":         schema_spheric_api_core_v1alpha1_DaemonEndpoint(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Disk":                   schema_spheric_api_core_v1alpha1_Disk(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskAccess":             schema_spheric_api_core_v1alpha1_DiskAccess(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskList":               schema_spheric_api_core_v1alpha1_DiskList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskSpec":               schema_spheric_api_core_v1alpha1_DiskSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskStatus":             schema_spheric_api_core_v1alpha1_DiskStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskTemplateSpec":       schema_spheric_api_core_v1alpha1_DiskTemplateSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskType":               schema_spheric_api_core_v1alpha1_DiskType(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.DiskTypeList":           schema_spheric_api_core_v1alpha1_DiskTypeList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.EFIVar":                 schema_spheric_api_core_v1alpha1_EFIVar(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.EmptyDiskSource":        schema_spheric_api_core_v1alpha1_EmptyDiskSource(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.EphemeralDiskSource":    schema_spheric_api_core_v1alpha1_EphemeralDiskSource(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Fleet":                  schema_spheric_api_core_v1alpha1_Fleet(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetAddress":           schema_spheric_api_core_v1alpha1_FleetAddress(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetCondition":         schema_spheric_api_core_v1alpha1_FleetCondition(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetDaemonEndpoints":   schema_spheric_api_core_v1alpha1_FleetDaemonEndpoints(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetList":              schema_spheric_api_core_v1alpha1_FleetList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetSpec":              schema_spheric_api_core_v1alpha1_FleetSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.FleetStatus":            schema_spheric_api_core_v1alpha1_FleetStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Instance":               schema_spheric_api_core_v1alpha1_Instance(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceExecOptions":    schema_spheric_api_core_v1alpha1_InstanceExecOptions(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceList":           schema_spheric_api_core_v1alpha1_InstanceList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceSpec":           schema_spheric_api_core_v1alpha1_InstanceSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceStatus":         schema_spheric_api_core_v1alpha1_InstanceStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceType":           schema_spheric_api_core_v1alpha1_InstanceType(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.InstanceTypeList":       schema_spheric_api_core_v1alpha1_InstanceTypeList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference":   schema_spheric_api_core_v1alpha1_LocalObjectReference(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.LocalUIDReference":      schema_spheric_api_core_v1alpha1_LocalUIDReference(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Network":                schema_spheric_api_core_v1alpha1_Network(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.NetworkInterface":       schema_spheric_api_core_v1alpha1_NetworkInterface(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.NetworkInterfaceStatus": schema_spheric_api_core_v1alpha1_NetworkInterfaceStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.NetworkList":            schema_spheric_api_core_v1alpha1_NetworkList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.NetworkSpec":            schema_spheric_api_core_v1alpha1_NetworkSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.NetworkStatus":          schema_spheric_api_core_v1alpha1_NetworkStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.ObjectSelector":         schema_spheric_api_core_v1alpha1_ObjectSelector(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.SecretKeySelector":      schema_spheric_api_core_v1alpha1_SecretKeySelector(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Subnet":                 schema_spheric_api_core_v1alpha1_Subnet(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.SubnetList":             schema_spheric_api_core_v1alpha1_SubnetList(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.SubnetReference":        schema_spheric_api_core_v1alpha1_SubnetReference(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.SubnetSpec":             schema_spheric_api_core_v1alpha1_SubnetSpec(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.SubnetStatus":           schema_spheric_api_core_v1alpha1_SubnetStatus(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Taint":                  schema_spheric_api_core_v1alpha1_Taint(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.Toleration":             schema_spheric_api_core_v1alpha1_Toleration(ref),
-		"spheric.cloud/spheric/api/core/v1alpha1.UIDReference":           schema_spheric_api_core_v1alpha1_UIDReference(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                      schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                      schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                  schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                   schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":               schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                   schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                  schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                     schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                 schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                      schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldSelectorRequirement":      schema_pkg_apis_meta_v1_FieldSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                      schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                    schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                     schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                 schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                  schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                 schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                 schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                          schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                      schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                   schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                     schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":         schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":     schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                         schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                  schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                 schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                     schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                        schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                   schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                 schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                         schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":         schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                  schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                      schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":             schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                          schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                     schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                      schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                    schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                               schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDisk":               schema_spheric_api_core_v1alpha1_AttachedDisk(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskSource":         schema_spheric_api_core_v1alpha1_AttachedDiskSource(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskStatus":         schema_spheric_api_core_v1alpha1_AttachedDiskStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.ConfigMapKeySelector":       schema_spheric_api_core_v1alpha1_ConfigMapKeySelector(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DaemonEndpoint":             schema_spheric_api_core_v1alpha1_DaemonEndpoint(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Disk":                       schema_spheric_api_core_v1alpha1_Disk(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskAccess":                 schema_spheric_api_core_v1alpha1_DiskAccess(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskDataSource":             schema_spheric_api_core_v1alpha1_DiskDataSource(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskList":                   schema_spheric_api_core_v1alpha1_DiskList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshot":               schema_spheric_api_core_v1alpha1_DiskSnapshot(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotCondition":      schema_spheric_api_core_v1alpha1_DiskSnapshotCondition(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotList":           schema_spheric_api_core_v1alpha1_DiskSnapshotList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotSpec":           schema_spheric_api_core_v1alpha1_DiskSnapshotSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotStatus":         schema_spheric_api_core_v1alpha1_DiskSnapshotStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskSpec":                   schema_spheric_api_core_v1alpha1_DiskSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskStatus":                 schema_spheric_api_core_v1alpha1_DiskStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskTemplateSpec":           schema_spheric_api_core_v1alpha1_DiskTemplateSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskType":                   schema_spheric_api_core_v1alpha1_DiskType(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.DiskTypeList":               schema_spheric_api_core_v1alpha1_DiskTypeList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.EFIVar":                     schema_spheric_api_core_v1alpha1_EFIVar(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.EmptyDiskSource":            schema_spheric_api_core_v1alpha1_EmptyDiskSource(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.EphemeralDiskSource":        schema_spheric_api_core_v1alpha1_EphemeralDiskSource(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Fleet":                      schema_spheric_api_core_v1alpha1_Fleet(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetAddress":               schema_spheric_api_core_v1alpha1_FleetAddress(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetCondition":             schema_spheric_api_core_v1alpha1_FleetCondition(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetDaemonEndpoints":       schema_spheric_api_core_v1alpha1_FleetDaemonEndpoints(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetList":                  schema_spheric_api_core_v1alpha1_FleetList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetRuntimeInfo":           schema_spheric_api_core_v1alpha1_FleetRuntimeInfo(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetSpec":                  schema_spheric_api_core_v1alpha1_FleetSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.FleetStatus":                schema_spheric_api_core_v1alpha1_FleetStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Instance":                   schema_spheric_api_core_v1alpha1_Instance(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceCondition":          schema_spheric_api_core_v1alpha1_InstanceCondition(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceExecOptions":        schema_spheric_api_core_v1alpha1_InstanceExecOptions(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceList":               schema_spheric_api_core_v1alpha1_InstanceList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceLogOptions":         schema_spheric_api_core_v1alpha1_InstanceLogOptions(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstancePortForwardOptions": schema_spheric_api_core_v1alpha1_InstancePortForwardOptions(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceSpec":               schema_spheric_api_core_v1alpha1_InstanceSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceStatsOptions":       schema_spheric_api_core_v1alpha1_InstanceStatsOptions(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceStatus":             schema_spheric_api_core_v1alpha1_InstanceStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceType":               schema_spheric_api_core_v1alpha1_InstanceType(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.InstanceTypeList":           schema_spheric_api_core_v1alpha1_InstanceTypeList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference":       schema_spheric_api_core_v1alpha1_LocalObjectReference(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.LocalUIDReference":          schema_spheric_api_core_v1alpha1_LocalUIDReference(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Network":                    schema_spheric_api_core_v1alpha1_Network(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.NetworkInterface":           schema_spheric_api_core_v1alpha1_NetworkInterface(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.NetworkInterfaceStatus":     schema_spheric_api_core_v1alpha1_NetworkInterfaceStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.NetworkList":                schema_spheric_api_core_v1alpha1_NetworkList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.NetworkSpec":                schema_spheric_api_core_v1alpha1_NetworkSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.NetworkStatus":              schema_spheric_api_core_v1alpha1_NetworkStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.ObjectSelector":             schema_spheric_api_core_v1alpha1_ObjectSelector(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.SecretKeySelector":          schema_spheric_api_core_v1alpha1_SecretKeySelector(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Subnet":                     schema_spheric_api_core_v1alpha1_Subnet(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.SubnetList":                 schema_spheric_api_core_v1alpha1_SubnetList(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.SubnetReference":            schema_spheric_api_core_v1alpha1_SubnetReference(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.SubnetSpec":                 schema_spheric_api_core_v1alpha1_SubnetSpec(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.SubnetStatus":               schema_spheric_api_core_v1alpha1_SubnetStatus(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Taint":                      schema_spheric_api_core_v1alpha1_Taint(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.Toleration":                 schema_spheric_api_core_v1alpha1_Toleration(ref),
+		"spheric.cloud/spheric/api/core/v1alpha1.UIDReference":               schema_spheric_api_core_v1alpha1_UIDReference(ref),
 	}
 }
 
@@ -3033,6 +3044,27 @@ func schema_spheric_api_core_v1alpha1_DiskAccess(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_DiskDataSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskDataSource specifies the source to populate a Disk with.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"snapshotRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotRef references the DiskSnapshot to restore the Disk from.",
+							Ref:         ref("spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference"},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_DiskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3082,6 +3114,234 @@ func schema_spheric_api_core_v1alpha1_DiskList(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_DiskSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskSnapshot is the Schema for the disksnapshots API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotSpec", "spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotStatus"},
+	}
+}
+
+func schema_spheric_api_core_v1alpha1_DiskSnapshotCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskSnapshotCondition is one of the conditions of a disk snapshot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a machine-readable indication of why the condition is in a certain state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable explanation of why the condition has a certain reason / state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration represents the .metadata.generation that the condition was set based upon.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the status of a condition has transitioned from one state to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_spheric_api_core_v1alpha1_DiskSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskSnapshotList contains a list of DiskSnapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshot"},
+	}
+}
+
+func schema_spheric_api_core_v1alpha1_DiskSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskSnapshotSpec defines the desired state of DiskSnapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"diskRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DiskRef references the Disk to take the snapshot of.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference"),
+						},
+					},
+				},
+				Required: []string{"diskRef"},
+			},
+		},
+		Dependencies: []string{
+			"spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference"},
+	}
+}
+
+func schema_spheric_api_core_v1alpha1_DiskSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskSnapshotStatus defines the observed state of DiskSnapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"readyToUse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadyToUse reports whether the DiskSnapshot can be used as data source of a Disk.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the size of the data of the DiskSnapshot.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTime is the time the snapshot was taken by the underlying driver.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the conditions of the DiskSnapshot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "spheric.cloud/spheric/api/core/v1alpha1.DiskSnapshotCondition"},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_DiskSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3116,12 +3376,18 @@ func schema_spheric_api_core_v1alpha1_DiskSpec(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"dataSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataSource is the source to populate the Disk with on creation.",
+							Ref:         ref("spheric.cloud/spheric/api/core/v1alpha1.DiskDataSource"),
+						},
+					},
 				},
 				Required: []string{"typeRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference", "spheric.cloud/spheric/api/core/v1alpha1.LocalUIDReference"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "spheric.cloud/spheric/api/core/v1alpha1.DiskDataSource", "spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference", "spheric.cloud/spheric/api/core/v1alpha1.LocalUIDReference"},
 	}
 }
 
@@ -3556,6 +3822,48 @@ func schema_spheric_api_core_v1alpha1_FleetList(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_FleetRuntimeInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FleetRuntimeInfo describes the instance runtime of a Fleet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runtimeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeName is the name of the instance runtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runtimeVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeVersion is the version of the instance runtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capabilities are the optional features supported by the instance runtime.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_FleetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3669,11 +3977,17 @@ func schema_spheric_api_core_v1alpha1_FleetStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"runtimeInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeInfo describes the instance runtime of the fleet.",
+							Ref:         ref("spheric.cloud/spheric/api/core/v1alpha1.FleetRuntimeInfo"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "spheric.cloud/spheric/api/core/v1alpha1.FleetAddress", "spheric.cloud/spheric/api/core/v1alpha1.FleetCondition", "spheric.cloud/spheric/api/core/v1alpha1.FleetDaemonEndpoints"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "spheric.cloud/spheric/api/core/v1alpha1.FleetAddress", "spheric.cloud/spheric/api/core/v1alpha1.FleetCondition", "spheric.cloud/spheric/api/core/v1alpha1.FleetDaemonEndpoints", "spheric.cloud/spheric/api/core/v1alpha1.FleetRuntimeInfo"},
 	}
 }
 
@@ -3724,6 +4038,67 @@ func schema_spheric_api_core_v1alpha1_Instance(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_InstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstanceCondition is one of the conditions of an instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a machine-readable indication of why the condition is in a certain state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human-readable explanation of why the condition has a certain reason / state.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration represents the .metadata.generation that the condition was set based upon.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the status of a condition has transitioned from one state to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "status", "reason", "message"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_InstanceExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3806,6 +4181,108 @@ func schema_spheric_api_core_v1alpha1_InstanceList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_InstanceLogOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstanceLogOptions is the query options to a Instance's log call",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"follow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Follow the serial console log stream of the instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tailLines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TailLines is the number of lines from the end of the log to show. If not specified, the whole log is shown.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sinceSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SinceSeconds is a relative time in seconds before the current time from which to show logs.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"insecureSkipTLSVerifyBackend": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_spheric_api_core_v1alpha1_InstancePortForwardOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstancePortForwardOptions is the query options to a Instance's port forward call",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports are the ports of the instance to forward.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"insecureSkipTLSVerifyBackend": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_InstanceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3942,6 +4419,20 @@ func schema_spheric_api_core_v1alpha1_InstanceSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"restartGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartGeneration is increased to request a restart of the instance. The instance is restarted once per increase while it is powered on.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"restartType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RestartType is the kind of restart performed when RestartGeneration is increased. Defaults to RestartGraceful.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"instanceTypeRef"},
 			},
@@ -3951,6 +4442,39 @@ func schema_spheric_api_core_v1alpha1_InstanceSpec(ref common.ReferenceCallback)
 	}
 }
 
+func schema_spheric_api_core_v1alpha1_InstanceStatsOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InstanceStatsOptions is the query options to a Instance's stats call",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"insecureSkipTLSVerifyBackend": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_spheric_api_core_v1alpha1_InstanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3972,12 +4496,25 @@ func schema_spheric_api_core_v1alpha1_InstanceStatus(ref common.ReferenceCallbac
 							Format:      "int64",
 						},
 					},
+					"observedRestartGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedRestartGeneration is the last RestartGeneration the Fleet carried out for the Instance.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "State is the infrastructure state of the instance.\n\nPossible enum values:\n - `\"Pending\"` means the Instance has been accepted by the system, but not yet completely started. This includes time before being bound to a Fleet, as well as time spent setting up the Instance on that Fleet.\n - `\"Running\"` means the instance is running on a Fleet.\n - `\"Shutdown\"` means the instance is shut down.\n - `\"Terminated\"` means the instance has been permanently stopped and cannot be started.",
+							Description: "State is the infrastructure state of the instance.\n\nPossible enum values:\n - `\"Pending\"` means the Instance has been accepted by the system, but not yet completely started. This includes time before being bound to a Fleet, as well as time spent setting up the Instance on that Fleet.\n - `\"Running\"` means the instance is running on a Fleet.\n - `\"Shutdown\"` means the instance is shut down.\n - `\"Suspended\"` means the instance is paused, keeping its memory state.\n - `\"Terminated\"` means the instance has been permanently stopped and cannot be started.",
 							Type:        []string{"string"},
 							Format:      "",
-							Enum:        []interface{}{"Pending", "Running", "Shutdown", "Terminated"},
+							Enum:        []interface{}{"Pending", "Running", "Shutdown", "Suspended", "Terminated"},
+						},
+					},
+					"instanceTypeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstanceTypeRef references the instance type whose resources are currently applied to the instance. It differs from the instance type of the spec while the instance is not resized yet.",
+							Ref:         ref("spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference"),
 						},
 					},
 					"networkInterfaces": {
@@ -4008,11 +4545,25 @@ func schema_spheric_api_core_v1alpha1_InstanceStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the conditions of the instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("spheric.cloud/spheric/api/core/v1alpha1.InstanceCondition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskStatus", "spheric.cloud/spheric/api/core/v1alpha1.NetworkInterfaceStatus"},
+			"spheric.cloud/spheric/api/core/v1alpha1.AttachedDiskStatus", "spheric.cloud/spheric/api/core/v1alpha1.InstanceCondition", "spheric.cloud/spheric/api/core/v1alpha1.LocalObjectReference", "spheric.cloud/spheric/api/core/v1alpha1.NetworkInterfaceStatus"},
 	}
 }
 
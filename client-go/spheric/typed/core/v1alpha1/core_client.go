@@ -16,6 +16,7 @@ import (
 type CoreV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	DisksGetter
+	DiskSnapshotsGetter
 	DiskTypesGetter
 	FleetsGetter
 	InstancesGetter
@@ -33,6 +34,10 @@ func (c *CoreV1alpha1Client) Disks(namespace string) DiskInterface {
 	return newDisks(c, namespace)
 }
 
+func (c *CoreV1alpha1Client) DiskSnapshots(namespace string) DiskSnapshotInterface {
+	return newDiskSnapshots(c, namespace)
+}
+
 func (c *CoreV1alpha1Client) DiskTypes() DiskTypeInterface {
 	return newDiskTypes(c)
 }
@@ -19,6 +19,10 @@ func (c *FakeCoreV1alpha1) Disks(namespace string) v1alpha1.DiskInterface {
 	return &FakeDisks{c, namespace}
 }
 
+func (c *FakeCoreV1alpha1) DiskSnapshots(namespace string) v1alpha1.DiskSnapshotInterface {
+	return &FakeDiskSnapshots{c, namespace}
+}
+
 func (c *FakeCoreV1alpha1) DiskTypes() v1alpha1.DiskTypeInterface {
 	return &FakeDiskTypes{c}
 }
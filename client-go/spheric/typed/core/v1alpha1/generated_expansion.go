@@ -7,6 +7,8 @@ package v1alpha1
 
 type DiskExpansion interface{}
 
+type DiskSnapshotExpansion interface{}
+
 type DiskTypeExpansion interface{}
 
 type FleetExpansion interface{}
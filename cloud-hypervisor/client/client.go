@@ -60,8 +60,26 @@ func hasStatus(expected int) func(statusCode int) bool {
 
 type Client interface {
 	PingVMM(ctx context.Context) (*oapiclient.VmmPingResponse, error)
+	ShutdownVMM(ctx context.Context) error
 	CreateVM(ctx context.Context, req oapiclient.CreateVMJSONRequestBody) error
+	DeleteVM(ctx context.Context) error
+	BootVM(ctx context.Context) error
+	ShutdownVM(ctx context.Context) error
+	RebootVM(ctx context.Context) error
+	PowerButtonVM(ctx context.Context) error
+	PauseVM(ctx context.Context) error
+	ResumeVM(ctx context.Context) error
+	ResizeVM(ctx context.Context, req oapiclient.VmResize) error
 	GetVMInfo(ctx context.Context) (*oapiclient.VmInfo, error)
+	GetVMCounters(ctx context.Context) (oapiclient.VmCounters, error)
+	AddDevice(ctx context.Context, req oapiclient.DeviceConfig) (*oapiclient.PciDeviceInfo, error)
+	AddDisk(ctx context.Context, req oapiclient.DiskConfig) (*oapiclient.PciDeviceInfo, error)
+	AddNet(ctx context.Context, req oapiclient.NetConfig) (*oapiclient.PciDeviceInfo, error)
+	RemoveDevice(ctx context.Context, id string) error
+	SnapshotVM(ctx context.Context, req oapiclient.VmSnapshotConfig) error
+	RestoreVM(ctx context.Context, req oapiclient.RestoreConfig) error
+	SendMigration(ctx context.Context, req oapiclient.SendMigrationData) error
+	ReceiveMigration(ctx context.Context, req oapiclient.ReceiveMigrationData) error
 }
 
 type client struct {
@@ -101,6 +119,17 @@ func (c *client) PingVMM(ctx context.Context) (*oapiclient.VmmPingResponse, erro
 	return res.JSON200, nil
 }
 
+func (c *client) ShutdownVMM(ctx context.Context) error {
+	res, err := c.oapiClient.ShutdownVMMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *client) CreateVM(ctx context.Context, req oapiclient.CreateVMJSONRequestBody) error {
 	res, err := c.oapiClient.CreateVMWithResponse(ctx, req)
 	if err != nil {
@@ -112,6 +141,94 @@ func (c *client) CreateVM(ctx context.Context, req oapiclient.CreateVMJSONReques
 	return nil
 }
 
+func (c *client) DeleteVM(ctx context.Context) error {
+	res, err := c.oapiClient.DeleteVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) BootVM(ctx context.Context) error {
+	res, err := c.oapiClient.BootVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ShutdownVM(ctx context.Context) error {
+	res, err := c.oapiClient.ShutdownVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) RebootVM(ctx context.Context) error {
+	res, err := c.oapiClient.RebootVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) PowerButtonVM(ctx context.Context) error {
+	res, err := c.oapiClient.PowerButtonVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) PauseVM(ctx context.Context) error {
+	res, err := c.oapiClient.PauseVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ResumeVM(ctx context.Context) error {
+	res, err := c.oapiClient.ResumeVMWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ResizeVM(ctx context.Context, req oapiclient.VmResize) error {
+	res, err := c.oapiClient.PutVmResizeWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *client) GetVMInfo(ctx context.Context) (*oapiclient.VmInfo, error) {
 	res, err := c.oapiClient.GetVmInfoWithResponse(ctx)
 	if err != nil {
@@ -125,3 +242,105 @@ func (c *client) GetVMInfo(ctx context.Context) (*oapiclient.VmInfo, error) {
 	}
 	return res.JSON200, nil
 }
+
+func (c *client) GetVMCounters(ctx context.Context) (oapiclient.VmCounters, error) {
+	res, err := c.oapiClient.GetVmCountersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, isOK); err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("empty response, body: %s", string(res.Body))
+	}
+	return *res.JSON200, nil
+}
+
+func (c *client) AddDevice(ctx context.Context, req oapiclient.DeviceConfig) (*oapiclient.PciDeviceInfo, error) {
+	res, err := c.oapiClient.PutVmAddDeviceWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, isOK, hasStatus(http.StatusNoContent)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *client) AddDisk(ctx context.Context, req oapiclient.DiskConfig) (*oapiclient.PciDeviceInfo, error) {
+	res, err := c.oapiClient.PutVmAddDiskWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, isOK, hasStatus(http.StatusNoContent)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *client) AddNet(ctx context.Context, req oapiclient.NetConfig) (*oapiclient.PciDeviceInfo, error) {
+	res, err := c.oapiClient.PutVmAddNetWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, isOK, hasStatus(http.StatusNoContent)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+func (c *client) RemoveDevice(ctx context.Context, id string) error {
+	res, err := c.oapiClient.PutVmRemoveDeviceWithResponse(ctx, oapiclient.VmRemoveDevice{Id: &id})
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) SnapshotVM(ctx context.Context, req oapiclient.VmSnapshotConfig) error {
+	res, err := c.oapiClient.PutVmSnapshotWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) RestoreVM(ctx context.Context, req oapiclient.RestoreConfig) error {
+	res, err := c.oapiClient.PutVmRestoreWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) SendMigration(ctx context.Context, req oapiclient.SendMigrationData) error {
+	res, err := c.oapiClient.PutVmSendMigrationWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ReceiveMigration(ctx context.Context, req oapiclient.ReceiveMigrationData) error {
+	res, err := c.oapiClient.PutVmReceiveMigrationWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkStatusWithBody(res.StatusCode(), res.Body, hasStatus(http.StatusNoContent)); err != nil {
+		return err
+	}
+	return nil
+}
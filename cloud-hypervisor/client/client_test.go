@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "spheric.cloud/spheric/cloud-hypervisor/client"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/utils/generic"
+	. "spheric.cloud/spheric/utils/testing"
+)
+
+type request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+type response struct {
+	StatusCode int
+	Body       string
+}
+
+// fakeVMM records all requests and replies with the response registered for the request path.
+// Paths without a registered response are answered with 204 No Content.
+type fakeVMM struct {
+	mu        sync.Mutex
+	requests  []request
+	responses map[string]response
+}
+
+func (f *fakeVMM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = append(f.requests, request{Method: r.Method, Path: r.URL.Path, Body: string(body)})
+
+	res, ok := f.responses[r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if res.Body != "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write([]byte(res.Body))
+}
+
+func (f *fakeVMM) respond(path string, statusCode int, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[path] = response{StatusCode: statusCode, Body: body}
+}
+
+func (f *fakeVMM) lastRequest() request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	Expect(f.requests).NotTo(BeEmpty())
+	return f.requests[len(f.requests)-1]
+}
+
+var _ = Describe("Client", func() {
+	var (
+		vmm *fakeVMM
+		c   Client
+	)
+
+	BeforeEach(func() {
+		vmm = &fakeVMM{responses: make(map[string]response)}
+
+		dir := ShortSocketDir(GinkgoT())
+
+		socket := filepath.Join(dir, "api.sock")
+		l, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := httptest.NewUnstartedServer(vmm)
+		srv.Listener = l
+		srv.Start()
+		DeferCleanup(srv.Close)
+
+		c, err = Connect(socket)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	expectRequest := func(method, path string, body any) {
+		GinkgoHelper()
+		req := vmm.lastRequest()
+		Expect(req.Method).To(Equal(method))
+		Expect(req.Path).To(Equal("/api/v1/" + path))
+		if body != nil {
+			data, err := json.Marshal(body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(req.Body).To(MatchJSON(data))
+		}
+	}
+
+	DescribeTable("operations without response content",
+		func(ctx SpecContext, method, path string, body any, op func(context.Context, Client) error) {
+			Expect(op(ctx, c)).To(Succeed())
+			expectRequest(method, path, body)
+		},
+		Entry("ShutdownVMM", http.MethodPut, "vmm.shutdown", nil,
+			func(ctx context.Context, c Client) error { return c.ShutdownVMM(ctx) }),
+		Entry("CreateVM", http.MethodPut, "vm.create", oapiclient.VmConfig{Payload: oapiclient.PayloadConfig{Firmware: generic.Pointer("fw")}},
+			func(ctx context.Context, c Client) error {
+				return c.CreateVM(ctx, oapiclient.VmConfig{Payload: oapiclient.PayloadConfig{Firmware: generic.Pointer("fw")}})
+			}),
+		Entry("DeleteVM", http.MethodPut, "vm.delete", nil,
+			func(ctx context.Context, c Client) error { return c.DeleteVM(ctx) }),
+		Entry("BootVM", http.MethodPut, "vm.boot", nil,
+			func(ctx context.Context, c Client) error { return c.BootVM(ctx) }),
+		Entry("ShutdownVM", http.MethodPut, "vm.shutdown", nil,
+			func(ctx context.Context, c Client) error { return c.ShutdownVM(ctx) }),
+		Entry("RebootVM", http.MethodPut, "vm.reboot", nil,
+			func(ctx context.Context, c Client) error { return c.RebootVM(ctx) }),
+		Entry("PowerButtonVM", http.MethodPut, "vm.power-button", nil,
+			func(ctx context.Context, c Client) error { return c.PowerButtonVM(ctx) }),
+		Entry("PauseVM", http.MethodPut, "vm.pause", nil,
+			func(ctx context.Context, c Client) error { return c.PauseVM(ctx) }),
+		Entry("ResumeVM", http.MethodPut, "vm.resume", nil,
+			func(ctx context.Context, c Client) error { return c.ResumeVM(ctx) }),
+		Entry("ResizeVM", http.MethodPut, "vm.resize", oapiclient.VmResize{DesiredVcpus: generic.Pointer(4)},
+			func(ctx context.Context, c Client) error {
+				return c.ResizeVM(ctx, oapiclient.VmResize{DesiredVcpus: generic.Pointer(4)})
+			}),
+		Entry("RemoveDevice", http.MethodPut, "vm.remove-device", oapiclient.VmRemoveDevice{Id: generic.Pointer("disk-foo")},
+			func(ctx context.Context, c Client) error { return c.RemoveDevice(ctx, "disk-foo") }),
+		Entry("SnapshotVM", http.MethodPut, "vm.snapshot", oapiclient.VmSnapshotConfig{DestinationUrl: generic.Pointer("file:///snap")},
+			func(ctx context.Context, c Client) error {
+				return c.SnapshotVM(ctx, oapiclient.VmSnapshotConfig{DestinationUrl: generic.Pointer("file:///snap")})
+			}),
+		Entry("RestoreVM", http.MethodPut, "vm.restore", oapiclient.RestoreConfig{SourceUrl: "file:///snap"},
+			func(ctx context.Context, c Client) error {
+				return c.RestoreVM(ctx, oapiclient.RestoreConfig{SourceUrl: "file:///snap"})
+			}),
+		Entry("SendMigration", http.MethodPut, "vm.send-migration", oapiclient.SendMigrationData{DestinationUrl: "unix:///dst"},
+			func(ctx context.Context, c Client) error {
+				return c.SendMigration(ctx, oapiclient.SendMigrationData{DestinationUrl: "unix:///dst"})
+			}),
+		Entry("ReceiveMigration", http.MethodPut, "vm.receive-migration", oapiclient.ReceiveMigrationData{ReceiverUrl: "unix:///src"},
+			func(ctx context.Context, c Client) error {
+				return c.ReceiveMigration(ctx, oapiclient.ReceiveMigrationData{ReceiverUrl: "unix:///src"})
+			}),
+	)
+
+	It("should ping the vmm", func(ctx SpecContext) {
+		vmm.respond("/api/v1/vmm.ping", http.StatusOK, `{"version":"v40.0","pid":42}`)
+
+		res, err := c.PingVMM(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Version).To(Equal("v40.0"))
+		Expect(res.Pid).To(HaveValue(BeEquivalentTo(42)))
+		expectRequest(http.MethodGet, "vmm.ping", nil)
+	})
+
+	It("should get the vm info", func(ctx SpecContext) {
+		vmm.respond("/api/v1/vm.info", http.StatusOK, `{"config":{"payload":{}},"state":"Running"}`)
+
+		res, err := c.GetVMInfo(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.State).To(Equal(oapiclient.Running))
+		expectRequest(http.MethodGet, "vm.info", nil)
+	})
+
+	It("should get the vm counters", func(ctx SpecContext) {
+		vmm.respond("/api/v1/vm.counters", http.StatusOK, `{"_disk0":{"read_bytes":1024}}`)
+
+		Expect(c.GetVMCounters(ctx)).To(Equal(oapiclient.VmCounters{
+			"_disk0": {"read_bytes": 1024},
+		}))
+		expectRequest(http.MethodGet, "vm.counters", nil)
+	})
+
+	DescribeTable("hot-plugging devices",
+		func(ctx SpecContext, path string, body any, op func(context.Context, Client) (*oapiclient.PciDeviceInfo, error)) {
+			vmm.respond("/api/v1/"+path, http.StatusOK, `{"id":"foo","bdf":"0000:00:05.0"}`)
+
+			Expect(op(ctx, c)).To(Equal(&oapiclient.PciDeviceInfo{Id: "foo", Bdf: "0000:00:05.0"}))
+			expectRequest(http.MethodPut, path, body)
+		},
+		Entry("AddDevice", "vm.add-device", oapiclient.DeviceConfig{Path: "/sys/bus/pci/devices/foo"},
+			func(ctx context.Context, c Client) (*oapiclient.PciDeviceInfo, error) {
+				return c.AddDevice(ctx, oapiclient.DeviceConfig{Path: "/sys/bus/pci/devices/foo"})
+			}),
+		Entry("AddDisk", "vm.add-disk", oapiclient.DiskConfig{Path: "/disk.raw"},
+			func(ctx context.Context, c Client) (*oapiclient.PciDeviceInfo, error) {
+				return c.AddDisk(ctx, oapiclient.DiskConfig{Path: "/disk.raw"})
+			}),
+		Entry("AddNet", "vm.add-net", oapiclient.NetConfig{Tap: generic.Pointer("tap0")},
+			func(ctx context.Context, c Client) (*oapiclient.PciDeviceInfo, error) {
+				return c.AddNet(ctx, oapiclient.NetConfig{Tap: generic.Pointer("tap0")})
+			}),
+	)
+
+	It("should report unexpected statuses as status errors", func(ctx SpecContext) {
+		vmm.respond("/api/v1/vm.boot", http.StatusNotFound, `"VM not created"`)
+
+		err := c.BootVM(ctx)
+		Expect(IsStatusError(err, http.StatusNotFound)).To(BeTrue())
+		Expect(IsStatusError(err, http.StatusMethodNotAllowed)).To(BeFalse())
+		Expect(err).To(MatchError(ContainSubstring("VM not created")))
+	})
+})
@@ -4,10 +4,12 @@
 package cloudhypervisor
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"os/exec"
+	"syscall"
 	"time"
 
 	"spheric.cloud/spheric/cloud-hypervisor/client"
@@ -25,23 +27,92 @@ func NewCloudHypervisor(command string) *CloudHypervisor {
 
 var Default = NewCloudHypervisor("cloud-hypervisor")
 
+// StartOptions are options for starting a cloud-hypervisor process.
+type StartOptions struct {
+	// LogFile is the file the output of cloud-hypervisor is appended to.
+	// If empty, the output is only reported in case cloud-hypervisor fails to start.
+	LogFile string
+	// Detach starts cloud-hypervisor in its own session, so it is not affected by
+	// signals sent to the process group of the caller and can outlive the caller.
+	Detach bool
+}
+
+// Process is a started cloud-hypervisor process.
+type Process struct {
+	PID       int
+	APISocket string
+	StartTime time.Time
+	Client    client.Client
+
+	done chan struct{}
+	err  error
+}
+
+// Done returns a channel that is closed once the process exited.
+func (p *Process) Done() <-chan struct{} {
+	return p.done
+}
+
+// Err returns the error the process exited with. It is only valid after Done is closed.
+func (p *Process) Err() error {
+	return p.err
+}
+
 func (c *CloudHypervisor) Start(ctx context.Context, apiSocket string) (client.Client, error) {
+	p, err := c.StartProcess(ctx, apiSocket, StartOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return p.Client, nil
+}
+
+// StartProcess starts a cloud-hypervisor process serving its api at apiSocket and waits
+// until the api is responsive.
+func (c *CloudHypervisor) StartProcess(ctx context.Context, apiSocket string, opts StartOptions) (*Process, error) {
 	cmd := exec.Command(c.command, "--api-socket", apiSocket)
+	if opts.Detach {
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	}
 
-	runErr := make(chan error, 1)
-	go func() {
-		defer close(runErr)
-		res, err := cmd.CombinedOutput()
+	var output bytes.Buffer
+	if opts.LogFile != "" {
+		logFile, err := os.OpenFile(opts.LogFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 		if err != nil {
-			runErr <- fmt.Errorf("error running api command: %w, output: %s", err, string(res))
-		} else {
-			runErr <- nil
+			return nil, fmt.Errorf("error opening log file: %w", err)
+		}
+		defer func() { _ = logFile.Close() }()
+
+		cmd.Stdout = logFile
+		cmd.Stderr = logFile
+	} else {
+		cmd.Stdout = &output
+		cmd.Stderr = &output
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error starting cloud-hypervisor: %w", err)
+	}
+
+	p := &Process{
+		PID:       cmd.Process.Pid,
+		APISocket: apiSocket,
+		StartTime: time.Now(),
+		done:      make(chan struct{}),
+	}
+	go func() {
+		defer close(p.done)
+		if err := cmd.Wait(); err != nil {
+			if opts.LogFile != "" {
+				p.err = fmt.Errorf("error running cloud-hypervisor: %w, see %s", err, opts.LogFile)
+			} else {
+				p.err = fmt.Errorf("error running cloud-hypervisor: %w, output: %s", err, output.String())
+			}
 		}
 	}()
 
 	interruptAndWait := func() {
 		_ = cmd.Process.Signal(os.Interrupt)
-		_, _ = cmd.Process.Wait()
+		<-p.done
 	}
 
 	cl, err := client.Connect(apiSocket)
@@ -49,6 +120,7 @@ func (c *CloudHypervisor) Start(ctx context.Context, apiSocket string) (client.C
 		interruptAndWait()
 		return nil, fmt.Errorf("error creating client: %w", err)
 	}
+	p.Client = cl
 
 	var lastErr error
 	check := func() bool {
@@ -57,7 +129,7 @@ func (c *CloudHypervisor) Start(ctx context.Context, apiSocket string) (client.C
 		return err == nil
 	}
 	if check() {
-		return cl, nil
+		return p, nil
 	}
 
 	t := time.NewTicker(1 * time.Second)
@@ -65,6 +137,7 @@ func (c *CloudHypervisor) Start(ctx context.Context, apiSocket string) (client.C
 	for {
 		select {
 		case <-ctx.Done():
+			interruptAndWait()
 			if lastErr != nil {
 				return nil, fmt.Errorf("%w, last error: %v", ctx.Err(), lastErr)
 			}
@@ -73,12 +146,12 @@ func (c *CloudHypervisor) Start(ctx context.Context, apiSocket string) (client.C
 			if !check() {
 				continue
 			}
-			return cl, nil
-		case err := <-runErr:
-			if err == nil {
+			return p, nil
+		case <-p.done:
+			if p.err == nil {
 				return nil, fmt.Errorf("cloud-hypervisor exited early without error")
 			}
-			return nil, err
+			return nil, p.err
 		}
 	}
 }
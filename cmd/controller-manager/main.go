@@ -19,6 +19,8 @@ import (
 	coreclient "spheric.cloud/spheric/internal/client/core"
 	corecontrollers "spheric.cloud/spheric/internal/controllers/core"
 	certificatespheric "spheric.cloud/spheric/internal/controllers/core/certificate/spheric"
+	"spheric.cloud/spheric/internal/controllers/core/snapshot"
+	fakesnapshot "spheric.cloud/spheric/internal/controllers/core/snapshot/fake"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -47,8 +49,12 @@ const (
 	diskReleaseController             = "volumerelease"
 	networkProtectionController       = "networkprotection"
 	certificateApprovalController     = "certificateapproval"
+	diskSnapshotController            = "disksnapshot"
+	diskRestoreController             = "diskrestore"
 )
 
+const fakeSnapshotDriver = "fake"
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(corev1alpha1.AddToScheme(scheme))
@@ -66,6 +72,8 @@ func main() {
 	var volumeBindTimeout time.Duration
 	var virtualIPBindTimeout time.Duration
 	var networkInterfaceBindTimeout time.Duration
+	var snapshotDriverName string
+	var allowFakeSnapshotDriver bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -75,6 +83,11 @@ func main() {
 	flag.DurationVar(&volumeBindTimeout, "disk-bind-timeout", 10*time.Second, "Time to wait until considering a disk bind to be failed.")
 	flag.DurationVar(&virtualIPBindTimeout, "virtual-ip-bind-timeout", 10*time.Second, "Time to wait until considering a virtual ip bind to be failed.")
 	flag.DurationVar(&networkInterfaceBindTimeout, "network-interface-bind-timeout", 10*time.Second, "Time to wait until considering a network interface bind to be failed.")
+	flag.StringVar(&snapshotDriverName, "disk-snapshot-driver", "",
+		fmt.Sprintf("Driver to take disk snapshots and restore disks with. Required if the %s or %s controller is enabled. Supported drivers: %v",
+			diskSnapshotController, diskRestoreController, []string{fakeSnapshotDriver}))
+	flag.BoolVar(&allowFakeSnapshotDriver, "allow-fake-disk-snapshot-driver", false,
+		"Allow the in-memory fake disk snapshot driver. Snapshots of the fake driver are lost on restart, only use it for testing.")
 
 	controllers := switches.New(
 		instanceEphemeralVolumeController,
@@ -83,6 +96,9 @@ func main() {
 		diskReleaseController,
 		networkProtectionController,
 		certificateApprovalController,
+		// Disabled as they require a --disk-snapshot-driver.
+		switches.Disable(diskSnapshotController),
+		switches.Disable(diskRestoreController),
 	)
 	flag.Var(controllers, "controllers",
 		fmt.Sprintf("Controllers to enable. All controllers: %v. Disabled-by-default controllers: %v",
@@ -183,6 +199,35 @@ func main() {
 		}
 	}
 
+	var snapshotDriver snapshot.Driver
+	if controllers.AnyEnabled(diskSnapshotController, diskRestoreController) {
+		snapshotDriver, err = newSnapshotDriver(snapshotDriverName, allowFakeSnapshotDriver)
+		if err != nil {
+			setupLog.Error(err, "unable to create disk snapshot driver")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(diskSnapshotController) {
+		if err := (&corecontrollers.DiskSnapshotReconciler{
+			Client: mgr.GetClient(),
+			Driver: snapshotDriver,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DiskSnapshot")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(diskRestoreController) {
+		if err := (&corecontrollers.DiskRestoreReconciler{
+			Client: mgr.GetClient(),
+			Driver: snapshotDriver,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DiskRestore")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.AnyEnabled(instanceEphemeralVolumeController) {
 		if err := coreclient.SetupInstanceSpecDiskNamesFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
 			setupLog.Error(err, "unable to index field", "field", coreclient.InstanceSpecDiskNamesField)
@@ -204,6 +249,20 @@ func main() {
 		}
 	}
 
+	if controllers.AnyEnabled(diskSnapshotController, diskRestoreController) {
+		if err := coreclient.SetupDiskSpecDataSourceSnapshotRefNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", coreclient.DiskSpecDataSourceSnapshotRefNameField)
+			os.Exit(1)
+		}
+	}
+
+	if controllers.AnyEnabled(diskSnapshotController) {
+		if err := coreclient.SetupDiskSnapshotSpecDiskRefNameFieldIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			setupLog.Error(err, "unable to setup field indexer", "field", coreclient.DiskSnapshotSpecDiskRefNameField)
+			os.Exit(1)
+		}
+	}
+
 	// healthz / readyz setup
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -221,3 +280,18 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+func newSnapshotDriver(name string, allowFake bool) (snapshot.Driver, error) {
+	switch name {
+	case "":
+		return nil, fmt.Errorf("must specify --disk-snapshot-driver")
+	case fakeSnapshotDriver:
+		if !allowFake {
+			return nil, fmt.Errorf("the %s disk snapshot driver only keeps snapshots in memory, "+
+				"specify --allow-fake-disk-snapshot-driver to use it for testing", fakeSnapshotDriver)
+		}
+		return fakesnapshot.NewDriver(), nil
+	default:
+		return nil, fmt.Errorf("unknown disk snapshot driver %q", name)
+	}
+}
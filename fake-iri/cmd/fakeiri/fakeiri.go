@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fakeiri
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	iriremote "spheric.cloud/spheric/spherelet/iri/remote"
+	"spheric.cloud/spheric/spherelet/iri/remote/fake"
+	utilgrpc "spheric.cloud/spheric/utils/grpc"
+)
+
+type Options struct {
+	APISocket   string
+	APIAddress  string
+	APISecurity utilgrpc.ServerSecurityOptions
+
+	CPUCount           int64
+	Memory             string
+	AllocatableCPU     int64
+	AllocatableMemory  string
+	InstanceQuantities map[string]int64
+
+	ImageFsCapacity string
+	ImageSize       string
+
+	StateTransitionDelay  time.Duration
+	AttachDelay           time.Duration
+	StuckInstanceRatio    float64
+	StuckInstanceSelector map[string]string
+
+	ErrorRates map[string]string
+	ErrorCode  string
+}
+
+func NewOptions() *Options {
+	return &Options{
+		APISocket:       filepath.Join("/var", "run", "fake-iri", "fake-iri.sock"),
+		CPUCount:        8,
+		Memory:          "16Gi",
+		ImageFsCapacity: "100Gi",
+		ImageSize:       "1Gi",
+		ErrorCode:       codes.Unavailable.String(),
+	}
+}
+
+func (o *Options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.APISocket, "api-socket", o.APISocket, "Where to create the API socket.")
+	cmd.Flags().StringVar(&o.APIAddress, "api-address", o.APIAddress, "TCP address to serve the API on instead of the API socket, e.g. ':9443'.")
+	o.APISecurity.BindFlags(cmd.Flags())
+
+	cmd.Flags().Int64Var(&o.CPUCount, "cpu-count", o.CPUCount, "CPU count capacity to report.")
+	cmd.Flags().StringVar(&o.Memory, "memory", o.Memory, "Memory capacity to report, e.g. '16Gi'.")
+	cmd.Flags().Int64Var(&o.AllocatableCPU, "allocatable-cpu-count", o.AllocatableCPU, "Allocatable CPU count to report. Defaults to the CPU count capacity.")
+	cmd.Flags().StringVar(&o.AllocatableMemory, "allocatable-memory", o.AllocatableMemory, "Allocatable memory to report. Defaults to the memory capacity.")
+	cmd.Flags().StringToInt64Var(&o.InstanceQuantities, "instance-quantity", o.InstanceQuantities, "Instance quantities to report per instance type, e.g. 'small=4'.")
+
+	cmd.Flags().StringVar(&o.ImageFsCapacity, "image-fs-capacity", o.ImageFsCapacity, "Capacity of the image filesystem to report.")
+	cmd.Flags().StringVar(&o.ImageSize, "image-size", o.ImageSize, "Size of pulled images.")
+
+	cmd.Flags().DurationVar(&o.StateTransitionDelay, "state-transition-delay", o.StateTransitionDelay,
+		"Delay after which instances reach the state matching their power (e.g. pending to running).")
+	cmd.Flags().DurationVar(&o.AttachDelay, "attach-delay", o.AttachDelay, "Delay after which disks and network interfaces are reported as attached.")
+	cmd.Flags().Float64Var(&o.StuckInstanceRatio, "stuck-instance-ratio", o.StuckInstanceRatio,
+		"Ratio (between 0 and 1) of created instances that never leave the pending state.")
+	cmd.Flags().StringToStringVar(&o.StuckInstanceSelector, "stuck-instance-selector", o.StuckInstanceSelector,
+		"Labels of created instances that never leave the pending state.")
+
+	cmd.Flags().StringToStringVar(&o.ErrorRates, "error-rate", o.ErrorRates,
+		"Rate (between 0 and 1) at which to fail requests per RPC, e.g. 'CreateInstance=0.1'.")
+	cmd.Flags().StringVar(&o.ErrorCode, "error-code", o.ErrorCode, "gRPC status code to fail requests with, e.g. 'Unavailable'.")
+}
+
+func parseErrorCode(s string) (codes.Code, error) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if code.String() == s {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid error code %q", s)
+}
+
+func parseMemory(s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(q.Value()), nil
+}
+
+func (o *Options) resources() (capacity, allocatable *iri.RuntimeResources, err error) {
+	memory, err := parseMemory(o.Memory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid memory: %w", err)
+	}
+	allocatableMemory, err := parseMemory(o.AllocatableMemory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid allocatable memory: %w", err)
+	}
+	if o.AllocatableMemory == "" {
+		allocatableMemory = memory
+	}
+	allocatableCPU := o.AllocatableCPU
+	if allocatableCPU == 0 {
+		allocatableCPU = o.CPUCount
+	}
+
+	capacity = &iri.RuntimeResources{
+		CpuCount:           o.CPUCount,
+		MemoryBytes:        memory,
+		InstanceQuantities: o.InstanceQuantities,
+	}
+	allocatable = &iri.RuntimeResources{
+		CpuCount:           allocatableCPU,
+		MemoryBytes:        allocatableMemory,
+		InstanceQuantities: o.InstanceQuantities,
+	}
+	return capacity, allocatable, nil
+}
+
+func Command() *cobra.Command {
+	var (
+		zapOpts = zap.Options{Development: true}
+		opts    = NewOptions()
+	)
+
+	cmd := &cobra.Command{
+		Use:   "fake-iri",
+		Short: "Serve a fake IRI runtime with configurable behavior.",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			logger := zap.New(zap.UseFlagOptions(&zapOpts))
+			ctrl.SetLogger(logger)
+			cmd.SetContext(ctrl.LoggerInto(cmd.Context(), ctrl.Log))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			return Run(ctx, *opts)
+		},
+	}
+
+	opts.AddFlags(cmd)
+
+	return cmd
+}
+
+func Run(ctx context.Context, opts Options) error {
+	setupLog := ctrl.Log.WithName("setup")
+
+	capacity, allocatable, err := opts.resources()
+	if err != nil {
+		return err
+	}
+	if opts.StuckInstanceRatio < 0 || opts.StuckInstanceRatio > 1 {
+		return fmt.Errorf("invalid stuck instance ratio %v: must be between 0 and 1", opts.StuckInstanceRatio)
+	}
+	errorRates, err := parseErrorRates(opts.ErrorRates)
+	if err != nil {
+		return err
+	}
+	errorCode, err := parseErrorCode(opts.ErrorCode)
+	if err != nil {
+		return err
+	}
+
+	imageFsCapacity, err := parseMemory(opts.ImageFsCapacity)
+	if err != nil {
+		return fmt.Errorf("invalid image filesystem capacity: %w", err)
+	}
+	imageSize, err := parseMemory(opts.ImageSize)
+	if err != nil {
+		return fmt.Errorf("invalid image size: %w", err)
+	}
+
+	runtime := fake.NewFakeRuntimeService()
+	runtime.SetStatus(capacity, allocatable)
+	runtime.SetBehavior(fake.Behavior{
+		SimulateTransitions:   true,
+		StateTransitionDelay:  opts.StateTransitionDelay,
+		AttachDelay:           opts.AttachDelay,
+		StuckInstanceRatio:    opts.StuckInstanceRatio,
+		StuckInstanceSelector: opts.StuckInstanceSelector,
+	})
+
+	images := fake.NewFakeImageService(runtime)
+	images.CapacityBytes = imageFsCapacity
+	images.ImageSizeBytes = imageSize
+
+	faults := &faultInjector{
+		rates: errorRates,
+		code:  errorCode,
+	}
+	securityOpts, err := opts.APISecurity.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("error configuring api security: %w", err)
+	}
+	grpcSrv := grpc.NewServer(append(securityOpts,
+		grpc.ChainUnaryInterceptor(
+			utilgrpc.InjectLogger(ctrl.Log.WithName("server")),
+			utilgrpc.LogRequest,
+			faults.UnaryServerInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			faults.StreamServerInterceptor,
+		),
+	)...)
+	iri.RegisterRuntimeServiceServer(grpcSrv, iriremote.NewRuntimeServer(runtime))
+	iri.RegisterImageServiceServer(grpcSrv, iriremote.NewImageServer(images))
+
+	l, err := utilgrpc.Listen(opts.APIAddress, opts.APISocket)
+	if err != nil {
+		return fmt.Errorf("unable to create listener: %w", err)
+	}
+	defer func() { _ = l.Close() }()
+
+	done := make(chan struct{})
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer close(done)
+		defer cancel()
+
+		setupLog.Info("Starting grpc server", "Address", l.Addr().String())
+		_ = grpcSrv.Serve(l)
+		setupLog.Info("Stopping grpc server")
+	}()
+
+	<-ctx.Done()
+	grpcSrv.GracefulStop()
+	<-done
+	return nil
+}
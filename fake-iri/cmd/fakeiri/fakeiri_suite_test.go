@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fakeiri
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFakeIRI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FakeIRI Suite")
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fakeiri
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"path"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// faultInjector fails requests to RPCs at a configured rate.
+type faultInjector struct {
+	// rates are the error rates (between 0 and 1), keyed by RPC name (e.g. CreateInstance).
+	rates map[string]float64
+	code  codes.Code
+}
+
+func parseErrorRates(rates map[string]string) (map[string]float64, error) {
+	res := make(map[string]float64, len(rates))
+	for method, rateString := range rates {
+		rate, err := strconv.ParseFloat(rateString, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid error rate for %s: %w", method, err)
+		}
+		if rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("invalid error rate for %s: %v is not between 0 and 1", method, rate)
+		}
+		res[method] = rate
+	}
+	return res, nil
+}
+
+func (f *faultInjector) fault(fullMethod string) error {
+	method := path.Base(fullMethod)
+	rate, ok := f.rates[method]
+	if !ok || rand.Float64() >= rate {
+		return nil
+	}
+	return status.Errorf(f.code, "injected fault for %s", method)
+}
+
+func (f *faultInjector) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := f.fault(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (f *faultInjector) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := f.fault(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fakeiri
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+var _ = Describe("Faults", func() {
+	Describe("parseErrorRates", func() {
+		It("should parse error rates per RPC", func() {
+			Expect(parseErrorRates(map[string]string{
+				"CreateInstance": "0.1",
+				"DeleteInstance": "1",
+				"ListInstances":  "0",
+			})).To(Equal(map[string]float64{
+				"CreateInstance": 0.1,
+				"DeleteInstance": 1,
+				"ListInstances":  0,
+			}))
+		})
+
+		DescribeTable("should reject invalid error rates",
+			func(rate string) {
+				_, err := parseErrorRates(map[string]string{"CreateInstance": rate})
+				Expect(err).To(MatchError(ContainSubstring("CreateInstance")))
+			},
+			Entry("not a number", "foo"),
+			Entry("negative", "-0.1"),
+			Entry("greater than one", "1.5"),
+		)
+	})
+
+	Describe("parseErrorCode", func() {
+		It("should parse gRPC status code names", func() {
+			Expect(parseErrorCode("Unavailable")).To(Equal(codes.Unavailable))
+			Expect(parseErrorCode("ResourceExhausted")).To(Equal(codes.ResourceExhausted))
+		})
+
+		It("should reject unknown status code names", func() {
+			_, err := parseErrorCode("Unknown code")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should parse the fault flags", func() {
+		opts := NewOptions()
+		cmd := &cobra.Command{}
+		opts.AddFlags(cmd)
+
+		Expect(cmd.Flags().Parse([]string{
+			"--error-rate=CreateInstance=0.5,DeleteInstance=1",
+			"--error-code=Internal",
+			"--stuck-instance-ratio=0.25",
+			"--stuck-instance-selector=stuck=true",
+			"--state-transition-delay=2s",
+			"--attach-delay=500ms",
+		})).To(Succeed())
+
+		Expect(opts.ErrorRates).To(Equal(map[string]string{"CreateInstance": "0.5", "DeleteInstance": "1"}))
+		Expect(opts.ErrorCode).To(Equal("Internal"))
+		Expect(opts.StuckInstanceRatio).To(Equal(0.25))
+		Expect(opts.StuckInstanceSelector).To(Equal(map[string]string{"stuck": "true"}))
+		Expect(opts.StateTransitionDelay).To(Equal(2 * time.Second))
+		Expect(opts.AttachDelay).To(Equal(500 * time.Millisecond))
+	})
+
+	Describe("faultInjector", func() {
+		var createInstanceMethod = "/" + iri.RuntimeService_ServiceDesc.ServiceName + "/CreateInstance"
+
+		var (
+			handlerCalls int
+			handler      grpc.UnaryHandler
+		)
+		BeforeEach(func() {
+			handlerCalls = 0
+			handler = func(ctx context.Context, req any) (any, error) {
+				handlerCalls++
+				return req, nil
+			}
+		})
+
+		It("should never fail requests with an error rate of 0", func(ctx SpecContext) {
+			f := &faultInjector{rates: map[string]float64{"CreateInstance": 0}, code: codes.Unavailable}
+
+			for range 100 {
+				_, err := f.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: createInstanceMethod}, handler)
+				Expect(err).NotTo(HaveOccurred())
+			}
+			Expect(handlerCalls).To(Equal(100))
+		})
+
+		It("should always fail requests with an error rate of 1", func(ctx SpecContext) {
+			f := &faultInjector{rates: map[string]float64{"CreateInstance": 1}, code: codes.Internal}
+
+			for range 100 {
+				_, err := f.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: createInstanceMethod}, handler)
+				Expect(status.Code(err)).To(Equal(codes.Internal))
+			}
+			Expect(handlerCalls).To(BeZero())
+
+			By("failing streams as well")
+			err := f.StreamServerInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: createInstanceMethod},
+				func(srv any, stream grpc.ServerStream) error {
+					handlerCalls++
+					return nil
+				})
+			Expect(status.Code(err)).To(Equal(codes.Internal))
+			Expect(handlerCalls).To(BeZero())
+		})
+
+		It("should not fail requests to RPCs without an error rate", func(ctx SpecContext) {
+			f := &faultInjector{rates: map[string]float64{"CreateInstance": 1}, code: codes.Unavailable}
+
+			_, err := f.UnaryServerInterceptor(ctx, nil,
+				&grpc.UnaryServerInfo{FullMethod: "/" + iri.RuntimeService_ServiceDesc.ServiceName + "/ListInstances"}, handler)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handlerCalls).To(Equal(1))
+		})
+
+		It("should fail about the configured ratio of requests", func(ctx SpecContext) {
+			f := &faultInjector{rates: map[string]float64{"CreateInstance": 0.5}, code: codes.Unavailable}
+
+			var failed int
+			for range 1000 {
+				if _, err := f.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: createInstanceMethod}, handler); err != nil {
+					failed++
+				}
+			}
+			Expect(failed).To(BeNumerically("~", 500, 150))
+		})
+	})
+})
@@ -25,4 +25,8 @@ const (
 	DefaultEphemeralManager = "ephemeral-manager"
 
 	FinalizerNetwork = "core.spheric.cloud/network"
+
+	FinalizerDiskSnapshot = "core.spheric.cloud/disksnapshot"
+
+	FinalizerDiskRestore = "core.spheric.cloud/diskrestore"
 )
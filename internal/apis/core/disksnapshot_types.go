@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DiskSnapshotSpec defines the desired state of DiskSnapshot
+type DiskSnapshotSpec struct {
+	// DiskRef references the Disk to take the snapshot of.
+	DiskRef LocalObjectReference
+}
+
+// DiskSnapshotStatus defines the observed state of DiskSnapshot
+type DiskSnapshotStatus struct {
+	// ReadyToUse reports whether the DiskSnapshot can be used as data source of a Disk.
+	ReadyToUse bool
+	// Size is the size of the data of the DiskSnapshot.
+	Size *resource.Quantity
+	// CreationTime is the time the snapshot was taken by the underlying driver.
+	CreationTime *metav1.Time
+	// Conditions are the conditions of the DiskSnapshot.
+	Conditions []DiskSnapshotCondition
+}
+
+// DiskSnapshotConditionType is a type a DiskSnapshotCondition can have.
+type DiskSnapshotConditionType string
+
+const (
+	// DiskSnapshotLost is True if the snapshot was taken but does not exist in the driver anymore.
+	DiskSnapshotLost DiskSnapshotConditionType = "Lost"
+)
+
+// DiskSnapshotCondition is one of the conditions of a disk snapshot.
+type DiskSnapshotCondition struct {
+	// Type is the type of the condition.
+	Type DiskSnapshotConditionType
+	// Status is the status of the condition.
+	Status corev1.ConditionStatus
+	// Reason is a machine-readable indication of why the condition is in a certain state.
+	Reason string
+	// Message is a human-readable explanation of why the condition has a certain reason / state.
+	Message string
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+	ObservedGeneration int64
+	// LastTransitionTime is the last time the status of a condition has transitioned from one state to another.
+	LastTransitionTime metav1.Time
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+
+// DiskSnapshot is the Schema for the disksnapshots API
+type DiskSnapshot struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   DiskSnapshotSpec
+	Status DiskSnapshotStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiskSnapshotList contains a list of DiskSnapshot
+type DiskSnapshotList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []DiskSnapshot
+}
@@ -40,6 +40,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Disk{},
 		&DiskList{},
+		&DiskSnapshot{},
+		&DiskSnapshotList{},
 		&DiskType{},
 		&DiskTypeList{},
 		&Fleet{},
@@ -47,6 +49,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Instance{},
 		&InstanceList{},
 		&InstanceExecOptions{},
+		&InstanceLogOptions{},
+		&InstancePortForwardOptions{},
+		&InstanceStatsOptions{},
 		&InstanceType{},
 		&InstanceTypeList{},
 		&Network{},
@@ -40,4 +40,7 @@ func SetDefaults_InstanceSpec(spec *v1alpha1.InstanceSpec) {
 	if spec.Power == "" {
 		spec.Power = v1alpha1.PowerOn
 	}
+	if spec.RestartType == "" {
+		spec.RestartType = v1alpha1.RestartGraceful
+	}
 }
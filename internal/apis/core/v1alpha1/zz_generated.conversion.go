@@ -99,6 +99,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskDataSource)(nil), (*core.DiskDataSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskDataSource_To_core_DiskDataSource(a.(*v1alpha1.DiskDataSource), b.(*core.DiskDataSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskDataSource)(nil), (*v1alpha1.DiskDataSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskDataSource_To_v1alpha1_DiskDataSource(a.(*core.DiskDataSource), b.(*v1alpha1.DiskDataSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskList)(nil), (*core.DiskList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiskList_To_core_DiskList(a.(*v1alpha1.DiskList), b.(*core.DiskList), scope)
 	}); err != nil {
@@ -109,6 +119,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSnapshot)(nil), (*core.DiskSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSnapshot_To_core_DiskSnapshot(a.(*v1alpha1.DiskSnapshot), b.(*core.DiskSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskSnapshot)(nil), (*v1alpha1.DiskSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskSnapshot_To_v1alpha1_DiskSnapshot(a.(*core.DiskSnapshot), b.(*v1alpha1.DiskSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSnapshotCondition)(nil), (*core.DiskSnapshotCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSnapshotCondition_To_core_DiskSnapshotCondition(a.(*v1alpha1.DiskSnapshotCondition), b.(*core.DiskSnapshotCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskSnapshotCondition)(nil), (*v1alpha1.DiskSnapshotCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskSnapshotCondition_To_v1alpha1_DiskSnapshotCondition(a.(*core.DiskSnapshotCondition), b.(*v1alpha1.DiskSnapshotCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSnapshotList)(nil), (*core.DiskSnapshotList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSnapshotList_To_core_DiskSnapshotList(a.(*v1alpha1.DiskSnapshotList), b.(*core.DiskSnapshotList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskSnapshotList)(nil), (*v1alpha1.DiskSnapshotList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskSnapshotList_To_v1alpha1_DiskSnapshotList(a.(*core.DiskSnapshotList), b.(*v1alpha1.DiskSnapshotList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSnapshotSpec)(nil), (*core.DiskSnapshotSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec(a.(*v1alpha1.DiskSnapshotSpec), b.(*core.DiskSnapshotSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskSnapshotSpec)(nil), (*v1alpha1.DiskSnapshotSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec(a.(*core.DiskSnapshotSpec), b.(*v1alpha1.DiskSnapshotSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSnapshotStatus)(nil), (*core.DiskSnapshotStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus(a.(*v1alpha1.DiskSnapshotStatus), b.(*core.DiskSnapshotStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DiskSnapshotStatus)(nil), (*v1alpha1.DiskSnapshotStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus(a.(*core.DiskSnapshotStatus), b.(*v1alpha1.DiskSnapshotStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.DiskSpec)(nil), (*core.DiskSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiskSpec_To_core_DiskSpec(a.(*v1alpha1.DiskSpec), b.(*core.DiskSpec), scope)
 	}); err != nil {
@@ -239,6 +299,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.FleetRuntimeInfo)(nil), (*core.FleetRuntimeInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FleetRuntimeInfo_To_core_FleetRuntimeInfo(a.(*v1alpha1.FleetRuntimeInfo), b.(*core.FleetRuntimeInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FleetRuntimeInfo)(nil), (*v1alpha1.FleetRuntimeInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FleetRuntimeInfo_To_v1alpha1_FleetRuntimeInfo(a.(*core.FleetRuntimeInfo), b.(*v1alpha1.FleetRuntimeInfo), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.FleetSpec)(nil), (*core.FleetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FleetSpec_To_core_FleetSpec(a.(*v1alpha1.FleetSpec), b.(*core.FleetSpec), scope)
 	}); err != nil {
@@ -269,6 +339,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceCondition)(nil), (*core.InstanceCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceCondition_To_core_InstanceCondition(a.(*v1alpha1.InstanceCondition), b.(*core.InstanceCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstanceCondition)(nil), (*v1alpha1.InstanceCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstanceCondition_To_v1alpha1_InstanceCondition(a.(*core.InstanceCondition), b.(*v1alpha1.InstanceCondition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceExecOptions)(nil), (*core.InstanceExecOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstanceExecOptions_To_core_InstanceExecOptions(a.(*v1alpha1.InstanceExecOptions), b.(*core.InstanceExecOptions), scope)
 	}); err != nil {
@@ -289,6 +369,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceLogOptions)(nil), (*core.InstanceLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceLogOptions_To_core_InstanceLogOptions(a.(*v1alpha1.InstanceLogOptions), b.(*core.InstanceLogOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstanceLogOptions)(nil), (*v1alpha1.InstanceLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstanceLogOptions_To_v1alpha1_InstanceLogOptions(a.(*core.InstanceLogOptions), b.(*v1alpha1.InstanceLogOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstancePortForwardOptions)(nil), (*core.InstancePortForwardOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstancePortForwardOptions_To_core_InstancePortForwardOptions(a.(*v1alpha1.InstancePortForwardOptions), b.(*core.InstancePortForwardOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstancePortForwardOptions)(nil), (*v1alpha1.InstancePortForwardOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstancePortForwardOptions_To_v1alpha1_InstancePortForwardOptions(a.(*core.InstancePortForwardOptions), b.(*v1alpha1.InstancePortForwardOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceSpec)(nil), (*core.InstanceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstanceSpec_To_core_InstanceSpec(a.(*v1alpha1.InstanceSpec), b.(*core.InstanceSpec), scope)
 	}); err != nil {
@@ -299,6 +399,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceStatsOptions)(nil), (*core.InstanceStatsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstanceStatsOptions_To_core_InstanceStatsOptions(a.(*v1alpha1.InstanceStatsOptions), b.(*core.InstanceStatsOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstanceStatsOptions)(nil), (*v1alpha1.InstanceStatsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstanceStatsOptions_To_v1alpha1_InstanceStatsOptions(a.(*core.InstanceStatsOptions), b.(*v1alpha1.InstanceStatsOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.InstanceStatus)(nil), (*core.InstanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstanceStatus_To_core_InstanceStatus(a.(*v1alpha1.InstanceStatus), b.(*core.InstanceStatus), scope)
 	}); err != nil {
@@ -514,6 +624,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*v1alpha1.InstanceLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1alpha1_InstanceLogOptions(a.(*url.Values), b.(*v1alpha1.InstanceLogOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*v1alpha1.InstancePortForwardOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1alpha1_InstancePortForwardOptions(a.(*url.Values), b.(*v1alpha1.InstancePortForwardOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*v1alpha1.InstanceStatsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1alpha1_InstanceStatsOptions(a.(*url.Values), b.(*v1alpha1.InstanceStatsOptions), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -693,6 +818,26 @@ func Convert_core_DiskAccess_To_v1alpha1_DiskAccess(in *core.DiskAccess, out *v1
 	return autoConvert_core_DiskAccess_To_v1alpha1_DiskAccess(in, out, s)
 }
 
+func autoConvert_v1alpha1_DiskDataSource_To_core_DiskDataSource(in *v1alpha1.DiskDataSource, out *core.DiskDataSource, s conversion.Scope) error {
+	out.SnapshotRef = (*core.LocalObjectReference)(unsafe.Pointer(in.SnapshotRef))
+	return nil
+}
+
+// Convert_v1alpha1_DiskDataSource_To_core_DiskDataSource is an autogenerated conversion function.
+func Convert_v1alpha1_DiskDataSource_To_core_DiskDataSource(in *v1alpha1.DiskDataSource, out *core.DiskDataSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskDataSource_To_core_DiskDataSource(in, out, s)
+}
+
+func autoConvert_core_DiskDataSource_To_v1alpha1_DiskDataSource(in *core.DiskDataSource, out *v1alpha1.DiskDataSource, s conversion.Scope) error {
+	out.SnapshotRef = (*v1alpha1.LocalObjectReference)(unsafe.Pointer(in.SnapshotRef))
+	return nil
+}
+
+// Convert_core_DiskDataSource_To_v1alpha1_DiskDataSource is an autogenerated conversion function.
+func Convert_core_DiskDataSource_To_v1alpha1_DiskDataSource(in *core.DiskDataSource, out *v1alpha1.DiskDataSource, s conversion.Scope) error {
+	return autoConvert_core_DiskDataSource_To_v1alpha1_DiskDataSource(in, out, s)
+}
+
 func autoConvert_v1alpha1_DiskList_To_core_DiskList(in *v1alpha1.DiskList, out *core.DiskList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.Disk)(unsafe.Pointer(&in.Items))
@@ -715,12 +860,147 @@ func Convert_core_DiskList_To_v1alpha1_DiskList(in *core.DiskList, out *v1alpha1
 	return autoConvert_core_DiskList_To_v1alpha1_DiskList(in, out, s)
 }
 
+func autoConvert_v1alpha1_DiskSnapshot_To_core_DiskSnapshot(in *v1alpha1.DiskSnapshot, out *core.DiskSnapshot, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DiskSnapshot_To_core_DiskSnapshot is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSnapshot_To_core_DiskSnapshot(in *v1alpha1.DiskSnapshot, out *core.DiskSnapshot, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSnapshot_To_core_DiskSnapshot(in, out, s)
+}
+
+func autoConvert_core_DiskSnapshot_To_v1alpha1_DiskSnapshot(in *core.DiskSnapshot, out *v1alpha1.DiskSnapshot, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_DiskSnapshot_To_v1alpha1_DiskSnapshot is an autogenerated conversion function.
+func Convert_core_DiskSnapshot_To_v1alpha1_DiskSnapshot(in *core.DiskSnapshot, out *v1alpha1.DiskSnapshot, s conversion.Scope) error {
+	return autoConvert_core_DiskSnapshot_To_v1alpha1_DiskSnapshot(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskSnapshotCondition_To_core_DiskSnapshotCondition(in *v1alpha1.DiskSnapshotCondition, out *core.DiskSnapshotCondition, s conversion.Scope) error {
+	out.Type = core.DiskSnapshotConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_DiskSnapshotCondition_To_core_DiskSnapshotCondition is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSnapshotCondition_To_core_DiskSnapshotCondition(in *v1alpha1.DiskSnapshotCondition, out *core.DiskSnapshotCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSnapshotCondition_To_core_DiskSnapshotCondition(in, out, s)
+}
+
+func autoConvert_core_DiskSnapshotCondition_To_v1alpha1_DiskSnapshotCondition(in *core.DiskSnapshotCondition, out *v1alpha1.DiskSnapshotCondition, s conversion.Scope) error {
+	out.Type = v1alpha1.DiskSnapshotConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_core_DiskSnapshotCondition_To_v1alpha1_DiskSnapshotCondition is an autogenerated conversion function.
+func Convert_core_DiskSnapshotCondition_To_v1alpha1_DiskSnapshotCondition(in *core.DiskSnapshotCondition, out *v1alpha1.DiskSnapshotCondition, s conversion.Scope) error {
+	return autoConvert_core_DiskSnapshotCondition_To_v1alpha1_DiskSnapshotCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskSnapshotList_To_core_DiskSnapshotList(in *v1alpha1.DiskSnapshotList, out *core.DiskSnapshotList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.DiskSnapshot)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_DiskSnapshotList_To_core_DiskSnapshotList is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSnapshotList_To_core_DiskSnapshotList(in *v1alpha1.DiskSnapshotList, out *core.DiskSnapshotList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSnapshotList_To_core_DiskSnapshotList(in, out, s)
+}
+
+func autoConvert_core_DiskSnapshotList_To_v1alpha1_DiskSnapshotList(in *core.DiskSnapshotList, out *v1alpha1.DiskSnapshotList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.DiskSnapshot)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_DiskSnapshotList_To_v1alpha1_DiskSnapshotList is an autogenerated conversion function.
+func Convert_core_DiskSnapshotList_To_v1alpha1_DiskSnapshotList(in *core.DiskSnapshotList, out *v1alpha1.DiskSnapshotList, s conversion.Scope) error {
+	return autoConvert_core_DiskSnapshotList_To_v1alpha1_DiskSnapshotList(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec(in *v1alpha1.DiskSnapshotSpec, out *core.DiskSnapshotSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_LocalObjectReference_To_core_LocalObjectReference(&in.DiskRef, &out.DiskRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec(in *v1alpha1.DiskSnapshotSpec, out *core.DiskSnapshotSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSnapshotSpec_To_core_DiskSnapshotSpec(in, out, s)
+}
+
+func autoConvert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec(in *core.DiskSnapshotSpec, out *v1alpha1.DiskSnapshotSpec, s conversion.Scope) error {
+	if err := Convert_core_LocalObjectReference_To_v1alpha1_LocalObjectReference(&in.DiskRef, &out.DiskRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec is an autogenerated conversion function.
+func Convert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec(in *core.DiskSnapshotSpec, out *v1alpha1.DiskSnapshotSpec, s conversion.Scope) error {
+	return autoConvert_core_DiskSnapshotSpec_To_v1alpha1_DiskSnapshotSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus(in *v1alpha1.DiskSnapshotStatus, out *core.DiskSnapshotStatus, s conversion.Scope) error {
+	out.ReadyToUse = in.ReadyToUse
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.CreationTime = (*v1.Time)(unsafe.Pointer(in.CreationTime))
+	out.Conditions = *(*[]core.DiskSnapshotCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus(in *v1alpha1.DiskSnapshotStatus, out *core.DiskSnapshotStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskSnapshotStatus_To_core_DiskSnapshotStatus(in, out, s)
+}
+
+func autoConvert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus(in *core.DiskSnapshotStatus, out *v1alpha1.DiskSnapshotStatus, s conversion.Scope) error {
+	out.ReadyToUse = in.ReadyToUse
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.CreationTime = (*v1.Time)(unsafe.Pointer(in.CreationTime))
+	out.Conditions = *(*[]v1alpha1.DiskSnapshotCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus is an autogenerated conversion function.
+func Convert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus(in *core.DiskSnapshotStatus, out *v1alpha1.DiskSnapshotStatus, s conversion.Scope) error {
+	return autoConvert_core_DiskSnapshotStatus_To_v1alpha1_DiskSnapshotStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_DiskSpec_To_core_DiskSpec(in *v1alpha1.DiskSpec, out *core.DiskSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_LocalObjectReference_To_core_LocalObjectReference(&in.TypeRef, &out.TypeRef, s); err != nil {
 		return err
 	}
 	out.InstanceRef = (*core.LocalUIDReference)(unsafe.Pointer(in.InstanceRef))
 	out.Resources = *(*core.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.DataSource = (*core.DiskDataSource)(unsafe.Pointer(in.DataSource))
 	return nil
 }
 
@@ -735,6 +1015,7 @@ func autoConvert_core_DiskSpec_To_v1alpha1_DiskSpec(in *core.DiskSpec, out *v1al
 	}
 	out.InstanceRef = (*v1alpha1.LocalUIDReference)(unsafe.Pointer(in.InstanceRef))
 	out.Resources = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.DataSource = (*v1alpha1.DiskDataSource)(unsafe.Pointer(in.DataSource))
 	return nil
 }
 
@@ -1029,6 +1310,30 @@ func Convert_core_FleetList_To_v1alpha1_FleetList(in *core.FleetList, out *v1alp
 	return autoConvert_core_FleetList_To_v1alpha1_FleetList(in, out, s)
 }
 
+func autoConvert_v1alpha1_FleetRuntimeInfo_To_core_FleetRuntimeInfo(in *v1alpha1.FleetRuntimeInfo, out *core.FleetRuntimeInfo, s conversion.Scope) error {
+	out.RuntimeName = in.RuntimeName
+	out.RuntimeVersion = in.RuntimeVersion
+	out.Capabilities = *(*[]core.FleetCapability)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_v1alpha1_FleetRuntimeInfo_To_core_FleetRuntimeInfo is an autogenerated conversion function.
+func Convert_v1alpha1_FleetRuntimeInfo_To_core_FleetRuntimeInfo(in *v1alpha1.FleetRuntimeInfo, out *core.FleetRuntimeInfo, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FleetRuntimeInfo_To_core_FleetRuntimeInfo(in, out, s)
+}
+
+func autoConvert_core_FleetRuntimeInfo_To_v1alpha1_FleetRuntimeInfo(in *core.FleetRuntimeInfo, out *v1alpha1.FleetRuntimeInfo, s conversion.Scope) error {
+	out.RuntimeName = in.RuntimeName
+	out.RuntimeVersion = in.RuntimeVersion
+	out.Capabilities = *(*[]v1alpha1.FleetCapability)(unsafe.Pointer(&in.Capabilities))
+	return nil
+}
+
+// Convert_core_FleetRuntimeInfo_To_v1alpha1_FleetRuntimeInfo is an autogenerated conversion function.
+func Convert_core_FleetRuntimeInfo_To_v1alpha1_FleetRuntimeInfo(in *core.FleetRuntimeInfo, out *v1alpha1.FleetRuntimeInfo, s conversion.Scope) error {
+	return autoConvert_core_FleetRuntimeInfo_To_v1alpha1_FleetRuntimeInfo(in, out, s)
+}
+
 func autoConvert_v1alpha1_FleetSpec_To_core_FleetSpec(in *v1alpha1.FleetSpec, out *core.FleetSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
 	out.Taints = *(*[]core.Taint)(unsafe.Pointer(&in.Taints))
@@ -1060,6 +1365,7 @@ func autoConvert_v1alpha1_FleetStatus_To_core_FleetStatus(in *v1alpha1.FleetStat
 	}
 	out.Capacity = *(*core.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.Allocatable = *(*core.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.RuntimeInfo = (*core.FleetRuntimeInfo)(unsafe.Pointer(in.RuntimeInfo))
 	return nil
 }
 
@@ -1077,6 +1383,7 @@ func autoConvert_core_FleetStatus_To_v1alpha1_FleetStatus(in *core.FleetStatus,
 	}
 	out.Capacity = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.Allocatable = *(*v1alpha1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.RuntimeInfo = (*v1alpha1.FleetRuntimeInfo)(unsafe.Pointer(in.RuntimeInfo))
 	return nil
 }
 
@@ -1117,6 +1424,36 @@ func Convert_core_Instance_To_v1alpha1_Instance(in *core.Instance, out *v1alpha1
 	return autoConvert_core_Instance_To_v1alpha1_Instance(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceCondition_To_core_InstanceCondition(in *v1alpha1.InstanceCondition, out *core.InstanceCondition, s conversion.Scope) error {
+	out.Type = core.InstanceConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_InstanceCondition_To_core_InstanceCondition is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceCondition_To_core_InstanceCondition(in *v1alpha1.InstanceCondition, out *core.InstanceCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceCondition_To_core_InstanceCondition(in, out, s)
+}
+
+func autoConvert_core_InstanceCondition_To_v1alpha1_InstanceCondition(in *core.InstanceCondition, out *v1alpha1.InstanceCondition, s conversion.Scope) error {
+	out.Type = v1alpha1.InstanceConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_core_InstanceCondition_To_v1alpha1_InstanceCondition is an autogenerated conversion function.
+func Convert_core_InstanceCondition_To_v1alpha1_InstanceCondition(in *core.InstanceCondition, out *v1alpha1.InstanceCondition, s conversion.Scope) error {
+	return autoConvert_core_InstanceCondition_To_v1alpha1_InstanceCondition(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstanceExecOptions_To_core_InstanceExecOptions(in *v1alpha1.InstanceExecOptions, out *core.InstanceExecOptions, s conversion.Scope) error {
 	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
 	return nil
@@ -1177,6 +1514,118 @@ func Convert_core_InstanceList_To_v1alpha1_InstanceList(in *core.InstanceList, o
 	return autoConvert_core_InstanceList_To_v1alpha1_InstanceList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceLogOptions_To_core_InstanceLogOptions(in *v1alpha1.InstanceLogOptions, out *core.InstanceLogOptions, s conversion.Scope) error {
+	out.Follow = in.Follow
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.SinceSeconds = (*int64)(unsafe.Pointer(in.SinceSeconds))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_v1alpha1_InstanceLogOptions_To_core_InstanceLogOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceLogOptions_To_core_InstanceLogOptions(in *v1alpha1.InstanceLogOptions, out *core.InstanceLogOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceLogOptions_To_core_InstanceLogOptions(in, out, s)
+}
+
+func autoConvert_core_InstanceLogOptions_To_v1alpha1_InstanceLogOptions(in *core.InstanceLogOptions, out *v1alpha1.InstanceLogOptions, s conversion.Scope) error {
+	out.Follow = in.Follow
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.SinceSeconds = (*int64)(unsafe.Pointer(in.SinceSeconds))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_core_InstanceLogOptions_To_v1alpha1_InstanceLogOptions is an autogenerated conversion function.
+func Convert_core_InstanceLogOptions_To_v1alpha1_InstanceLogOptions(in *core.InstanceLogOptions, out *v1alpha1.InstanceLogOptions, s conversion.Scope) error {
+	return autoConvert_core_InstanceLogOptions_To_v1alpha1_InstanceLogOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1alpha1_InstanceLogOptions(in *url.Values, out *v1alpha1.InstanceLogOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["follow"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Follow, s); err != nil {
+			return err
+		}
+	} else {
+		out.Follow = false
+	}
+	if values, ok := map[string][]string(*in)["tailLines"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.TailLines, s); err != nil {
+			return err
+		}
+	} else {
+		out.TailLines = nil
+	}
+	if values, ok := map[string][]string(*in)["sinceSeconds"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.SinceSeconds, s); err != nil {
+			return err
+		}
+	} else {
+		out.SinceSeconds = nil
+	}
+	if values, ok := map[string][]string(*in)["insecureSkipTLSVerifyBackend"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.InsecureSkipTLSVerifyBackend, s); err != nil {
+			return err
+		}
+	} else {
+		out.InsecureSkipTLSVerifyBackend = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1alpha1_InstanceLogOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1alpha1_InstanceLogOptions(in *url.Values, out *v1alpha1.InstanceLogOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1alpha1_InstanceLogOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstancePortForwardOptions_To_core_InstancePortForwardOptions(in *v1alpha1.InstancePortForwardOptions, out *core.InstancePortForwardOptions, s conversion.Scope) error {
+	out.Ports = *(*[]int32)(unsafe.Pointer(&in.Ports))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_v1alpha1_InstancePortForwardOptions_To_core_InstancePortForwardOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstancePortForwardOptions_To_core_InstancePortForwardOptions(in *v1alpha1.InstancePortForwardOptions, out *core.InstancePortForwardOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstancePortForwardOptions_To_core_InstancePortForwardOptions(in, out, s)
+}
+
+func autoConvert_core_InstancePortForwardOptions_To_v1alpha1_InstancePortForwardOptions(in *core.InstancePortForwardOptions, out *v1alpha1.InstancePortForwardOptions, s conversion.Scope) error {
+	out.Ports = *(*[]int32)(unsafe.Pointer(&in.Ports))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_core_InstancePortForwardOptions_To_v1alpha1_InstancePortForwardOptions is an autogenerated conversion function.
+func Convert_core_InstancePortForwardOptions_To_v1alpha1_InstancePortForwardOptions(in *core.InstancePortForwardOptions, out *v1alpha1.InstancePortForwardOptions, s conversion.Scope) error {
+	return autoConvert_core_InstancePortForwardOptions_To_v1alpha1_InstancePortForwardOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1alpha1_InstancePortForwardOptions(in *url.Values, out *v1alpha1.InstancePortForwardOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["ports"]; ok && len(values) > 0 {
+		if err := v1.Convert_Slice_string_To_Slice_int32(&values, &out.Ports, s); err != nil {
+			return err
+		}
+	} else {
+		out.Ports = nil
+	}
+	if values, ok := map[string][]string(*in)["insecureSkipTLSVerifyBackend"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.InsecureSkipTLSVerifyBackend, s); err != nil {
+			return err
+		}
+	} else {
+		out.InsecureSkipTLSVerifyBackend = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1alpha1_InstancePortForwardOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1alpha1_InstancePortForwardOptions(in *url.Values, out *v1alpha1.InstancePortForwardOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1alpha1_InstancePortForwardOptions(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstanceSpec_To_core_InstanceSpec(in *v1alpha1.InstanceSpec, out *core.InstanceSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_LocalObjectReference_To_core_LocalObjectReference(&in.InstanceTypeRef, &out.InstanceTypeRef, s); err != nil {
 		return err
@@ -1191,6 +1640,8 @@ func autoConvert_v1alpha1_InstanceSpec_To_core_InstanceSpec(in *v1alpha1.Instanc
 	out.IgnitionRef = (*core.SecretKeySelector)(unsafe.Pointer(in.IgnitionRef))
 	out.EFIVars = *(*[]core.EFIVar)(unsafe.Pointer(&in.EFIVars))
 	out.Tolerations = *(*[]core.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.RestartGeneration = in.RestartGeneration
+	out.RestartType = core.RestartType(in.RestartType)
 	return nil
 }
 
@@ -1213,6 +1664,8 @@ func autoConvert_core_InstanceSpec_To_v1alpha1_InstanceSpec(in *core.InstanceSpe
 	out.IgnitionRef = (*v1alpha1.SecretKeySelector)(unsafe.Pointer(in.IgnitionRef))
 	out.EFIVars = *(*[]v1alpha1.EFIVar)(unsafe.Pointer(&in.EFIVars))
 	out.Tolerations = *(*[]v1alpha1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.RestartGeneration = in.RestartGeneration
+	out.RestartType = v1alpha1.RestartType(in.RestartType)
 	return nil
 }
 
@@ -1221,12 +1674,53 @@ func Convert_core_InstanceSpec_To_v1alpha1_InstanceSpec(in *core.InstanceSpec, o
 	return autoConvert_core_InstanceSpec_To_v1alpha1_InstanceSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstanceStatsOptions_To_core_InstanceStatsOptions(in *v1alpha1.InstanceStatsOptions, out *core.InstanceStatsOptions, s conversion.Scope) error {
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_v1alpha1_InstanceStatsOptions_To_core_InstanceStatsOptions is an autogenerated conversion function.
+func Convert_v1alpha1_InstanceStatsOptions_To_core_InstanceStatsOptions(in *v1alpha1.InstanceStatsOptions, out *core.InstanceStatsOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstanceStatsOptions_To_core_InstanceStatsOptions(in, out, s)
+}
+
+func autoConvert_core_InstanceStatsOptions_To_v1alpha1_InstanceStatsOptions(in *core.InstanceStatsOptions, out *v1alpha1.InstanceStatsOptions, s conversion.Scope) error {
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_core_InstanceStatsOptions_To_v1alpha1_InstanceStatsOptions is an autogenerated conversion function.
+func Convert_core_InstanceStatsOptions_To_v1alpha1_InstanceStatsOptions(in *core.InstanceStatsOptions, out *v1alpha1.InstanceStatsOptions, s conversion.Scope) error {
+	return autoConvert_core_InstanceStatsOptions_To_v1alpha1_InstanceStatsOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1alpha1_InstanceStatsOptions(in *url.Values, out *v1alpha1.InstanceStatsOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["insecureSkipTLSVerifyBackend"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.InsecureSkipTLSVerifyBackend, s); err != nil {
+			return err
+		}
+	} else {
+		out.InsecureSkipTLSVerifyBackend = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1alpha1_InstanceStatsOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1alpha1_InstanceStatsOptions(in *url.Values, out *v1alpha1.InstanceStatsOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1alpha1_InstanceStatsOptions(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstanceStatus_To_core_InstanceStatus(in *v1alpha1.InstanceStatus, out *core.InstanceStatus, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
 	out.ObservedGeneration = in.ObservedGeneration
+	out.ObservedRestartGeneration = in.ObservedRestartGeneration
 	out.State = core.InstanceState(in.State)
+	out.InstanceTypeRef = (*core.LocalObjectReference)(unsafe.Pointer(in.InstanceTypeRef))
 	out.NetworkInterfaces = *(*[]core.NetworkInterfaceStatus)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.Disks = *(*[]core.AttachedDiskStatus)(unsafe.Pointer(&in.Disks))
+	out.Conditions = *(*[]core.InstanceCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -1238,9 +1732,12 @@ func Convert_v1alpha1_InstanceStatus_To_core_InstanceStatus(in *v1alpha1.Instanc
 func autoConvert_core_InstanceStatus_To_v1alpha1_InstanceStatus(in *core.InstanceStatus, out *v1alpha1.InstanceStatus, s conversion.Scope) error {
 	out.InstanceID = in.InstanceID
 	out.ObservedGeneration = in.ObservedGeneration
+	out.ObservedRestartGeneration = in.ObservedRestartGeneration
 	out.State = v1alpha1.InstanceState(in.State)
+	out.InstanceTypeRef = (*v1alpha1.LocalObjectReference)(unsafe.Pointer(in.InstanceTypeRef))
 	out.NetworkInterfaces = *(*[]v1alpha1.NetworkInterfaceStatus)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.Disks = *(*[]v1alpha1.AttachedDiskStatus)(unsafe.Pointer(&in.Disks))
+	out.Conditions = *(*[]v1alpha1.InstanceCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
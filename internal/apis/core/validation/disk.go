@@ -13,6 +13,24 @@ func ValidateDisk(disk *core.Disk) field.ErrorList {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(disk, true, validation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateDiskSpec(&disk.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateDiskSpec(spec *core.DiskSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if dataSource := spec.DataSource; dataSource != nil {
+		dataSourcePath := fldPath.Child("dataSource")
+		if dataSource.SnapshotRef == nil {
+			allErrs = append(allErrs, field.Required(dataSourcePath.Child("snapshotRef"), "must specify snapshot ref"))
+		} else {
+			for _, msg := range validation.NameIsDNSLabel(dataSource.SnapshotRef.Name, false) {
+				allErrs = append(allErrs, field.Invalid(dataSourcePath.Child("snapshotRef", "name"), dataSource.SnapshotRef.Name, msg))
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -22,6 +40,7 @@ func ValidateDiskUpdate(oldDisk, newDisk *core.Disk) field.ErrorList {
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(oldDisk, newDisk, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateDisk(newDisk)...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newDisk.Spec.DataSource, oldDisk.Spec.DataSource, field.NewPath("spec", "dataSource"))...)
 
 	return allErrs
 }
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"spheric.cloud/spheric/internal/apis/core"
+)
+
+func ValidateDiskSnapshot(diskSnapshot *core.DiskSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(diskSnapshot, true, validation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateDiskSnapshotSpec(&diskSnapshot.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateDiskSnapshotSpec(spec *core.DiskSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.DiskRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("diskRef", "name"), "must specify disk ref name"))
+	} else {
+		for _, msg := range validation.NameIsDNSLabel(spec.DiskRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("diskRef", "name"), spec.DiskRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateDiskSnapshotUpdate(oldDiskSnapshot, newDiskSnapshot *core.DiskSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newDiskSnapshot, oldDiskSnapshot, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateDiskSnapshot(newDiskSnapshot)...)
+	allErrs = append(allErrs, validation.ValidateImmutableField(newDiskSnapshot.Spec, oldDiskSnapshot.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
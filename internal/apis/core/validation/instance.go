@@ -5,6 +5,7 @@ package validation
 
 import (
 	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"spheric.cloud/spheric/internal/apis/core"
 )
@@ -13,6 +14,23 @@ func ValidateInstance(instance *core.Instance) field.ErrorList {
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, validation.ValidateObjectMetaAccessor(instance, true, validation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateInstanceSpec(&instance.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+var supportedRestartTypes = sets.New(
+	core.RestartGraceful,
+	core.RestartHard,
+)
+
+func validateInstanceSpec(spec *core.InstanceSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validation.ValidateNonnegativeField(spec.RestartGeneration, fldPath.Child("restartGeneration"))...)
+	if spec.RestartType != "" && !supportedRestartTypes.Has(spec.RestartType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("restartType"), spec.RestartType, sets.List(supportedRestartTypes)))
+	}
 
 	return allErrs
 }
@@ -20,8 +38,25 @@ func ValidateInstance(instance *core.Instance) field.ErrorList {
 func ValidateInstanceUpdate(oldInstance, newInstance *core.Instance) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(oldInstance, newInstance, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validation.ValidateObjectMetaAccessorUpdate(newInstance, oldInstance, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateInstance(newInstance)...)
 
+	if newInstance.Spec.RestartGeneration < oldInstance.Spec.RestartGeneration {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "restartGeneration"), newInstance.Spec.RestartGeneration, "must not be decreased"))
+	}
+
+	return allErrs
+}
+
+func ValidateInstanceLogOptions(opts *core.InstanceLogOptions) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if opts.TailLines != nil {
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(*opts.TailLines, field.NewPath("tailLines"))...)
+	}
+	if opts.SinceSeconds != nil && *opts.SinceSeconds < 1 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("sinceSeconds"), *opts.SinceSeconds, "must be greater than 0"))
+	}
+
 	return allErrs
 }
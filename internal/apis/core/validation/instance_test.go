@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"spheric.cloud/spheric/internal/apis/core"
+	. "spheric.cloud/spheric/internal/apis/core/validation"
+)
+
+var _ = Describe("Instance", func() {
+	newInstance := func(restartGeneration int64, restartType core.RestartType) *core.Instance {
+		return &core.Instance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo", ResourceVersion: "1"},
+			Spec: core.InstanceSpec{
+				RestartGeneration: restartGeneration,
+				RestartType:       restartType,
+			},
+		}
+	}
+
+	fieldErrors := func(errs field.ErrorList) []string {
+		var fields []string
+		for _, err := range errs {
+			fields = append(fields, err.Field)
+		}
+		return fields
+	}
+
+	It("should accept valid restart settings", func() {
+		Expect(ValidateInstance(newInstance(1, core.RestartHard))).To(BeEmpty())
+	})
+
+	It("should reject invalid restart settings", func() {
+		Expect(fieldErrors(ValidateInstance(newInstance(-1, "Soft")))).To(ConsistOf(
+			"spec.restartGeneration",
+			"spec.restartType",
+		))
+	})
+
+	It("should reject decreasing the restart generation", func() {
+		Expect(ValidateInstanceUpdate(newInstance(2, core.RestartGraceful), newInstance(3, core.RestartGraceful))).To(BeEmpty())
+		Expect(fieldErrors(ValidateInstanceUpdate(newInstance(2, core.RestartGraceful), newInstance(1, core.RestartGraceful)))).To(ConsistOf(
+			"spec.restartGeneration",
+		))
+	})
+
+	It("should validate the log options", func() {
+		Expect(ValidateInstanceLogOptions(&core.InstanceLogOptions{
+			TailLines:    ptr.To[int64](0),
+			SinceSeconds: ptr.To[int64](1),
+		})).To(BeEmpty())
+		Expect(fieldErrors(ValidateInstanceLogOptions(&core.InstanceLogOptions{
+			TailLines:    ptr.To[int64](-1),
+			SinceSeconds: ptr.To[int64](0),
+		}))).To(ConsistOf(
+			"tailLines",
+			"sinceSeconds",
+		))
+	})
+})
@@ -174,6 +174,27 @@ func (in *DiskAccess) DeepCopy() *DiskAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskDataSource) DeepCopyInto(out *DiskDataSource) {
+	*out = *in
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskDataSource.
+func (in *DiskDataSource) DeepCopy() *DiskDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskList) DeepCopyInto(out *DiskList) {
 	*out = *in
@@ -207,6 +228,133 @@ func (in *DiskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSnapshot) DeepCopyInto(out *DiskSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSnapshot.
+func (in *DiskSnapshot) DeepCopy() *DiskSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSnapshotCondition) DeepCopyInto(out *DiskSnapshotCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSnapshotCondition.
+func (in *DiskSnapshotCondition) DeepCopy() *DiskSnapshotCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSnapshotCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSnapshotList) DeepCopyInto(out *DiskSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DiskSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSnapshotList.
+func (in *DiskSnapshotList) DeepCopy() *DiskSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSnapshotSpec) DeepCopyInto(out *DiskSnapshotSpec) {
+	*out = *in
+	out.DiskRef = in.DiskRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSnapshotSpec.
+func (in *DiskSnapshotSpec) DeepCopy() *DiskSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSnapshotStatus) DeepCopyInto(out *DiskSnapshotStatus) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]DiskSnapshotCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSnapshotStatus.
+func (in *DiskSnapshotStatus) DeepCopy() *DiskSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
 	*out = *in
@@ -223,6 +371,11 @@ func (in *DiskSpec) DeepCopyInto(out *DiskSpec) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.DataSource != nil {
+		in, out := &in.DataSource, &out.DataSource
+		*out = new(DiskDataSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -507,6 +660,27 @@ func (in *FleetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetRuntimeInfo) DeepCopyInto(out *FleetRuntimeInfo) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]FleetCapability, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetRuntimeInfo.
+func (in *FleetRuntimeInfo) DeepCopy() *FleetRuntimeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetRuntimeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FleetSpec) DeepCopyInto(out *FleetSpec) {
 	*out = *in
@@ -558,6 +732,11 @@ func (in *FleetStatus) DeepCopyInto(out *FleetStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.RuntimeInfo != nil {
+		in, out := &in.RuntimeInfo, &out.RuntimeInfo
+		*out = new(FleetRuntimeInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -599,6 +778,23 @@ func (in *Instance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceCondition) DeepCopyInto(out *InstanceCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceCondition.
+func (in *InstanceCondition) DeepCopy() *InstanceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceExecOptions) DeepCopyInto(out *InstanceExecOptions) {
 	*out = *in
@@ -657,6 +853,71 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceLogOptions) DeepCopyInto(out *InstanceLogOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.TailLines != nil {
+		in, out := &in.TailLines, &out.TailLines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SinceSeconds != nil {
+		in, out := &in.SinceSeconds, &out.SinceSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceLogOptions.
+func (in *InstanceLogOptions) DeepCopy() *InstanceLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceLogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceLogOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancePortForwardOptions) DeepCopyInto(out *InstancePortForwardOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePortForwardOptions.
+func (in *InstancePortForwardOptions) DeepCopy() *InstancePortForwardOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancePortForwardOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstancePortForwardOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 	*out = *in
@@ -720,9 +981,39 @@ func (in *InstanceSpec) DeepCopy() *InstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatsOptions) DeepCopyInto(out *InstanceStatsOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatsOptions.
+func (in *InstanceStatsOptions) DeepCopy() *InstanceStatsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceStatsOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 	*out = *in
+	if in.InstanceTypeRef != nil {
+		in, out := &in.InstanceTypeRef, &out.InstanceTypeRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
 	if in.NetworkInterfaces != nil {
 		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
 		*out = make([]NetworkInterfaceStatus, len(*in))
@@ -737,6 +1028,13 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]InstanceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -52,3 +52,27 @@ func SetupSubnetSpecNetworkRefNameField(ctx context.Context, idx client.FieldInd
 		return []string{networkRef.Name}
 	})
 }
+
+const DiskSpecDataSourceSnapshotRefNameField = "Disk.spec.dataSource.snapshotRef.name"
+
+func SetupDiskSpecDataSourceSnapshotRefNameFieldIndexer(ctx context.Context, idx client.FieldIndexer) error {
+	return idx.IndexField(ctx, &corev1alpha1.Disk{}, DiskSpecDataSourceSnapshotRefNameField, func(obj client.Object) []string {
+		disk := obj.(*corev1alpha1.Disk)
+		dataSource := disk.Spec.DataSource
+		if dataSource == nil || dataSource.SnapshotRef == nil {
+			return []string{""}
+		}
+
+		return []string{dataSource.SnapshotRef.Name}
+	})
+}
+
+const DiskSnapshotSpecDiskRefNameField = "DiskSnapshot.spec.diskRef.name"
+
+func SetupDiskSnapshotSpecDiskRefNameFieldIndexer(ctx context.Context, idx client.FieldIndexer) error {
+	return idx.IndexField(ctx, &corev1alpha1.DiskSnapshot{}, DiskSnapshotSpecDiskRefNameField, func(obj client.Object) []string {
+		diskSnapshot := obj.(*corev1alpha1.DiskSnapshot)
+		diskRef := diskSnapshot.Spec.DiskRef
+		return []string{diskRef.Name}
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/utils/lru"
 	coreclient "spheric.cloud/spheric/internal/client/core"
 	"spheric.cloud/spheric/internal/controllers/core/scheduler"
+	fakesnapshot "spheric.cloud/spheric/internal/controllers/core/snapshot/fake"
 	. "spheric.cloud/spheric/utils/testing"
 
 	"github.com/ironcore-dev/controller-utils/buildutils"
@@ -45,9 +46,10 @@ const (
 )
 
 var (
-	k8sClient  = NewClientPromise()
-	testEnv    *envtest.Environment
-	testEnvExt *utilsenvtest.EnvironmentExtensions
+	k8sClient      = NewClientPromise()
+	testEnv        *envtest.Environment
+	testEnvExt     *utilsenvtest.EnvironmentExtensions
+	snapshotDriver *fakesnapshot.Driver
 )
 
 func TestCore(t *testing.T) {
@@ -112,6 +114,8 @@ var _ = BeforeSuite(func() {
 	Expect(coreclient.SetupInstanceSpecFleetRefNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(coreclient.SetupInstanceSpecInstanceTypeRefNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 	Expect(coreclient.SetupSubnetSpecNetworkRefNameField(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(coreclient.SetupDiskSpecDataSourceSnapshotRefNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
+	Expect(coreclient.SetupDiskSnapshotSpecDiskRefNameFieldIndexer(ctx, k8sManager.GetFieldIndexer())).To(Succeed())
 
 	schedulerCache := scheduler.NewCache(k8sManager.GetLogger(), scheduler.DefaultCacheStrategy)
 	Expect(k8sManager.Add(schedulerCache)).To(Succeed())
@@ -127,6 +131,18 @@ var _ = BeforeSuite(func() {
 		AbsenceCache: lru.New(128),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	snapshotDriver = fakesnapshot.NewDriver()
+	Expect((&core.DiskSnapshotReconciler{
+		Client:       k8sManager.GetClient(),
+		Driver:       snapshotDriver,
+		PollInterval: pollingInterval,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&core.DiskRestoreReconciler{
+		Client: k8sManager.GetClient(),
+		Driver: snapshotDriver,
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	Expect((&core.InstanceEphemeralDiskReconciler{
 		Client: k8sManager.GetClient(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	coreclient "spheric.cloud/spheric/internal/client/core"
+	"spheric.cloud/spheric/internal/controllers/core/snapshot"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/clientutils"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// DiskRestoreReconciler provisions disks that specify a DiskSnapshot as data source.
+type DiskRestoreReconciler struct {
+	client.Client
+
+	// Driver restores disks from snapshots.
+	Driver snapshot.Driver
+}
+
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disks,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disks/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disksnapshots,verbs=get;list;watch
+
+func (r *DiskRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	disk := &corev1alpha1.Disk{}
+	if err := r.Get(ctx, req.NamespacedName, disk); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, disk)
+}
+
+func (r *DiskRestoreReconciler) reconcileExists(ctx context.Context, log logr.Logger, disk *corev1alpha1.Disk) (ctrl.Result, error) {
+	if !disk.DeletionTimestamp.IsZero() {
+		return r.delete(ctx, log, disk)
+	}
+	return r.reconcile(ctx, log, disk)
+}
+
+func (r *DiskRestoreReconciler) delete(ctx context.Context, log logr.Logger, disk *corev1alpha1.Disk) (ctrl.Result, error) {
+	log.V(1).Info("Delete")
+
+	if !controllerutil.ContainsFinalizer(disk, corev1alpha1.FinalizerDiskRestore) {
+		log.V(1).Info("No finalizer present, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Deleting restored disk")
+	if err := r.Driver.DeleteDisk(ctx, string(disk.UID)); snapshot.IgnoreDiskNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting restored disk: %w", err)
+	}
+
+	log.V(1).Info("Removing finalizer")
+	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, disk, corev1alpha1.FinalizerDiskRestore); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error removing finalizer: %w", err)
+	}
+
+	log.V(1).Info("Deleted")
+	return ctrl.Result{}, nil
+}
+
+func (r *DiskRestoreReconciler) reconcile(ctx context.Context, log logr.Logger, disk *corev1alpha1.Disk) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	dataSource := disk.Spec.DataSource
+	if dataSource == nil || dataSource.SnapshotRef == nil {
+		log.V(1).Info("Disk does not specify a snapshot data source, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Ensuring finalizer")
+	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, disk, corev1alpha1.FinalizerDiskRestore); err != nil || modified {
+		return ctrl.Result{}, err
+	}
+
+	if disk.Status.State == corev1alpha1.DiskStateAvailable && disk.Status.Access != nil {
+		log.V(1).Info("Disk is already restored")
+		return ctrl.Result{}, nil
+	}
+
+	diskSnapshot := &corev1alpha1.DiskSnapshot{}
+	diskSnapshotKey := client.ObjectKey{Namespace: disk.Namespace, Name: dataSource.SnapshotRef.Name}
+	if err := r.Get(ctx, diskSnapshotKey, diskSnapshot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting disk snapshot %s: %w", diskSnapshotKey.Name, err)
+		}
+
+		log.V(1).Info("Disk snapshot not found, waiting for it to be created", "DiskSnapshot", diskSnapshotKey.Name)
+		return ctrl.Result{}, r.patchStatus(ctx, disk, corev1alpha1.DiskStatePending, nil)
+	}
+
+	if !diskSnapshot.Status.ReadyToUse {
+		log.V(1).Info("Disk snapshot is not ready to use yet", "DiskSnapshot", diskSnapshotKey.Name)
+		return ctrl.Result{}, r.patchStatus(ctx, disk, corev1alpha1.DiskStatePending, nil)
+	}
+
+	log.V(1).Info("Restoring disk from snapshot", "DiskSnapshot", diskSnapshotKey.Name)
+	access, err := r.Driver.RestoreSnapshot(ctx, string(diskSnapshot.UID), string(disk.UID))
+	if err != nil {
+		if !errors.Is(err, snapshot.ErrSnapshotNotFound) {
+			return ctrl.Result{}, fmt.Errorf("error restoring disk from snapshot: %w", err)
+		}
+
+		log.V(1).Info("Snapshot is not known to the driver, cannot restore disk", "DiskSnapshot", diskSnapshotKey.Name)
+		return ctrl.Result{}, r.patchStatus(ctx, disk, corev1alpha1.DiskStateError, nil)
+	}
+
+	log.V(1).Info("Updating status")
+	if err := r.patchStatus(ctx, disk, corev1alpha1.DiskStateAvailable, access); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+func (r *DiskRestoreReconciler) patchStatus(ctx context.Context, disk *corev1alpha1.Disk, state corev1alpha1.DiskState, access *corev1alpha1.DiskAccess) error {
+	if disk.Status.State == state && equality.Semantic.DeepEqual(disk.Status.Access, access) {
+		return nil
+	}
+
+	base := disk.DeepCopy()
+	if disk.Status.State != state {
+		now := metav1.Now()
+		disk.Status.LastStateTransitionTime = &now
+	}
+	disk.Status.State = state
+	disk.Status.Access = access
+	if err := r.Status().Patch(ctx, disk, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching status: %w", err)
+	}
+	return nil
+}
+
+func (r *DiskRestoreReconciler) enqueueByDiskSnapshot() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		diskSnapshot := obj.(*corev1alpha1.DiskSnapshot)
+		log := ctrl.LoggerFrom(ctx)
+
+		diskList := &corev1alpha1.DiskList{}
+		if err := r.List(ctx, diskList,
+			client.InNamespace(diskSnapshot.Namespace),
+			client.MatchingFields{coreclient.DiskSpecDataSourceSnapshotRefNameField: diskSnapshot.Name},
+		); err != nil {
+			log.Error(err, "Error listing disks")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, disk := range diskList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&disk)})
+		}
+		return reqs
+	})
+}
+
+func (r *DiskRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("diskrestore").
+		For(
+			&corev1alpha1.Disk{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				disk := obj.(*corev1alpha1.Disk)
+				dataSource := disk.Spec.DataSource
+				return dataSource != nil && dataSource.SnapshotRef != nil
+			})),
+		).
+		Watches(
+			&corev1alpha1.DiskSnapshot{},
+			r.enqueueByDiskSnapshot(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	coreclient "spheric.cloud/spheric/internal/client/core"
+	"spheric.cloud/spheric/internal/controllers/core/snapshot"
+
+	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/clientutils"
+	"github.com/ironcore-dev/controller-utils/conditionutils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+const defaultDiskSnapshotPollInterval = 5 * time.Second
+
+const (
+	// snapshotNotFoundReason is the reason of a True Lost condition.
+	snapshotNotFoundReason = "SnapshotNotFound"
+	// snapshotFoundReason is the reason of a False Lost condition if a lost snapshot is known to the driver again.
+	snapshotFoundReason = "SnapshotFound"
+)
+
+type DiskSnapshotReconciler struct {
+	client.Client
+
+	// Driver takes the snapshots of disks.
+	Driver snapshot.Driver
+	// PollInterval is the interval to poll the Driver for snapshots that are not ready yet.
+	// Defaults to 5 seconds.
+	PollInterval time.Duration
+}
+
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disksnapshots,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disksnapshots/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disksnapshots/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=disks,verbs=get;list;watch
+
+func (r *DiskSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	diskSnapshot := &corev1alpha1.DiskSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, diskSnapshot); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return r.reconcileExists(ctx, log, diskSnapshot)
+}
+
+func (r *DiskSnapshotReconciler) reconcileExists(ctx context.Context, log logr.Logger, diskSnapshot *corev1alpha1.DiskSnapshot) (ctrl.Result, error) {
+	if !diskSnapshot.DeletionTimestamp.IsZero() {
+		return r.delete(ctx, log, diskSnapshot)
+	}
+	return r.reconcile(ctx, log, diskSnapshot)
+}
+
+func (r *DiskSnapshotReconciler) pollInterval() time.Duration {
+	if r.PollInterval > 0 {
+		return r.PollInterval
+	}
+	return defaultDiskSnapshotPollInterval
+}
+
+func (r *DiskSnapshotReconciler) delete(ctx context.Context, log logr.Logger, diskSnapshot *corev1alpha1.DiskSnapshot) (ctrl.Result, error) {
+	log.V(1).Info("Delete")
+
+	if !controllerutil.ContainsFinalizer(diskSnapshot, corev1alpha1.FinalizerDiskSnapshot) {
+		log.V(1).Info("No finalizer present, nothing to do")
+		return ctrl.Result{}, nil
+	}
+
+	ok, err := r.isDiskSnapshotInUse(ctx, log, diskSnapshot)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking whether disk snapshot is in use: %w", err)
+	}
+	if ok {
+		log.V(1).Info("Disk snapshot is still used as data source, waiting for disks to be deleted")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Deleting snapshot")
+	if err := r.Driver.DeleteSnapshot(ctx, string(diskSnapshot.UID)); snapshot.IgnoreSnapshotNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting snapshot: %w", err)
+	}
+
+	log.V(1).Info("Removing finalizer")
+	if _, err := clientutils.PatchEnsureNoFinalizer(ctx, r.Client, diskSnapshot, corev1alpha1.FinalizerDiskSnapshot); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error removing finalizer: %w", err)
+	}
+
+	log.V(1).Info("Deleted")
+	return ctrl.Result{}, nil
+}
+
+func (r *DiskSnapshotReconciler) isDiskSnapshotInUse(ctx context.Context, log logr.Logger, diskSnapshot *corev1alpha1.DiskSnapshot) (bool, error) {
+	diskList := &corev1alpha1.DiskList{}
+	if err := r.List(ctx, diskList,
+		client.InNamespace(diskSnapshot.Namespace),
+		client.MatchingFields{coreclient.DiskSpecDataSourceSnapshotRefNameField: diskSnapshot.Name},
+	); err != nil {
+		return false, fmt.Errorf("error listing disks: %w", err)
+	}
+
+	var names []string
+	for _, disk := range diskList.Items {
+		if disk.DeletionTimestamp.IsZero() {
+			names = append(names, disk.Name)
+		}
+	}
+
+	if len(names) > 0 {
+		log.V(1).Info("Disk snapshot is in use", "Disks", names)
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *DiskSnapshotReconciler) reconcile(ctx context.Context, log logr.Logger, diskSnapshot *corev1alpha1.DiskSnapshot) (ctrl.Result, error) {
+	log.V(1).Info("Reconcile")
+
+	log.V(1).Info("Ensuring finalizer")
+	if modified, err := clientutils.PatchEnsureFinalizer(ctx, r.Client, diskSnapshot, corev1alpha1.FinalizerDiskSnapshot); err != nil || modified {
+		return ctrl.Result{}, err
+	}
+
+	id := string(diskSnapshot.UID)
+	snap, err := r.Driver.GetSnapshot(ctx, id)
+	if err != nil {
+		if !errors.Is(err, snapshot.ErrSnapshotNotFound) {
+			return ctrl.Result{}, fmt.Errorf("error getting snapshot: %w", err)
+		}
+
+		// Taking the snapshot again would capture the current data of the disk, so a snapshot
+		// that was already taken is only reported as lost.
+		if diskSnapshot.Status.CreationTime != nil {
+			log.V(1).Info("Snapshot was taken but is not known to the driver anymore, reporting it as lost")
+			if err := r.patchLost(ctx, diskSnapshot); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+
+		snap, err = r.createSnapshot(ctx, log, diskSnapshot)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if snap == nil {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	log.V(1).Info("Updating status", "ReadyToUse", snap.ReadyToUse)
+	if err := r.updateStatus(ctx, diskSnapshot, snap); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !snap.ReadyToUse {
+		log.V(1).Info("Snapshot is not ready to use yet, requeueing")
+		return ctrl.Result{RequeueAfter: r.pollInterval()}, nil
+	}
+
+	log.V(1).Info("Reconciled")
+	return ctrl.Result{}, nil
+}
+
+// createSnapshot takes the snapshot of the referenced disk. If the disk is not available yet, it returns nil.
+func (r *DiskSnapshotReconciler) createSnapshot(ctx context.Context, log logr.Logger, diskSnapshot *corev1alpha1.DiskSnapshot) (*snapshot.Snapshot, error) {
+	disk := &corev1alpha1.Disk{}
+	diskKey := client.ObjectKey{Namespace: diskSnapshot.Namespace, Name: diskSnapshot.Spec.DiskRef.Name}
+	if err := r.Get(ctx, diskKey, disk); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting disk %s: %w", diskKey.Name, err)
+		}
+
+		log.V(1).Info("Disk not found, waiting for it to be created", "Disk", diskKey.Name)
+		return nil, nil
+	}
+
+	if disk.Status.State != corev1alpha1.DiskStateAvailable || disk.Status.Access == nil {
+		log.V(1).Info("Disk is not available yet", "Disk", diskKey.Name, "State", disk.Status.State)
+		return nil, nil
+	}
+
+	log.V(1).Info("Creating snapshot", "Disk", diskKey.Name)
+	snap, err := r.Driver.CreateSnapshot(ctx, string(diskSnapshot.UID), disk.Status.Access)
+	if err != nil {
+		return nil, fmt.Errorf("error creating snapshot: %w", err)
+	}
+	return snap, nil
+}
+
+func (r *DiskSnapshotReconciler) updateStatus(ctx context.Context, diskSnapshot *corev1alpha1.DiskSnapshot, snap *snapshot.Snapshot) error {
+	base := diskSnapshot.DeepCopy()
+	creationTime := metav1.NewTime(snap.CreationTime)
+	size := snap.Size.DeepCopy()
+	diskSnapshot.Status.ReadyToUse = snap.ReadyToUse
+	diskSnapshot.Status.Size = &size
+	diskSnapshot.Status.CreationTime = &creationTime
+	if conditionutils.MustFindSlice(diskSnapshot.Status.Conditions, string(corev1alpha1.DiskSnapshotLost), &corev1alpha1.DiskSnapshotCondition{}) {
+		conditionutils.MustUpdateSlice(&diskSnapshot.Status.Conditions, string(corev1alpha1.DiskSnapshotLost),
+			conditionutils.UpdateStatus(corev1.ConditionFalse),
+			conditionutils.UpdateReason(snapshotFoundReason),
+			conditionutils.UpdateMessage("The snapshot is known to the driver."),
+			conditionutils.UpdateObserved(diskSnapshot),
+		)
+	}
+	if err := r.Status().Patch(ctx, diskSnapshot, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching status: %w", err)
+	}
+	return nil
+}
+
+func (r *DiskSnapshotReconciler) patchLost(ctx context.Context, diskSnapshot *corev1alpha1.DiskSnapshot) error {
+	base := diskSnapshot.DeepCopy()
+	diskSnapshot.Status.ReadyToUse = false
+	conditionutils.MustUpdateSlice(&diskSnapshot.Status.Conditions, string(corev1alpha1.DiskSnapshotLost),
+		conditionutils.UpdateStatus(corev1.ConditionTrue),
+		conditionutils.UpdateReason(snapshotNotFoundReason),
+		conditionutils.UpdateMessage("The snapshot was taken but does not exist in the driver anymore."),
+		conditionutils.UpdateObserved(diskSnapshot),
+	)
+	if equality.Semantic.DeepEqual(base.Status, diskSnapshot.Status) {
+		return nil
+	}
+
+	if err := r.Status().Patch(ctx, diskSnapshot, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching status: %w", err)
+	}
+	return nil
+}
+
+func (r *DiskSnapshotReconciler) enqueueByDisk() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		disk := obj.(*corev1alpha1.Disk)
+		log := ctrl.LoggerFrom(ctx)
+
+		diskSnapshotList := &corev1alpha1.DiskSnapshotList{}
+		if err := r.List(ctx, diskSnapshotList,
+			client.InNamespace(disk.Namespace),
+			client.MatchingFields{coreclient.DiskSnapshotSpecDiskRefNameField: disk.Name},
+		); err != nil {
+			log.Error(err, "Error listing disk snapshots")
+			return nil
+		}
+
+		var reqs []ctrl.Request
+		for _, diskSnapshot := range diskSnapshotList.Items {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&diskSnapshot)})
+		}
+
+		if dataSource := disk.Spec.DataSource; dataSource != nil && dataSource.SnapshotRef != nil {
+			reqs = append(reqs, ctrl.Request{NamespacedName: client.ObjectKey{
+				Namespace: disk.Namespace,
+				Name:      dataSource.SnapshotRef.Name,
+			}})
+		}
+		return reqs
+	})
+}
+
+func (r *DiskSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("disksnapshot").
+		For(&corev1alpha1.DiskSnapshot{}).
+		Watches(
+			&corev1alpha1.Disk{},
+			r.enqueueByDisk(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package core_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	fakesnapshot "spheric.cloud/spheric/internal/controllers/core/snapshot/fake"
+	. "spheric.cloud/spheric/utils/testing"
+)
+
+var _ = Describe("DiskSnapshotReconciler", func() {
+	ns := SetupNamespace(k8sClient)
+
+	It("should snapshot a disk, restore a disk from it and protect the snapshot while it is used as data source", func(ctx SpecContext) {
+		By("creating a disk")
+		disk := &corev1alpha1.Disk{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, disk)).To(Succeed())
+
+		By("creating a disk snapshot of the disk")
+		diskSnapshot := &corev1alpha1.DiskSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-snapshot-",
+			},
+			Spec: corev1alpha1.DiskSnapshotSpec{
+				DiskRef: corev1alpha1.LocalObjectReference{Name: disk.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, diskSnapshot)).To(Succeed())
+
+		By("asserting no snapshot is taken while the disk is not available")
+		Eventually(Object(diskSnapshot)).Should(HaveField("Finalizers", ConsistOf(corev1alpha1.FinalizerDiskSnapshot)))
+		Consistently(Object(diskSnapshot)).Should(HaveField("Status.ReadyToUse", BeFalse()))
+
+		By("making the disk available")
+		Eventually(UpdateStatus(disk, func() {
+			disk.Status.State = corev1alpha1.DiskStateAvailable
+			disk.Status.Access = &corev1alpha1.DiskAccess{
+				Driver: "test",
+				Handle: "disk-handle",
+			}
+		})).Should(Succeed())
+
+		By("waiting for the disk snapshot to be ready to use")
+		Eventually(Object(diskSnapshot)).Should(SatisfyAll(
+			HaveField("Status.ReadyToUse", BeTrue()),
+			HaveField("Status.Size", HaveValue(BeComparableTo(fakesnapshot.DefaultSnapshotSize))),
+			HaveField("Status.CreationTime", Not(BeNil())),
+		))
+		snapshotDriver.Lock()
+		Expect(snapshotDriver.Sources).To(HaveKeyWithValue(string(diskSnapshot.UID), "disk-handle"))
+		snapshotDriver.Unlock()
+
+		By("creating a disk restored from the snapshot")
+		restoredDisk := &corev1alpha1.Disk{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "restored-disk-",
+			},
+			Spec: corev1alpha1.DiskSpec{
+				DataSource: &corev1alpha1.DiskDataSource{
+					SnapshotRef: &corev1alpha1.LocalObjectReference{Name: diskSnapshot.Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, restoredDisk)).To(Succeed())
+
+		By("waiting for the restored disk to be available")
+		Eventually(Object(restoredDisk)).Should(SatisfyAll(
+			HaveField("Finalizers", ConsistOf(corev1alpha1.FinalizerDiskRestore)),
+			HaveField("Status.State", corev1alpha1.DiskStateAvailable),
+			HaveField("Status.Access", HaveValue(HaveField("Driver", fakesnapshot.DriverName))),
+		))
+
+		By("asserting the restored disk is based on the snapshot")
+		snapshotDriver.Lock()
+		Expect(snapshotDriver.Restores).To(HaveKeyWithValue(string(restoredDisk.UID), string(diskSnapshot.UID)))
+		snapshotDriver.Unlock()
+
+		By("deleting the disk snapshot")
+		Expect(k8sClient.Delete(ctx, diskSnapshot)).To(Succeed())
+
+		By("asserting the snapshot is kept while the restored disk exists")
+		Consistently(Object(diskSnapshot)).Should(HaveField("Finalizers", ConsistOf(corev1alpha1.FinalizerDiskSnapshot)))
+		snapshotDriver.Lock()
+		Expect(snapshotDriver.Snapshots).To(HaveKey(string(diskSnapshot.UID)))
+		snapshotDriver.Unlock()
+
+		By("deleting the restored disk")
+		Expect(k8sClient.Delete(ctx, restoredDisk)).To(Succeed())
+
+		By("waiting for the restored disk and the disk snapshot to be gone")
+		Eventually(Get(restoredDisk)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Get(diskSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+		snapshotDriver.Lock()
+		Expect(snapshotDriver.Restores).NotTo(HaveKey(string(restoredDisk.UID)))
+		Expect(snapshotDriver.Snapshots).NotTo(HaveKey(string(diskSnapshot.UID)))
+		snapshotDriver.Unlock()
+	})
+
+	It("should poll the driver until the snapshot is ready to use", func(ctx SpecContext) {
+		snapshotDriver.Lock()
+		snapshotDriver.Pending = true
+		snapshotDriver.Unlock()
+		DeferCleanup(func() {
+			snapshotDriver.Lock()
+			defer snapshotDriver.Unlock()
+			snapshotDriver.Pending = false
+		})
+
+		By("creating an available disk")
+		disk := &corev1alpha1.Disk{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, disk)).To(Succeed())
+		Eventually(UpdateStatus(disk, func() {
+			disk.Status.State = corev1alpha1.DiskStateAvailable
+			disk.Status.Access = &corev1alpha1.DiskAccess{
+				Driver: "test",
+				Handle: "pending-disk-handle",
+			}
+		})).Should(Succeed())
+
+		By("creating a disk snapshot of the disk")
+		diskSnapshot := &corev1alpha1.DiskSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-snapshot-",
+			},
+			Spec: corev1alpha1.DiskSnapshotSpec{
+				DiskRef: corev1alpha1.LocalObjectReference{Name: disk.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, diskSnapshot)).To(Succeed())
+
+		By("waiting for the snapshot to be taken but not ready to use")
+		Eventually(Object(diskSnapshot)).Should(SatisfyAll(
+			HaveField("Status.CreationTime", Not(BeNil())),
+			HaveField("Status.ReadyToUse", BeFalse()),
+		))
+
+		By("completing the snapshot in the driver")
+		Expect(snapshotDriver.SetReady(string(diskSnapshot.UID))).To(Succeed())
+
+		By("waiting for the disk snapshot to be ready to use")
+		Eventually(Object(diskSnapshot)).Should(HaveField("Status.ReadyToUse", BeTrue()))
+
+		By("deleting the disk snapshot")
+		Expect(k8sClient.Delete(ctx, diskSnapshot)).To(Succeed())
+		Eventually(Get(diskSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should report a snapshot as lost instead of taking it again", func(ctx SpecContext) {
+		By("creating an available disk")
+		disk := &corev1alpha1.Disk{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, disk)).To(Succeed())
+		Eventually(UpdateStatus(disk, func() {
+			disk.Status.State = corev1alpha1.DiskStateAvailable
+			disk.Status.Access = &corev1alpha1.DiskAccess{
+				Driver: "test",
+				Handle: "lost-disk-handle",
+			}
+		})).Should(Succeed())
+
+		By("creating a disk snapshot of the disk")
+		diskSnapshot := &corev1alpha1.DiskSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-snapshot-",
+			},
+			Spec: corev1alpha1.DiskSnapshotSpec{
+				DiskRef: corev1alpha1.LocalObjectReference{Name: disk.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, diskSnapshot)).To(Succeed())
+
+		By("waiting for the disk snapshot to be ready to use")
+		Eventually(Object(diskSnapshot)).Should(HaveField("Status.ReadyToUse", BeTrue()))
+		creationTime := diskSnapshot.Status.CreationTime
+
+		By("removing the snapshot from the driver")
+		snapshotDriver.Lock()
+		delete(snapshotDriver.Snapshots, string(diskSnapshot.UID))
+		delete(snapshotDriver.Sources, string(diskSnapshot.UID))
+		snapshotDriver.Unlock()
+
+		By("triggering a reconciliation of the disk snapshot")
+		Eventually(Update(diskSnapshot, func() {
+			metav1.SetMetaDataAnnotation(&diskSnapshot.ObjectMeta, "test", "lost")
+		})).Should(Succeed())
+
+		By("waiting for the disk snapshot to be reported as lost")
+		Eventually(Object(diskSnapshot)).Should(SatisfyAll(
+			HaveField("Status.ReadyToUse", BeFalse()),
+			HaveField("Status.CreationTime", Equal(creationTime)),
+			HaveField("Status.Conditions", ConsistOf(SatisfyAll(
+				HaveField("Type", corev1alpha1.DiskSnapshotLost),
+				HaveField("Status", corev1.ConditionTrue),
+			))),
+		))
+
+		By("asserting the snapshot is not taken again")
+		Consistently(func(g Gomega) {
+			snapshotDriver.Lock()
+			defer snapshotDriver.Unlock()
+			g.Expect(snapshotDriver.Snapshots).NotTo(HaveKey(string(diskSnapshot.UID)))
+		}).Should(Succeed())
+
+		By("deleting the disk snapshot")
+		Expect(k8sClient.Delete(ctx, diskSnapshot)).To(Succeed())
+		Eventually(Get(diskSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should reject changing the data source of a disk", func(ctx SpecContext) {
+		disk := &corev1alpha1.Disk{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "disk-",
+			},
+			Spec: corev1alpha1.DiskSpec{
+				DataSource: &corev1alpha1.DiskDataSource{
+					SnapshotRef: &corev1alpha1.LocalObjectReference{Name: "foo"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, disk)).To(Succeed())
+
+		base := disk.DeepCopy()
+		disk.Spec.DataSource.SnapshotRef.Name = "bar"
+		Expect(k8sClient.Patch(ctx, disk, client.MergeFrom(base))).To(Satisfy(apierrors.IsInvalid))
+	})
+})
@@ -12,7 +12,10 @@ import (
 	"spheric.cloud/spheric/internal/controllers/core/scheduler"
 
 	"github.com/go-logr/logr"
+	"github.com/ironcore-dev/controller-utils/conditionutils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/record"
@@ -31,6 +34,7 @@ import (
 
 const (
 	outOfCapacity = "OutOfCapacity"
+	fitsFleet     = "FitsFleet"
 )
 
 type InstanceScheduler struct {
@@ -45,6 +49,7 @@ type InstanceScheduler struct {
 //+kubebuilder:rbac:groups=core.spheric.cloud,resources=instances,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=core.spheric.cloud,resources=instances/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=core.spheric.cloud,resources=fleets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core.spheric.cloud,resources=instancetypes,verbs=get;list;watch
 
 // Reconcile reconciles the desired with the actual state.
 func (s *InstanceScheduler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -60,6 +65,9 @@ func (s *InstanceScheduler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
+	if instance.Spec.FleetRef != nil {
+		return s.reconcileBound(ctx, log, instance)
+	}
 	return s.reconcileExists(ctx, log, instance)
 }
 
@@ -87,10 +95,39 @@ func (s *InstanceScheduler) tolerateTaints(ctx context.Context, info *scheduler.
 	return corev1alpha1.TolerateTaints(instance.Spec.Tolerations, info.Fleet().Spec.Taints)
 }
 
+// requiredCapabilities returns the fleet capabilities the instance depends on.
+func requiredCapabilities(instance *corev1alpha1.Instance) []corev1alpha1.FleetCapability {
+	var res []corev1alpha1.FleetCapability
+	if instance.Spec.Power == corev1alpha1.PowerSuspended {
+		res = append(res, corev1alpha1.FleetCapabilitySuspend)
+	}
+	if instance.Spec.RestartGeneration > 0 {
+		res = append(res, corev1alpha1.FleetCapabilityReboot)
+	}
+	return res
+}
+
+func (s *InstanceScheduler) supportsCapabilities(ctx context.Context, info *scheduler.ContainerInfo, instance *corev1alpha1.Instance) bool {
+	runtimeInfo := info.Fleet().Status.RuntimeInfo
+	if runtimeInfo == nil {
+		// Fleets that don't report their runtime yet are not restricted.
+		return true
+	}
+
+	for _, capability := range requiredCapabilities(instance) {
+		if !runtimeInfo.HasCapability(capability) {
+			return false
+		}
+	}
+	return true
+}
+
 func (s *InstanceScheduler) fitsFleet(ctx context.Context, info *scheduler.ContainerInfo, instance *corev1alpha1.Instance) bool {
-	instanceClassName := instance.Spec.InstanceTypeRef.Name
+	return hasAllocatableInstanceType(info.Fleet(), instance.Spec.InstanceTypeRef.Name)
+}
 
-	allocatable, ok := info.Fleet().Status.Allocatable[corev1alpha1.ResourceInstanceType(instanceClassName)]
+func hasAllocatableInstanceType(fleet *corev1alpha1.Fleet, instanceTypeName string) bool {
+	allocatable, ok := fleet.Status.Allocatable[corev1alpha1.ResourceInstanceType(instanceTypeName)]
 	if !ok {
 		return false
 	}
@@ -98,6 +135,111 @@ func (s *InstanceScheduler) fitsFleet(ctx context.Context, info *scheduler.Conta
 	return allocatable.Cmp(*resource.NewQuantity(1, resource.DecimalSI)) >= 0
 }
 
+// fitsBoundFleet reports whether the fleet the instance is bound to can run the instance with its
+// (possibly changed) instance type. The resources of the instance type currently applied to the instance
+// are already in use by the instance, so they are added back to the allocatable resources of the fleet
+// before comparing them to the resources of the new instance type.
+func (s *InstanceScheduler) fitsBoundFleet(ctx context.Context, fleet *corev1alpha1.Fleet, instance *corev1alpha1.Instance) (bool, error) {
+	instanceTypeName := instance.Spec.InstanceTypeRef.Name
+	appliedRef := instance.Status.InstanceTypeRef
+	if appliedRef != nil && appliedRef.Name == instanceTypeName {
+		return true, nil
+	}
+	if hasAllocatableInstanceType(fleet, instanceTypeName) {
+		return true, nil
+	}
+	if appliedRef == nil {
+		return false, nil
+	}
+
+	instanceType := &corev1alpha1.InstanceType{}
+	if err := s.Get(ctx, client.ObjectKey{Name: instanceTypeName}, instanceType); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	appliedInstanceType := &corev1alpha1.InstanceType{}
+	if err := s.Get(ctx, client.ObjectKey{Name: appliedRef.Name}, appliedInstanceType); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	for _, name := range []corev1alpha1.ResourceName{corev1alpha1.ResourceCPU, corev1alpha1.ResourceMemory} {
+		available := fleet.Status.Allocatable[name]
+		available.Add(appliedInstanceType.Capabilities[name])
+		if available.Cmp(instanceType.Capabilities[name]) < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// reconcileBound re-checks whether the fleet of an already bound instance still has allocatable capacity
+// for the instance's (possibly changed) instance type and reports the result as condition.
+// The capacity the instance already uses on the fleet counts towards its new instance type.
+func (s *InstanceScheduler) reconcileBound(ctx context.Context, log logr.Logger, instance *corev1alpha1.Instance) (ctrl.Result, error) {
+	cond := &corev1alpha1.InstanceCondition{}
+	found := conditionutils.MustFindSlice(instance.Status.Conditions, string(corev1alpha1.InstanceRescheduleRequired), cond)
+	if found && cond.ObservedGeneration == instance.Generation && cond.Status != corev1.ConditionTrue {
+		log.V(1).Info("Instance generation has already been checked against its fleet")
+		return ctrl.Result{}, nil
+	}
+
+	fleet := &corev1alpha1.Fleet{}
+	fleetKey := client.ObjectKey{Name: instance.Spec.FleetRef.Name}
+	if err := s.Get(ctx, fleetKey, fleet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting fleet %s: %w", fleetKey.Name, err)
+		}
+
+		log.V(1).Info("Fleet of instance not found", "FleetName", fleetKey.Name)
+		return ctrl.Result{}, nil
+	}
+
+	fits, err := s.fitsBoundFleet(ctx, fleet, instance)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error checking whether instance fits fleet %s: %w", fleet.Name, err)
+	}
+
+	if fits {
+		if !found {
+			log.V(1).Info("Instance type fits fleet")
+			return ctrl.Result{}, nil
+		}
+
+		log.V(1).Info("Instance type fits fleet, updating condition")
+		return ctrl.Result{}, s.patchRescheduleRequired(ctx, instance, corev1.ConditionFalse, fitsFleet,
+			fmt.Sprintf("Fleet %s has allocatable capacity for instance type %s", fleet.Name, instance.Spec.InstanceTypeRef.Name),
+		)
+	}
+
+	log.V(1).Info("Instance type does not fit fleet anymore", "FleetName", fleet.Name)
+	s.Eventf(instance, corev1.EventTypeNormal, outOfCapacity, "Fleet %s has no allocatable capacity for instance type %s", fleet.Name, instance.Spec.InstanceTypeRef.Name)
+	return ctrl.Result{}, s.patchRescheduleRequired(ctx, instance, corev1.ConditionTrue, outOfCapacity,
+		fmt.Sprintf("Fleet %s has no allocatable capacity for instance type %s", fleet.Name, instance.Spec.InstanceTypeRef.Name),
+	)
+}
+
+func (s *InstanceScheduler) patchRescheduleRequired(
+	ctx context.Context,
+	instance *corev1alpha1.Instance,
+	conditionStatus corev1.ConditionStatus,
+	reason, message string,
+) error {
+	base := instance.DeepCopy()
+	conditionutils.MustUpdateSlice(&instance.Status.Conditions, string(corev1alpha1.InstanceRescheduleRequired),
+		conditionutils.UpdateStatus(conditionStatus),
+		conditionutils.UpdateReason(reason),
+		conditionutils.UpdateMessage(message),
+		conditionutils.UpdateObserved(instance),
+	)
+	if equality.Semantic.DeepEqual(base.Status.Conditions, instance.Status.Conditions) {
+		return nil
+	}
+
+	if err := s.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching instance status: %w", err)
+	}
+	return nil
+}
+
 func (s *InstanceScheduler) reconcileExists(ctx context.Context, log logr.Logger, instance *corev1alpha1.Instance) (ctrl.Result, error) {
 	s.updateSnapshot()
 
@@ -117,6 +259,10 @@ func (s *InstanceScheduler) reconcileExists(ctx context.Context, log logr.Logger
 			log.Info("fleet filtered", "reason", "label do not match")
 			continue
 		}
+		if !s.supportsCapabilities(ctx, fleet, instance) {
+			log.Info("fleet filtered", "reason", "capabilities not supported")
+			continue
+		}
 		if !s.fitsFleet(ctx, fleet, instance) {
 			log.Info("fleet filtered", "reason", "resources do not match")
 			continue
@@ -213,6 +359,27 @@ func (s *InstanceScheduler) enqueueUnscheduledInstances(ctx context.Context, que
 	}
 }
 
+func (s *InstanceScheduler) enqueueRescheduleRequiredInstances(ctx context.Context, queue workqueue.TypedRateLimitingInterface[ctrl.Request], fleetName string) {
+	log := ctrl.LoggerFrom(ctx)
+	instanceList := &corev1alpha1.InstanceList{}
+	if err := s.List(ctx, instanceList, client.MatchingFields{coreclient.InstanceSpecFleetRefNameField: fleetName}); err != nil {
+		log.Error(fmt.Errorf("could not list instances of fleet %s: %w", fleetName, err), "Error listing instances")
+		return
+	}
+
+	for _, instance := range instanceList.Items {
+		if !instance.DeletionTimestamp.IsZero() {
+			continue
+		}
+		cond := &corev1alpha1.InstanceCondition{}
+		if !conditionutils.MustFindSlice(instance.Status.Conditions, string(corev1alpha1.InstanceRescheduleRequired), cond) ||
+			cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&instance)})
+	}
+}
+
 func (s *InstanceScheduler) isInstanceAssigned() predicate.Predicate {
 	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
 		instance := obj.(*corev1alpha1.Instance)
@@ -245,6 +412,12 @@ func (s *InstanceScheduler) handleInstance() handler.EventHandler {
 			if err := s.Cache.UpdateInstance(oldInstance, newInstance); err != nil {
 				log.Error(err, "Error updating instance in cache")
 			}
+
+			// Re-check the fleet capacity if the requested or applied instance type of a bound instance changed.
+			if oldInstance.Spec.InstanceTypeRef != newInstance.Spec.InstanceTypeRef ||
+				!equality.Semantic.DeepEqual(oldInstance.Status.InstanceTypeRef, newInstance.Status.InstanceTypeRef) {
+				queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(newInstance)})
+			}
 		},
 		DeleteFunc: func(ctx context.Context, evt event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
 			log := ctrl.LoggerFrom(ctx)
@@ -269,6 +442,7 @@ func (s *InstanceScheduler) handleFleet() handler.EventHandler {
 			newFleet := evt.ObjectNew.(*corev1alpha1.Fleet)
 			s.Cache.UpdateContainer(oldFleet, newFleet)
 			s.enqueueUnscheduledInstances(ctx, queue)
+			s.enqueueRescheduleRequiredInstances(ctx, queue, newFleet.Name)
 		},
 		DeleteFunc: func(ctx context.Context, evt event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
 			log := ctrl.LoggerFrom(ctx)
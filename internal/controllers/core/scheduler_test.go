@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,6 +61,174 @@ var _ = Describe("InstanceScheduler", func() {
 		))
 	})
 
+	It("should report a required reschedule if the fleet has no capacity for a changed instance type", func(ctx SpecContext) {
+		By("creating a fleet")
+		fleet := &corev1alpha1.Fleet{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-fleet-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, fleet)).To(Succeed(), "failed to create fleet")
+
+		By("creating a larger instance type")
+		largeInstanceType := &corev1alpha1.InstanceType{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "large-instance-type-",
+			},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("4"),
+				corev1alpha1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, largeInstanceType)).To(Succeed(), "failed to create large instance type")
+		DeferCleanup(k8sClient.Delete, largeInstanceType)
+
+		By("patching the fleet status to only contain the smaller instance type")
+		Eventually(UpdateStatus(fleet, func() {
+			fleet.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceInstanceType(instanceType.Name): resource.MustParse("10"),
+			}
+		})).Should(Succeed())
+
+		By("creating a instance w/ the smaller instance type")
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				Image:           "my-image",
+				InstanceTypeRef: corev1alpha1.LocalObjRef(instanceType.Name),
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed(), "failed to create instance")
+
+		By("waiting for the instance to be scheduled onto the fleet")
+		Eventually(Object(instance)).Should(HaveField("Spec.FleetRef", Equal(corev1alpha1.NewLocalObjRef(fleet.Name))))
+
+		By("changing the instance type to the larger instance type")
+		Eventually(Update(instance, func() {
+			instance.Spec.InstanceTypeRef = corev1alpha1.LocalObjRef(largeInstanceType.Name)
+		})).Should(Succeed())
+
+		By("waiting for the instance to report a required reschedule")
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(SatisfyAll(
+			HaveField("Type", Equal(corev1alpha1.InstanceRescheduleRequired)),
+			HaveField("Status", Equal(corev1.ConditionTrue)),
+			HaveField("Reason", Equal("OutOfCapacity")),
+		))))
+
+		By("patching the fleet status to contain the larger instance type")
+		Eventually(UpdateStatus(fleet, func() {
+			fleet.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceInstanceType(instanceType.Name):      resource.MustParse("10"),
+				corev1alpha1.ResourceInstanceType(largeInstanceType.Name): resource.MustParse("2"),
+			}
+		})).Should(Succeed())
+
+		By("waiting for the instance to report it fits the fleet")
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(SatisfyAll(
+			HaveField("Type", Equal(corev1alpha1.InstanceRescheduleRequired)),
+			HaveField("Status", Equal(corev1.ConditionFalse)),
+			HaveField("Reason", Equal("FitsFleet")),
+		))))
+	})
+
+	It("should count the resources of the applied instance type when resizing on a fully used fleet", func(ctx SpecContext) {
+		By("creating a fleet")
+		fleet := &corev1alpha1.Fleet{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-fleet-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, fleet)).To(Succeed(), "failed to create fleet")
+
+		By("creating an instance type of the same size")
+		sameSizeInstanceType := &corev1alpha1.InstanceType{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "same-size-instance-type-",
+			},
+			Capabilities: instanceType.Capabilities,
+		}
+		Expect(k8sClient.Create(ctx, sameSizeInstanceType)).To(Succeed(), "failed to create same size instance type")
+		DeferCleanup(k8sClient.Delete, sameSizeInstanceType)
+
+		By("creating a larger instance type")
+		largeInstanceType := &corev1alpha1.InstanceType{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "large-instance-type-",
+			},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("4"),
+				corev1alpha1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, largeInstanceType)).To(Succeed(), "failed to create large instance type")
+		DeferCleanup(k8sClient.Delete, largeInstanceType)
+
+		By("patching the fleet status to contain the instance type")
+		Eventually(UpdateStatus(fleet, func() {
+			fleet.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceInstanceType(instanceType.Name): resource.MustParse("1"),
+			}
+		})).Should(Succeed())
+
+		By("creating a instance w/ the instance type")
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				Image:           "my-image",
+				InstanceTypeRef: corev1alpha1.LocalObjRef(instanceType.Name),
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed(), "failed to create instance")
+
+		By("waiting for the instance to be scheduled onto the fleet")
+		Eventually(Object(instance)).Should(HaveField("Spec.FleetRef", Equal(corev1alpha1.NewLocalObjRef(fleet.Name))))
+
+		By("reporting the instance type as applied and the fleet as fully used")
+		Eventually(UpdateStatus(instance, func() {
+			instance.Status.InstanceTypeRef = corev1alpha1.NewLocalObjRef(instanceType.Name)
+		})).Should(Succeed())
+		Eventually(UpdateStatus(fleet, func() {
+			fleet.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:                                     resource.MustParse("0"),
+				corev1alpha1.ResourceMemory:                                  resource.MustParse("0"),
+				corev1alpha1.ResourceInstanceType(instanceType.Name):         resource.MustParse("0"),
+				corev1alpha1.ResourceInstanceType(sameSizeInstanceType.Name): resource.MustParse("0"),
+				corev1alpha1.ResourceInstanceType(largeInstanceType.Name):    resource.MustParse("0"),
+			}
+		})).Should(Succeed())
+
+		By("changing the instance type to the instance type of the same size")
+		Eventually(Update(instance, func() {
+			instance.Spec.InstanceTypeRef = corev1alpha1.LocalObjRef(sameSizeInstanceType.Name)
+		})).Should(Succeed())
+
+		By("waiting for the instance to report it fits the fleet")
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(SatisfyAll(
+			HaveField("Type", Equal(corev1alpha1.InstanceRescheduleRequired)),
+			HaveField("Status", Equal(corev1.ConditionFalse)),
+			HaveField("Reason", Equal("FitsFleet")),
+			HaveField("ObservedGeneration", Equal(instance.Generation)),
+		))))
+
+		By("changing the instance type to the larger instance type")
+		Eventually(Update(instance, func() {
+			instance.Spec.InstanceTypeRef = corev1alpha1.LocalObjRef(largeInstanceType.Name)
+		})).Should(Succeed())
+
+		By("waiting for the instance to report a required reschedule")
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(SatisfyAll(
+			HaveField("Type", Equal(corev1alpha1.InstanceRescheduleRequired)),
+			HaveField("Status", Equal(corev1.ConditionTrue)),
+			HaveField("Reason", Equal("OutOfCapacity")),
+		))))
+	})
+
 	It("should schedule schedule instances onto fleets if the fleet becomes available later than the instance", func(ctx SpecContext) {
 		By("creating a instance w/ the requested instance type")
 		instance := &corev1alpha1.Instance{
@@ -159,6 +328,60 @@ var _ = Describe("InstanceScheduler", func() {
 		))
 	})
 
+	It("should schedule onto fleets supporting the capabilities required by the instance", func(ctx SpecContext) {
+		createFleet := func(capabilities ...corev1alpha1.FleetCapability) *corev1alpha1.Fleet {
+			fleet := &corev1alpha1.Fleet{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-fleet-",
+					Labels: map[string]string{
+						"capabilities": "test",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, fleet)).To(Succeed(), "failed to create fleet")
+
+			Eventually(UpdateStatus(fleet, func() {
+				fleet.Status.Allocatable = corev1alpha1.ResourceList{
+					corev1alpha1.ResourceInstanceType(instanceType.Name): resource.MustParse("10"),
+				}
+				fleet.Status.RuntimeInfo = &corev1alpha1.FleetRuntimeInfo{
+					RuntimeName:    "test",
+					RuntimeVersion: "0.1.0",
+					Capabilities:   capabilities,
+				}
+			})).Should(Succeed())
+			return fleet
+		}
+
+		By("creating a fleet w/o suspend support")
+		createFleet(corev1alpha1.FleetCapabilityReboot)
+
+		By("creating a fleet w/ suspend support")
+		fleetWithSuspend := createFleet(corev1alpha1.FleetCapabilityReboot, corev1alpha1.FleetCapabilitySuspend)
+
+		By("creating a suspended instance")
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				Image: "my-image",
+				FleetSelector: map[string]string{
+					"capabilities": "test",
+				},
+				InstanceTypeRef: corev1alpha1.LocalObjRef(instanceType.Name),
+				Power:           corev1alpha1.PowerSuspended,
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed(), "failed to create instance")
+
+		By("waiting for the instance to be scheduled onto the fleet supporting suspend")
+		Eventually(Object(instance)).Should(
+			HaveField("Spec.FleetRef", Equal(corev1alpha1.NewLocalObjRef(fleetWithSuspend.Name))),
+		)
+	})
+
 	It("should schedule a instance with corresponding tolerations onto a fleet with taints", func(ctx SpecContext) {
 		By("creating a fleet w/ taints")
 		taintedFleet := &corev1alpha1.Fleet{
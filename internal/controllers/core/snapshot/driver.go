@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot defines the contract between the DiskSnapshot and DiskRestore controllers and
+// the storage backends that take the actual snapshots of disks and restore disks from them.
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+)
+
+var (
+	// ErrSnapshotNotFound is returned by a Driver if a snapshot does not exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+	// ErrDiskNotFound is returned by a Driver if a restored disk does not exist.
+	ErrDiskNotFound = errors.New("disk not found")
+)
+
+// Snapshot is a snapshot as known to a Driver.
+type Snapshot struct {
+	// ID is the id of the snapshot.
+	ID string
+	// ReadyToUse reports whether the snapshot is complete and can be restored from.
+	ReadyToUse bool
+	// Size is the size of the data of the snapshot.
+	Size resource.Quantity
+	// CreationTime is the point in time the data of the snapshot was captured.
+	CreationTime time.Time
+}
+
+// Driver takes, inspects and deletes snapshots of disks and restores disks from them.
+//
+// Snapshot and disk ids are chosen by the caller, which allows the controllers to use
+// the uids of the DiskSnapshot and Disk objects and keeps all operations idempotent.
+type Driver interface {
+	// CreateSnapshot takes the snapshot id of the disk accessible via access.
+	// If the snapshot already exists, the existing snapshot is returned.
+	CreateSnapshot(ctx context.Context, id string, access *corev1alpha1.DiskAccess) (*Snapshot, error)
+	// GetSnapshot returns the snapshot id or ErrSnapshotNotFound if it does not exist.
+	GetSnapshot(ctx context.Context, id string) (*Snapshot, error)
+	// DeleteSnapshot deletes the snapshot id or returns ErrSnapshotNotFound if it does not exist.
+	DeleteSnapshot(ctx context.Context, id string) error
+	// RestoreSnapshot provisions the disk diskID with the data of the snapshot id and returns how to access it.
+	// If the disk already exists, its access is returned. If the snapshot does not exist, ErrSnapshotNotFound is returned.
+	RestoreSnapshot(ctx context.Context, id, diskID string) (*corev1alpha1.DiskAccess, error)
+	// DeleteDisk deletes the restored disk diskID or returns ErrDiskNotFound if it does not exist.
+	DeleteDisk(ctx context.Context, diskID string) error
+}
+
+// IgnoreSnapshotNotFound returns nil if err is ErrSnapshotNotFound, err otherwise.
+func IgnoreSnapshotNotFound(err error) error {
+	if errors.Is(err, ErrSnapshotNotFound) {
+		return nil
+	}
+	return err
+}
+
+// IgnoreDiskNotFound returns nil if err is ErrDiskNotFound, err otherwise.
+func IgnoreDiskNotFound(err error) error {
+	if errors.Is(err, ErrDiskNotFound) {
+		return nil
+	}
+	return err
+}
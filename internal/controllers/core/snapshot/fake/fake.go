@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides an in-memory snapshot.Driver for testing.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	"spheric.cloud/spheric/internal/controllers/core/snapshot"
+)
+
+// DefaultSnapshotSize is the size of snapshots taken by the Driver if not specified otherwise.
+var DefaultSnapshotSize = resource.MustParse("1Gi")
+
+// DriverName is the driver of the access of disks restored by the Driver.
+const DriverName = "fake"
+
+// Driver is an in-memory snapshot.Driver.
+type Driver struct {
+	sync.Mutex
+
+	// Snapshots are the snapshots of the driver, keyed by snapshot id.
+	Snapshots map[string]*snapshot.Snapshot
+	// Sources are the handles of the disks the snapshots were taken of, keyed by snapshot id.
+	Sources map[string]string
+	// Restores are the ids of the snapshots the disks were restored from, keyed by disk id.
+	Restores map[string]string
+	// SnapshotSize is the size of newly taken snapshots. Defaults to DefaultSnapshotSize.
+	SnapshotSize *resource.Quantity
+	// Pending controls whether newly taken snapshots are not ready to use until marked via SetReady.
+	Pending bool
+}
+
+var _ snapshot.Driver = (*Driver)(nil)
+
+func NewDriver() *Driver {
+	return &Driver{
+		Snapshots: make(map[string]*snapshot.Snapshot),
+		Sources:   make(map[string]string),
+		Restores:  make(map[string]string),
+	}
+}
+
+func copySnapshot(s *snapshot.Snapshot) *snapshot.Snapshot {
+	res := *s
+	res.Size = s.Size.DeepCopy()
+	return &res
+}
+
+func (d *Driver) CreateSnapshot(ctx context.Context, id string, access *corev1alpha1.DiskAccess) (*snapshot.Snapshot, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	if s, ok := d.Snapshots[id]; ok {
+		return copySnapshot(s), nil
+	}
+	if access == nil || access.Handle == "" {
+		return nil, fmt.Errorf("must specify disk handle")
+	}
+
+	size := DefaultSnapshotSize
+	if d.SnapshotSize != nil {
+		size = *d.SnapshotSize
+	}
+
+	s := &snapshot.Snapshot{
+		ID:           id,
+		ReadyToUse:   !d.Pending,
+		Size:         size.DeepCopy(),
+		CreationTime: time.Now().Truncate(time.Second),
+	}
+	d.Snapshots[id] = s
+	d.Sources[id] = access.Handle
+	return copySnapshot(s), nil
+}
+
+func (d *Driver) GetSnapshot(ctx context.Context, id string) (*snapshot.Snapshot, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	s, ok := d.Snapshots[id]
+	if !ok {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+	return copySnapshot(s), nil
+}
+
+func (d *Driver) DeleteSnapshot(ctx context.Context, id string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := d.Snapshots[id]; !ok {
+		return snapshot.ErrSnapshotNotFound
+	}
+	delete(d.Snapshots, id)
+	delete(d.Sources, id)
+	return nil
+}
+
+func restoredDiskAccess(diskID string) *corev1alpha1.DiskAccess {
+	return &corev1alpha1.DiskAccess{
+		Driver: DriverName,
+		Handle: "restored-" + diskID,
+	}
+}
+
+func (d *Driver) RestoreSnapshot(ctx context.Context, id, diskID string) (*corev1alpha1.DiskAccess, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := d.Restores[diskID]; ok {
+		return restoredDiskAccess(diskID), nil
+	}
+
+	s, ok := d.Snapshots[id]
+	if !ok {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+	if !s.ReadyToUse {
+		return nil, fmt.Errorf("snapshot %s is not ready to use", id)
+	}
+
+	d.Restores[diskID] = id
+	return restoredDiskAccess(diskID), nil
+}
+
+func (d *Driver) DeleteDisk(ctx context.Context, diskID string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := d.Restores[diskID]; !ok {
+		return snapshot.ErrDiskNotFound
+	}
+	delete(d.Restores, diskID)
+	return nil
+}
+
+// SetReady marks the snapshot id as ready to use.
+func (d *Driver) SetReady(id string) error {
+	d.Lock()
+	defer d.Unlock()
+
+	s, ok := d.Snapshots[id]
+	if !ok {
+		return snapshot.ErrSnapshotNotFound
+	}
+	s.ReadyToUse = true
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"spheric.cloud/spheric/internal/apis/core"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Disk", Type: "string", Description: "The disk the snapshot was taken of"},
+		{Name: "Ready", Type: "boolean", Description: "Whether the snapshot is ready to be used"},
+		{Name: "Size", Type: "string", Description: "The size of the snapshot"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		diskSnapshot := obj.(*core.DiskSnapshot)
+
+		cells = append(cells, name)
+		cells = append(cells, diskSnapshot.Spec.DiskRef.Name)
+		cells = append(cells, diskSnapshot.Status.ReadyToUse)
+		if size := diskSnapshot.Status.Size; size != nil {
+			cells = append(cells, size.String())
+		} else {
+			cells = append(cells, "<unknown>")
+		}
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package disksnapshot
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"spheric.cloud/spheric/internal/api"
+	"spheric.cloud/spheric/internal/apis/core"
+	"spheric.cloud/spheric/internal/apis/core/validation"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	diskSnapshot, ok := obj.(*core.DiskSnapshot)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a DiskSnapshot")
+	}
+	return diskSnapshot.Labels, SelectableFields(diskSnapshot), nil
+}
+
+func MatchDiskSnapshot(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{},
+	}
+}
+
+func SelectableFields(diskSnapshot *core.DiskSnapshot) fields.Set {
+	fieldsSet := make(fields.Set)
+	return generic.AddObjectMetaFieldsSet(fieldsSet, &diskSnapshot.ObjectMeta, true)
+}
+
+func Indexers() *cache.Indexers {
+	return &cache.Indexers{}
+}
+
+type diskSnapshotStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = diskSnapshotStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (diskSnapshotStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (diskSnapshotStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	diskSnapshot := obj.(*core.DiskSnapshot)
+	diskSnapshot.Status = core.DiskSnapshotStatus{}
+}
+
+func (diskSnapshotStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newDiskSnapshot := obj.(*core.DiskSnapshot)
+	oldDiskSnapshot := old.(*core.DiskSnapshot)
+	newDiskSnapshot.Status = oldDiskSnapshot.Status
+}
+
+func (diskSnapshotStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	diskSnapshot := obj.(*core.DiskSnapshot)
+	return validation.ValidateDiskSnapshot(diskSnapshot)
+}
+
+func (diskSnapshotStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (diskSnapshotStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (diskSnapshotStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (diskSnapshotStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (diskSnapshotStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newDiskSnapshot, oldDiskSnapshot := obj.(*core.DiskSnapshot), old.(*core.DiskSnapshot)
+	return validation.ValidateDiskSnapshotUpdate(oldDiskSnapshot, newDiskSnapshot)
+}
+
+func (diskSnapshotStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type diskSnapshotStatusStrategy struct {
+	diskSnapshotStrategy
+}
+
+var StatusStrategy = diskSnapshotStatusStrategy{Strategy}
+
+func (diskSnapshotStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"core.spheric.cloud/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (diskSnapshotStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newDiskSnapshot := obj.(*core.DiskSnapshot)
+	oldDiskSnapshot := old.(*core.DiskSnapshot)
+	newDiskSnapshot.Spec = oldDiskSnapshot.Spec
+}
+
+func (diskSnapshotStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return nil
+}
+
+func (diskSnapshotStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
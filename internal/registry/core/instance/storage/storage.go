@@ -13,22 +13,28 @@ import (
 
 	"spheric.cloud/spheric/internal/registry/core/instance"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/proxy"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	genericrest "k8s.io/apiserver/pkg/registry/generic/rest"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
 	"spheric.cloud/spheric/internal/apis/core"
+	"spheric.cloud/spheric/internal/apis/core/validation"
 	"spheric.cloud/spheric/internal/spherelet/client"
 )
 
 type InstanceStorage struct {
-	Instance *REST
-	Status   *StatusREST
-	Exec     *ExecREST
+	Instance    *REST
+	Status      *StatusREST
+	Exec        *ExecREST
+	PortForward *PortForwardREST
+	Log         *LogREST
+	Stats       *StatsREST
 }
 
 type REST struct {
@@ -71,9 +77,12 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, k client.ConnectionInfoGet
 	statusStore.ResetFieldsStrategy = instance.StatusStrategy
 
 	return InstanceStorage{
-		Instance: &REST{store},
-		Status:   &StatusREST{&statusStore},
-		Exec:     &ExecREST{store, k},
+		Instance:    &REST{store},
+		Status:      &StatusREST{&statusStore},
+		Exec:        &ExecREST{store, k},
+		PortForward: &PortForwardREST{store, k},
+		Log:         &LogREST{store, k},
+		Stats:       &StatsREST{store, k},
 	}, nil
 }
 
@@ -139,3 +148,126 @@ func (r *ExecREST) ConnectMethods() []string {
 }
 
 func (r *ExecREST) Destroy() {}
+
+// PortForwardREST forwards ports of an instance by proxying to the spherelet of its fleet.
+type PortForwardREST struct {
+	Store        *genericregistry.Store
+	InstanceConn client.ConnectionInfoGetter
+}
+
+func (r *PortForwardREST) New() runtime.Object {
+	return &core.InstancePortForwardOptions{}
+}
+
+func (r *PortForwardREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	portForwardOpts, ok := opts.(*core.InstancePortForwardOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options objects: %#v", opts)
+	}
+
+	location, transport, err := instance.PortForwardLocation(ctx, r.Store, r.InstanceConn, name, portForwardOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newThrottledUpgradeAwareProxyHandler(location, transport, false, true, responder), nil
+}
+
+func (r *PortForwardREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &core.InstancePortForwardOptions{}, false, ""
+}
+
+func (r *PortForwardREST) ConnectMethods() []string {
+	return upgradeableMethods
+}
+
+func (r *PortForwardREST) Destroy() {}
+
+// LogREST serves the serial console log of an instance by streaming it from the spherelet of its fleet.
+type LogREST struct {
+	Store        *genericregistry.Store
+	InstanceConn client.ConnectionInfoGetter
+}
+
+var _ = rest.GetterWithOptions(&LogREST{})
+
+func (r *LogREST) New() runtime.Object {
+	return &core.InstanceLogOptions{}
+}
+
+func (r *LogREST) Get(ctx context.Context, name string, opts runtime.Object) (runtime.Object, error) {
+	logOpts, ok := opts.(*core.InstanceLogOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options objects: %#v", opts)
+	}
+
+	if errs := validation.ValidateInstanceLogOptions(logOpts); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(core.Kind("InstanceLogOptions").GroupKind(), name, errs)
+	}
+
+	location, transport, err := instance.LogLocation(ctx, r.Store, r.InstanceConn, name, logOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genericrest.LocationStreamer{
+		Location:        location,
+		Transport:       transport,
+		ContentType:     "text/plain",
+		Flush:           logOpts.Follow,
+		ResponseChecker: genericrest.NewGenericHttpResponseChecker(core.Resource("instances/log"), name),
+		RedirectChecker: genericrest.PreventRedirects,
+	}, nil
+}
+
+func (r *LogREST) NewGetOptions() (runtime.Object, bool, string) {
+	return &core.InstanceLogOptions{}, false, ""
+}
+
+// ProducesMIMETypes returns a list of the MIME types the specified HTTP verb (GET, POST, DELETE,
+// PATCH) can respond with.
+func (r *LogREST) ProducesMIMETypes(verb string) []string {
+	return []string{"text/plain"}
+}
+
+// ProducesObject returns an object the specified HTTP verb respond with. It will overwrite storage object if
+// it is not nil. Only the type of the return object matters, the value will be ignored.
+func (r *LogREST) ProducesObject(verb string) interface{} {
+	return ""
+}
+
+func (r *LogREST) Destroy() {}
+
+// StatsREST serves the stats of an instance by proxying to the spherelet of its fleet.
+type StatsREST struct {
+	Store        *genericregistry.Store
+	InstanceConn client.ConnectionInfoGetter
+}
+
+func (r *StatsREST) New() runtime.Object {
+	return &core.InstanceStatsOptions{}
+}
+
+func (r *StatsREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	statsOpts, ok := opts.(*core.InstanceStatsOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options objects: %#v", opts)
+	}
+
+	location, transport, err := instance.StatsLocation(ctx, r.Store, r.InstanceConn, name, statsOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy.NewUpgradeAwareHandler(location, transport, false, false, proxy.NewErrorResponder(responder)), nil
+}
+
+func (r *StatsREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &core.InstanceStatsOptions{}, false, ""
+}
+
+func (r *StatsREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+func (r *StatsREST) Destroy() {}
@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -122,7 +123,7 @@ func (instanceStrategy) Canonicalize(obj runtime.Object) {
 func (instanceStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
 	oldInstance := old.(*core.Instance)
 	newInstance := obj.(*core.Instance)
-	return validation.ValidateInstanceUpdate(newInstance, oldInstance)
+	return validation.ValidateInstanceUpdate(oldInstance, newInstance)
 }
 
 func (instanceStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
@@ -152,7 +153,7 @@ func (instanceStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old run
 func (instanceStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
 	newInstance := obj.(*core.Instance)
 	oldInstance := old.(*core.Instance)
-	return validation.ValidateInstanceUpdate(newInstance, oldInstance)
+	return validation.ValidateInstanceUpdate(oldInstance, newInstance)
 }
 
 func (instanceStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
@@ -169,6 +170,73 @@ func ExecLocation(
 	connInfo client.ConnectionInfoGetter,
 	name string,
 	opts *core.InstanceExecOptions,
+) (*url.URL, http.RoundTripper, error) {
+	return sphereletLocation(ctx, getter, connInfo, name, "exec", opts.InsecureSkipTLSVerifyBackend)
+}
+
+func PortForwardLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name string,
+	opts *core.InstancePortForwardOptions,
+) (*url.URL, http.RoundTripper, error) {
+	loc, transport, err := sphereletLocation(ctx, getter, connInfo, name, "portforward", opts.InsecureSkipTLSVerifyBackend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	for _, port := range opts.Ports {
+		params.Add("port", strconv.FormatInt(int64(port), 10))
+	}
+	loc.RawQuery = params.Encode()
+	return loc, transport, nil
+}
+
+func LogLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name string,
+	opts *core.InstanceLogOptions,
+) (*url.URL, http.RoundTripper, error) {
+	loc, transport, err := sphereletLocation(ctx, getter, connInfo, name, "log", opts.InsecureSkipTLSVerifyBackend)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	if opts.Follow {
+		params.Add("follow", "true")
+	}
+	if opts.TailLines != nil {
+		params.Add("tailLines", strconv.FormatInt(*opts.TailLines, 10))
+	}
+	if opts.SinceSeconds != nil {
+		params.Add("sinceSeconds", strconv.FormatInt(*opts.SinceSeconds, 10))
+	}
+	loc.RawQuery = params.Encode()
+	return loc, transport, nil
+}
+
+func StatsLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name string,
+	opts *core.InstanceStatsOptions,
+) (*url.URL, http.RoundTripper, error) {
+	return sphereletLocation(ctx, getter, connInfo, name, "stats", opts.InsecureSkipTLSVerifyBackend)
+}
+
+// sphereletLocation returns the location of the given subresource of an instance at the spherelet of its fleet.
+func sphereletLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name, subresource string,
+	insecureSkipTLSVerifyBackend bool,
 ) (*url.URL, http.RoundTripper, error) {
 	instance, err := getInstance(ctx, getter, name)
 	if err != nil {
@@ -189,10 +257,10 @@ func ExecLocation(
 	loc := &url.URL{
 		Scheme: fleetInfo.Scheme,
 		Host:   net.JoinHostPort(fleetInfo.Hostname, fleetInfo.Port),
-		Path:   fmt.Sprintf("/apis/core.spheric.cloud/namespaces/%s/instances/%s/exec", instance.Namespace, instance.Name),
+		Path:   fmt.Sprintf("/apis/core.spheric.cloud/namespaces/%s/instances/%s/%s", instance.Namespace, instance.Name, subresource),
 	}
 	transport := fleetInfo.Transport
-	if opts.InsecureSkipTLSVerifyBackend {
+	if insecureSkipTLSVerifyBackend {
 		transport = fleetInfo.InsecureSkipTLSVerifyTransport
 	}
 
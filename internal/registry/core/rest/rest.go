@@ -13,6 +13,7 @@ import (
 	"spheric.cloud/spheric/internal/api"
 	"spheric.cloud/spheric/internal/apis/core"
 	diskstorage "spheric.cloud/spheric/internal/registry/core/disk/storage"
+	disksnapshotstorage "spheric.cloud/spheric/internal/registry/core/disksnapshot/storage"
 	disktypestorage "spheric.cloud/spheric/internal/registry/core/disktype/storage"
 	fleetstorage "spheric.cloud/spheric/internal/registry/core/fleet/storage"
 	instancestorage "spheric.cloud/spheric/internal/registry/core/instance/storage"
@@ -58,6 +59,14 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["disks"] = diskStorage.Disk
 	storageMap["disks/status"] = diskStorage.Status
 
+	diskSnapshotStorage, err := disksnapshotstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["disksnapshots"] = diskSnapshotStorage.DiskSnapshot
+	storageMap["disksnapshots/status"] = diskSnapshotStorage.Status
+
 	diskTypeStorage, err := disktypestorage.NewStorage(restOptionsGetter)
 	if err != nil {
 		return storageMap, err
@@ -81,6 +90,9 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["instances"] = instanceStorage.Instance
 	storageMap["instances/status"] = instanceStorage.Status
 	storageMap["instances/exec"] = instanceStorage.Exec
+	storageMap["instances/portforward"] = instanceStorage.PortForward
+	storageMap["instances/log"] = instanceStorage.Log
+	storageMap["instances/stats"] = instanceStorage.Stats
 
 	instanceTypeStorage, err := instancetypestorage.NewStorage(restOptionsGetter)
 	if err != nil {
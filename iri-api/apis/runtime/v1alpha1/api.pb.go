@@ -21,11 +21,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LabelSelectorOperator int32
+
+const (
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN             LabelSelectorOperator = 0
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN         LabelSelectorOperator = 1
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS         LabelSelectorOperator = 2
+	LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST LabelSelectorOperator = 3
+)
+
+// Enum value maps for LabelSelectorOperator.
+var (
+	LabelSelectorOperator_name = map[int32]string{
+		0: "LABEL_SELECTOR_OPERATOR_IN",
+		1: "LABEL_SELECTOR_OPERATOR_NOT_IN",
+		2: "LABEL_SELECTOR_OPERATOR_EXISTS",
+		3: "LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST",
+	}
+	LabelSelectorOperator_value = map[string]int32{
+		"LABEL_SELECTOR_OPERATOR_IN":             0,
+		"LABEL_SELECTOR_OPERATOR_NOT_IN":         1,
+		"LABEL_SELECTOR_OPERATOR_EXISTS":         2,
+		"LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST": 3,
+	}
+)
+
+func (x LabelSelectorOperator) Enum() *LabelSelectorOperator {
+	p := new(LabelSelectorOperator)
+	*p = x
+	return p
+}
+
+func (x LabelSelectorOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LabelSelectorOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (LabelSelectorOperator) Type() protoreflect.EnumType {
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x LabelSelectorOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LabelSelectorOperator.Descriptor instead.
+func (LabelSelectorOperator) EnumDescriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
 type Power int32
 
 const (
-	Power_POWER_ON  Power = 0
-	Power_POWER_OFF Power = 1
+	Power_POWER_ON        Power = 0
+	Power_POWER_OFF       Power = 1
+	Power_POWER_SUSPENDED Power = 2
 )
 
 // Enum value maps for Power.
@@ -33,10 +86,12 @@ var (
 	Power_name = map[int32]string{
 		0: "POWER_ON",
 		1: "POWER_OFF",
+		2: "POWER_SUSPENDED",
 	}
 	Power_value = map[string]int32{
-		"POWER_ON":  0,
-		"POWER_OFF": 1,
+		"POWER_ON":        0,
+		"POWER_OFF":       1,
+		"POWER_SUSPENDED": 2,
 	}
 )
 
@@ -51,11 +106,11 @@ func (x Power) String() string {
 }
 
 func (Power) Descriptor() protoreflect.EnumDescriptor {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[0].Descriptor()
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[1].Descriptor()
 }
 
 func (Power) Type() protoreflect.EnumType {
-	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[0]
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[1]
 }
 
 func (x Power) Number() protoreflect.EnumNumber {
@@ -64,7 +119,7 @@ func (x Power) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Power.Descriptor instead.
 func (Power) EnumDescriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{1}
 }
 
 type DiskState int32
@@ -97,11 +152,11 @@ func (x DiskState) String() string {
 }
 
 func (DiskState) Descriptor() protoreflect.EnumDescriptor {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[1].Descriptor()
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[2].Descriptor()
 }
 
 func (DiskState) Type() protoreflect.EnumType {
-	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[1]
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[2]
 }
 
 func (x DiskState) Number() protoreflect.EnumNumber {
@@ -110,7 +165,7 @@ func (x DiskState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DiskState.Descriptor instead.
 func (DiskState) EnumDescriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{2}
 }
 
 type NetworkInterfaceState int32
@@ -143,11 +198,11 @@ func (x NetworkInterfaceState) String() string {
 }
 
 func (NetworkInterfaceState) Descriptor() protoreflect.EnumDescriptor {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[2].Descriptor()
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[3].Descriptor()
 }
 
 func (NetworkInterfaceState) Type() protoreflect.EnumType {
-	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[2]
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[3]
 }
 
 func (x NetworkInterfaceState) Number() protoreflect.EnumNumber {
@@ -156,7 +211,7 @@ func (x NetworkInterfaceState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkInterfaceState.Descriptor instead.
 func (NetworkInterfaceState) EnumDescriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{3}
 }
 
 type InstanceState int32
@@ -195,11 +250,11 @@ func (x InstanceState) String() string {
 }
 
 func (InstanceState) Descriptor() protoreflect.EnumDescriptor {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[3].Descriptor()
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[4].Descriptor()
 }
 
 func (InstanceState) Type() protoreflect.EnumType {
-	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[3]
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[4]
 }
 
 func (x InstanceState) Number() protoreflect.EnumNumber {
@@ -208,7 +263,106 @@ func (x InstanceState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstanceState.Descriptor instead.
 func (InstanceState) EnumDescriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_ADDED    WatchEventType = 0
+	WatchEventType_WATCH_EVENT_MODIFIED WatchEventType = 1
+	WatchEventType_WATCH_EVENT_DELETED  WatchEventType = 2
+	// Marks that an ADDED event was sent for every instance existing when the watch started.
+	WatchEventType_WATCH_EVENT_SYNCED WatchEventType = 3
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_ADDED",
+		1: "WATCH_EVENT_MODIFIED",
+		2: "WATCH_EVENT_DELETED",
+		3: "WATCH_EVENT_SYNCED",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_ADDED":    0,
+		"WATCH_EVENT_MODIFIED": 1,
+		"WATCH_EVENT_DELETED":  2,
+		"WATCH_EVENT_SYNCED":   3,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[5]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+type RebootType int32
+
+const (
+	RebootType_REBOOT_GRACEFUL RebootType = 0
+	RebootType_REBOOT_HARD     RebootType = 1
+)
+
+// Enum value maps for RebootType.
+var (
+	RebootType_name = map[int32]string{
+		0: "REBOOT_GRACEFUL",
+		1: "REBOOT_HARD",
+	}
+	RebootType_value = map[string]int32{
+		"REBOOT_GRACEFUL": 0,
+		"REBOOT_HARD":     1,
+	}
+)
+
+func (x RebootType) Enum() *RebootType {
+	p := new(RebootType)
+	*p = x
+	return p
+}
+
+func (x RebootType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebootType) Descriptor() protoreflect.EnumDescriptor {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[6].Descriptor()
+}
+
+func (RebootType) Type() protoreflect.EnumType {
+	return &file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes[6]
+}
+
+func (x RebootType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebootType.Descriptor instead.
+func (RebootType) EnumDescriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{6}
 }
 
 type ObjectMetadata struct {
@@ -369,6 +523,69 @@ func (x *DiskSpec) GetSecretData() map[string][]byte {
 	return nil
 }
 
+type LabelSelectorRequirement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator LabelSelectorOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=runtime.v1alpha1.LabelSelectorOperator" json:"operator,omitempty"`
+	Values   []string              `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *LabelSelectorRequirement) Reset() {
+	*x = LabelSelectorRequirement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelSelectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelectorRequirement) ProtoMessage() {}
+
+func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LabelSelectorRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetOperator() LabelSelectorOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN
+}
+
+func (x *LabelSelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type InstanceFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -376,12 +593,16 @@ type InstanceFilter struct {
 
 	Id            string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector map[string]string `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Set-based label requirements that have to match in addition to label_selector.
+	LabelSelectorRequirements []*LabelSelectorRequirement `protobuf:"bytes,3,rep,name=label_selector_requirements,json=labelSelectorRequirements,proto3" json:"label_selector_requirements,omitempty"`
+	// States instances have to be in. If empty, instances in any state match.
+	States []InstanceState `protobuf:"varint,4,rep,packed,name=states,proto3,enum=runtime.v1alpha1.InstanceState" json:"states,omitempty"`
 }
 
 func (x *InstanceFilter) Reset() {
 	*x = InstanceFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +615,7 @@ func (x *InstanceFilter) String() string {
 func (*InstanceFilter) ProtoMessage() {}
 
 func (x *InstanceFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +628,7 @@ func (x *InstanceFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceFilter.ProtoReflect.Descriptor instead.
 func (*InstanceFilter) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InstanceFilter) GetId() string {
@@ -424,6 +645,20 @@ func (x *InstanceFilter) GetLabelSelector() map[string]string {
 	return nil
 }
 
+func (x *InstanceFilter) GetLabelSelectorRequirements() []*LabelSelectorRequirement {
+	if x != nil {
+		return x.LabelSelectorRequirements
+	}
+	return nil
+}
+
+func (x *InstanceFilter) GetStates() []InstanceState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
 type Instance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -437,7 +672,7 @@ type Instance struct {
 func (x *Instance) Reset() {
 	*x = Instance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +685,7 @@ func (x *Instance) String() string {
 func (*Instance) ProtoMessage() {}
 
 func (x *Instance) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +698,7 @@ func (x *Instance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Instance.ProtoReflect.Descriptor instead.
 func (*Instance) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Instance) GetMetadata() *ObjectMetadata {
@@ -498,7 +733,7 @@ type ImageSpec struct {
 func (x *ImageSpec) Reset() {
 	*x = ImageSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -511,7 +746,7 @@ func (x *ImageSpec) String() string {
 func (*ImageSpec) ProtoMessage() {}
 
 func (x *ImageSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +759,7 @@ func (x *ImageSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageSpec.ProtoReflect.Descriptor instead.
 func (*ImageSpec) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ImageSpec) GetImage() string {
@@ -545,7 +780,7 @@ type EmptyDisk struct {
 func (x *EmptyDisk) Reset() {
 	*x = EmptyDisk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -558,7 +793,7 @@ func (x *EmptyDisk) String() string {
 func (*EmptyDisk) ProtoMessage() {}
 
 func (x *EmptyDisk) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +806,7 @@ func (x *EmptyDisk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyDisk.ProtoReflect.Descriptor instead.
 func (*EmptyDisk) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EmptyDisk) GetSizeBytes() int64 {
@@ -595,7 +830,7 @@ type DiskConnection struct {
 func (x *DiskConnection) Reset() {
 	*x = DiskConnection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -608,7 +843,7 @@ func (x *DiskConnection) String() string {
 func (*DiskConnection) ProtoMessage() {}
 
 func (x *DiskConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +856,7 @@ func (x *DiskConnection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskConnection.ProtoReflect.Descriptor instead.
 func (*DiskConnection) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DiskConnection) GetDriver() string {
@@ -666,7 +901,7 @@ type Disk struct {
 func (x *Disk) Reset() {
 	*x = Disk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -679,7 +914,7 @@ func (x *Disk) String() string {
 func (*Disk) ProtoMessage() {}
 
 func (x *Disk) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +927,7 @@ func (x *Disk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Disk.ProtoReflect.Descriptor instead.
 func (*Disk) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Disk) GetName() string {
@@ -737,7 +972,7 @@ type NetworkInterfaceSubnetMetadata struct {
 func (x *NetworkInterfaceSubnetMetadata) Reset() {
 	*x = NetworkInterfaceSubnetMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -750,7 +985,7 @@ func (x *NetworkInterfaceSubnetMetadata) String() string {
 func (*NetworkInterfaceSubnetMetadata) ProtoMessage() {}
 
 func (x *NetworkInterfaceSubnetMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,7 +998,7 @@ func (x *NetworkInterfaceSubnetMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkInterfaceSubnetMetadata.ProtoReflect.Descriptor instead.
 func (*NetworkInterfaceSubnetMetadata) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NetworkInterfaceSubnetMetadata) GetNetworkName() string {
@@ -808,7 +1043,7 @@ type NetworkInterface struct {
 func (x *NetworkInterface) Reset() {
 	*x = NetworkInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -821,7 +1056,7 @@ func (x *NetworkInterface) String() string {
 func (*NetworkInterface) ProtoMessage() {}
 
 func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1069,7 @@ func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
 func (*NetworkInterface) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NetworkInterface) GetName() string {
@@ -883,7 +1118,7 @@ type InstanceSpec struct {
 func (x *InstanceSpec) Reset() {
 	*x = InstanceSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1131,7 @@ func (x *InstanceSpec) String() string {
 func (*InstanceSpec) ProtoMessage() {}
 
 func (x *InstanceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1144,7 @@ func (x *InstanceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceSpec.ProtoReflect.Descriptor instead.
 func (*InstanceSpec) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InstanceSpec) GetPower() Power {
@@ -983,7 +1218,7 @@ type InstanceStatus struct {
 func (x *InstanceStatus) Reset() {
 	*x = InstanceStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1231,7 @@ func (x *InstanceStatus) String() string {
 func (*InstanceStatus) ProtoMessage() {}
 
 func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1244,7 @@ func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstanceStatus.ProtoReflect.Descriptor instead.
 func (*InstanceStatus) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *InstanceStatus) GetObservedGeneration() int64 {
@@ -1059,7 +1294,7 @@ type DiskStatus struct {
 func (x *DiskStatus) Reset() {
 	*x = DiskStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1072,7 +1307,7 @@ func (x *DiskStatus) String() string {
 func (*DiskStatus) ProtoMessage() {}
 
 func (x *DiskStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1320,7 @@ func (x *DiskStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskStatus.ProtoReflect.Descriptor instead.
 func (*DiskStatus) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{12}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DiskStatus) GetName() string {
@@ -1115,7 +1350,7 @@ type NetworkInterfaceStatus struct {
 func (x *NetworkInterfaceStatus) Reset() {
 	*x = NetworkInterfaceStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1128,7 +1363,7 @@ func (x *NetworkInterfaceStatus) String() string {
 func (*NetworkInterfaceStatus) ProtoMessage() {}
 
 func (x *NetworkInterfaceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1376,7 @@ func (x *NetworkInterfaceStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkInterfaceStatus.ProtoReflect.Descriptor instead.
 func (*NetworkInterfaceStatus) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{13}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NetworkInterfaceStatus) GetName() string {
@@ -1176,7 +1411,7 @@ type VersionRequest struct {
 func (x *VersionRequest) Reset() {
 	*x = VersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1189,7 +1424,7 @@ func (x *VersionRequest) String() string {
 func (*VersionRequest) ProtoMessage() {}
 
 func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1437,7 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{14}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VersionRequest) GetVersion() string {
@@ -1222,12 +1457,14 @@ type VersionResponse struct {
 	// Version of the instance runtime. The string must be
 	// semver-compatible.
 	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// Optional features supported by the instance runtime.
+	Capabilities *RuntimeCapabilities `protobuf:"bytes,3,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1240,7 +1477,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +1490,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{15}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *VersionResponse) GetRuntimeName() string {
@@ -1270,31 +1507,180 @@ func (x *VersionResponse) GetRuntimeVersion() string {
 	return ""
 }
 
-type ListInstancesRequest struct {
+func (x *VersionResponse) GetCapabilities() *RuntimeCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type RuntimeCapabilities struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filter *InstanceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-}
-
-func (x *ListInstancesRequest) Reset() {
-	*x = ListInstancesRequest{}
+	// Disks can be attached to and detached from existing instances.
+	HotplugDisks bool `protobuf:"varint,1,opt,name=hotplug_disks,json=hotplugDisks,proto3" json:"hotplug_disks,omitempty"`
+	// Network interfaces can be attached to and detached from existing instances.
+	HotplugNetworkInterfaces bool `protobuf:"varint,2,opt,name=hotplug_network_interfaces,json=hotplugNetworkInterfaces,proto3" json:"hotplug_network_interfaces,omitempty"`
+	// Instance changes can be streamed via WatchInstances.
+	WatchInstances bool `protobuf:"varint,3,opt,name=watch_instances,json=watchInstances,proto3" json:"watch_instances,omitempty"`
+	// Instance statistics can be queried via InstanceStats and ListInstanceStats.
+	InstanceStats bool `protobuf:"varint,4,opt,name=instance_stats,json=instanceStats,proto3" json:"instance_stats,omitempty"`
+	// Instance consoles can be streamed via Exec.
+	Exec bool `protobuf:"varint,5,opt,name=exec,proto3" json:"exec,omitempty"`
+	// Instances can be rebooted via RebootInstance.
+	Reboot bool `protobuf:"varint,6,opt,name=reboot,proto3" json:"reboot,omitempty"`
+	// Instances can be suspended via POWER_SUSPENDED.
+	Suspend bool `protobuf:"varint,7,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	// Images can be managed via the ImageService.
+	Images bool `protobuf:"varint,8,opt,name=images,proto3" json:"images,omitempty"`
+	// Instance ports can be forwarded via PortForward.
+	PortForward bool `protobuf:"varint,9,opt,name=port_forward,json=portForward,proto3" json:"port_forward,omitempty"`
+	// Serial console logs of instances can be read via GetConsoleLog.
+	ConsoleLog bool `protobuf:"varint,10,opt,name=console_log,json=consoleLog,proto3" json:"console_log,omitempty"`
+	// Resources of existing instances can be updated via UpdateInstanceResources.
+	Resize bool `protobuf:"varint,11,opt,name=resize,proto3" json:"resize,omitempty"`
+}
+
+func (x *RuntimeCapabilities) Reset() {
+	*x = RuntimeCapabilities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListInstancesRequest) String() string {
+func (x *RuntimeCapabilities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstancesRequest) ProtoMessage() {}
+func (*RuntimeCapabilities) ProtoMessage() {}
 
-func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16]
+func (x *RuntimeCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeCapabilities.ProtoReflect.Descriptor instead.
+func (*RuntimeCapabilities) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RuntimeCapabilities) GetHotplugDisks() bool {
+	if x != nil {
+		return x.HotplugDisks
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetHotplugNetworkInterfaces() bool {
+	if x != nil {
+		return x.HotplugNetworkInterfaces
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetWatchInstances() bool {
+	if x != nil {
+		return x.WatchInstances
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetInstanceStats() bool {
+	if x != nil {
+		return x.InstanceStats
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetExec() bool {
+	if x != nil {
+		return x.Exec
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetReboot() bool {
+	if x != nil {
+		return x.Reboot
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetImages() bool {
+	if x != nil {
+		return x.Images
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetPortForward() bool {
+	if x != nil {
+		return x.PortForward
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetConsoleLog() bool {
+	if x != nil {
+		return x.ConsoleLog
+	}
+	return false
+}
+
+func (x *RuntimeCapabilities) GetResize() bool {
+	if x != nil {
+		return x.Resize
+	}
+	return false
+}
+
+type ListInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *InstanceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of instances to return. If 0, all instances are returned.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token of a previous response to continue listing from.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListInstancesRequest) Reset() {
+	*x = ListInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstancesRequest) ProtoMessage() {}
+
+func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1307,7 +1693,7 @@ func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
 func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{16}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListInstancesRequest) GetFilter() *InstanceFilter {
@@ -1317,18 +1703,34 @@ func (x *ListInstancesRequest) GetFilter() *InstanceFilter {
 	return nil
 }
 
+func (x *ListInstancesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListInstancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Instances []*Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	// Token to retrieve the next page. Empty if there are no more instances.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListInstancesResponse) Reset() {
 	*x = ListInstancesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1341,7 +1743,7 @@ func (x *ListInstancesResponse) String() string {
 func (*ListInstancesResponse) ProtoMessage() {}
 
 func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1354,7 +1756,7 @@ func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInstancesResponse.ProtoReflect.Descriptor instead.
 func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{17}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListInstancesResponse) GetInstances() []*Instance {
@@ -1364,6 +1766,136 @@ func (x *ListInstancesResponse) GetInstances() []*Instance {
 	return nil
 }
 
+func (x *ListInstancesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *InstanceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Resume token of the last event received by a previous watch. If set, only events after it are sent.
+	// If unset, an ADDED event is sent for each existing instance, followed by a SYNCED event.
+	// Runtimes respond with OUT_OF_RANGE if the resume token is no longer valid.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchInstancesRequest) Reset() {
+	*x = WatchInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInstancesRequest) ProtoMessage() {}
+
+func (x *WatchInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInstancesRequest.ProtoReflect.Descriptor instead.
+func (*WatchInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchInstancesRequest) GetFilter() *InstanceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchInstancesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=runtime.v1alpha1.WatchEventType" json:"type,omitempty"`
+	// The instance the event is about. Unset for SYNCED events.
+	Instance *Instance `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Token to resume the watch after this event with.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchInstancesResponse) Reset() {
+	*x = WatchInstancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInstancesResponse) ProtoMessage() {}
+
+func (x *WatchInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInstancesResponse.ProtoReflect.Descriptor instead.
+func (*WatchInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchInstancesResponse) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_WATCH_EVENT_ADDED
+}
+
+func (x *WatchInstancesResponse) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *WatchInstancesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
 type CreateInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1375,7 +1907,7 @@ type CreateInstanceRequest struct {
 func (x *CreateInstanceRequest) Reset() {
 	*x = CreateInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1388,7 +1920,7 @@ func (x *CreateInstanceRequest) String() string {
 func (*CreateInstanceRequest) ProtoMessage() {}
 
 func (x *CreateInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,7 +1933,7 @@ func (x *CreateInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInstanceRequest.ProtoReflect.Descriptor instead.
 func (*CreateInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{18}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateInstanceRequest) GetInstance() *Instance {
@@ -1422,7 +1954,7 @@ type CreateInstanceResponse struct {
 func (x *CreateInstanceResponse) Reset() {
 	*x = CreateInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1967,7 @@ func (x *CreateInstanceResponse) String() string {
 func (*CreateInstanceResponse) ProtoMessage() {}
 
 func (x *CreateInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1980,7 @@ func (x *CreateInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInstanceResponse.ProtoReflect.Descriptor instead.
 func (*CreateInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{19}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreateInstanceResponse) GetInstance() *Instance {
@@ -1469,7 +2001,7 @@ type DeleteInstanceRequest struct {
 func (x *DeleteInstanceRequest) Reset() {
 	*x = DeleteInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1482,7 +2014,7 @@ func (x *DeleteInstanceRequest) String() string {
 func (*DeleteInstanceRequest) ProtoMessage() {}
 
 func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,7 +2027,7 @@ func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInstanceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{20}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteInstanceRequest) GetInstanceId() string {
@@ -1514,7 +2046,7 @@ type DeleteInstanceResponse struct {
 func (x *DeleteInstanceResponse) Reset() {
 	*x = DeleteInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1527,7 +2059,7 @@ func (x *DeleteInstanceResponse) String() string {
 func (*DeleteInstanceResponse) ProtoMessage() {}
 
 func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +2072,7 @@ func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteInstanceResponse.ProtoReflect.Descriptor instead.
 func (*DeleteInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{21}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{25}
 }
 
 type UpdateInstanceAnnotationsRequest struct {
@@ -1555,7 +2087,7 @@ type UpdateInstanceAnnotationsRequest struct {
 func (x *UpdateInstanceAnnotationsRequest) Reset() {
 	*x = UpdateInstanceAnnotationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1568,7 +2100,7 @@ func (x *UpdateInstanceAnnotationsRequest) String() string {
 func (*UpdateInstanceAnnotationsRequest) ProtoMessage() {}
 
 func (x *UpdateInstanceAnnotationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,7 +2113,7 @@ func (x *UpdateInstanceAnnotationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInstanceAnnotationsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceAnnotationsRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{22}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateInstanceAnnotationsRequest) GetInstanceId() string {
@@ -1607,7 +2139,7 @@ type UpdateInstanceAnnotationsResponse struct {
 func (x *UpdateInstanceAnnotationsResponse) Reset() {
 	*x = UpdateInstanceAnnotationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1620,7 +2152,7 @@ func (x *UpdateInstanceAnnotationsResponse) String() string {
 func (*UpdateInstanceAnnotationsResponse) ProtoMessage() {}
 
 func (x *UpdateInstanceAnnotationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +2165,7 @@ func (x *UpdateInstanceAnnotationsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateInstanceAnnotationsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInstanceAnnotationsResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{23}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{27}
 }
 
 type UpdateInstancePowerRequest struct {
@@ -1648,7 +2180,7 @@ type UpdateInstancePowerRequest struct {
 func (x *UpdateInstancePowerRequest) Reset() {
 	*x = UpdateInstancePowerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1661,7 +2193,7 @@ func (x *UpdateInstancePowerRequest) String() string {
 func (*UpdateInstancePowerRequest) ProtoMessage() {}
 
 func (x *UpdateInstancePowerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,7 +2206,7 @@ func (x *UpdateInstancePowerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInstancePowerRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInstancePowerRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{24}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateInstancePowerRequest) GetInstanceId() string {
@@ -1700,7 +2232,7 @@ type UpdateInstancePowerResponse struct {
 func (x *UpdateInstancePowerResponse) Reset() {
 	*x = UpdateInstancePowerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1713,7 +2245,7 @@ func (x *UpdateInstancePowerResponse) String() string {
 func (*UpdateInstancePowerResponse) ProtoMessage() {}
 
 func (x *UpdateInstancePowerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,35 +2258,239 @@ func (x *UpdateInstancePowerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInstancePowerResponse.ProtoReflect.Descriptor instead.
 func (*UpdateInstancePowerResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{25}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{29}
 }
 
-type AttachDiskRequest struct {
+type RebootInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Disk       *Disk  `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk,omitempty"`
+	InstanceId string     `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Type       RebootType `protobuf:"varint,2,opt,name=type,proto3,enum=runtime.v1alpha1.RebootType" json:"type,omitempty"`
 }
 
-func (x *AttachDiskRequest) Reset() {
-	*x = AttachDiskRequest{}
+func (x *RebootInstanceRequest) Reset() {
+	*x = RebootInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AttachDiskRequest) String() string {
+func (x *RebootInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachDiskRequest) ProtoMessage() {}
+func (*RebootInstanceRequest) ProtoMessage() {}
 
-func (x *AttachDiskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26]
+func (x *RebootInstanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootInstanceRequest.ProtoReflect.Descriptor instead.
+func (*RebootInstanceRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RebootInstanceRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *RebootInstanceRequest) GetType() RebootType {
+	if x != nil {
+		return x.Type
+	}
+	return RebootType_REBOOT_GRACEFUL
+}
+
+type RebootInstanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RebootInstanceResponse) Reset() {
+	*x = RebootInstanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RebootInstanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebootInstanceResponse) ProtoMessage() {}
+
+func (x *RebootInstanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebootInstanceResponse.ProtoReflect.Descriptor instead.
+func (*RebootInstanceResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{31}
+}
+
+// Runtimes that cannot apply the resources to the instance in its current state, e.g. because they exceed
+// what can be hot-plugged into a running instance, fail with FAILED_PRECONDITION.
+type UpdateInstanceResourcesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId  string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CpuCount    int64  `protobuf:"varint,3,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	MemoryBytes uint64 `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+}
+
+func (x *UpdateInstanceResourcesRequest) Reset() {
+	*x = UpdateInstanceResourcesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateInstanceResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceResourcesRequest) ProtoMessage() {}
+
+func (x *UpdateInstanceResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceResourcesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateInstanceResourcesRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *UpdateInstanceResourcesRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateInstanceResourcesRequest) GetCpuCount() int64 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+func (x *UpdateInstanceResourcesRequest) GetMemoryBytes() uint64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+type UpdateInstanceResourcesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateInstanceResourcesResponse) Reset() {
+	*x = UpdateInstanceResourcesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateInstanceResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstanceResourcesResponse) ProtoMessage() {}
+
+func (x *UpdateInstanceResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstanceResourcesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInstanceResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{33}
+}
+
+type AttachDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Disk       *Disk  `protobuf:"bytes,2,opt,name=disk,proto3" json:"disk,omitempty"`
+}
+
+func (x *AttachDiskRequest) Reset() {
+	*x = AttachDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachDiskRequest) ProtoMessage() {}
+
+func (x *AttachDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,7 +2503,7 @@ func (x *AttachDiskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachDiskRequest.ProtoReflect.Descriptor instead.
 func (*AttachDiskRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{26}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AttachDiskRequest) GetInstanceId() string {
@@ -1793,7 +2529,7 @@ type AttachDiskResponse struct {
 func (x *AttachDiskResponse) Reset() {
 	*x = AttachDiskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1806,7 +2542,7 @@ func (x *AttachDiskResponse) String() string {
 func (*AttachDiskResponse) ProtoMessage() {}
 
 func (x *AttachDiskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,7 +2555,7 @@ func (x *AttachDiskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachDiskResponse.ProtoReflect.Descriptor instead.
 func (*AttachDiskResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{27}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{35}
 }
 
 type DetachDiskRequest struct {
@@ -1834,7 +2570,7 @@ type DetachDiskRequest struct {
 func (x *DetachDiskRequest) Reset() {
 	*x = DetachDiskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1847,7 +2583,7 @@ func (x *DetachDiskRequest) String() string {
 func (*DetachDiskRequest) ProtoMessage() {}
 
 func (x *DetachDiskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +2596,7 @@ func (x *DetachDiskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachDiskRequest.ProtoReflect.Descriptor instead.
 func (*DetachDiskRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{28}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DetachDiskRequest) GetInstanceId() string {
@@ -1886,7 +2622,7 @@ type DetachDiskResponse struct {
 func (x *DetachDiskResponse) Reset() {
 	*x = DetachDiskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1899,7 +2635,7 @@ func (x *DetachDiskResponse) String() string {
 func (*DetachDiskResponse) ProtoMessage() {}
 
 func (x *DetachDiskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2648,7 @@ func (x *DetachDiskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachDiskResponse.ProtoReflect.Descriptor instead.
 func (*DetachDiskResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{29}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{37}
 }
 
 type AttachNetworkInterfaceRequest struct {
@@ -1927,7 +2663,7 @@ type AttachNetworkInterfaceRequest struct {
 func (x *AttachNetworkInterfaceRequest) Reset() {
 	*x = AttachNetworkInterfaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1940,7 +2676,7 @@ func (x *AttachNetworkInterfaceRequest) String() string {
 func (*AttachNetworkInterfaceRequest) ProtoMessage() {}
 
 func (x *AttachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,7 +2689,7 @@ func (x *AttachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
 func (*AttachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{30}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AttachNetworkInterfaceRequest) GetInstanceId() string {
@@ -1979,7 +2715,7 @@ type AttachNetworkInterfaceResponse struct {
 func (x *AttachNetworkInterfaceResponse) Reset() {
 	*x = AttachNetworkInterfaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1992,7 +2728,7 @@ func (x *AttachNetworkInterfaceResponse) String() string {
 func (*AttachNetworkInterfaceResponse) ProtoMessage() {}
 
 func (x *AttachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,7 +2741,7 @@ func (x *AttachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
 func (*AttachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{31}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{39}
 }
 
 type DetachNetworkInterfaceRequest struct {
@@ -2020,7 +2756,7 @@ type DetachNetworkInterfaceRequest struct {
 func (x *DetachNetworkInterfaceRequest) Reset() {
 	*x = DetachNetworkInterfaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2033,7 +2769,7 @@ func (x *DetachNetworkInterfaceRequest) String() string {
 func (*DetachNetworkInterfaceRequest) ProtoMessage() {}
 
 func (x *DetachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,7 +2782,7 @@ func (x *DetachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
 func (*DetachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{32}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DetachNetworkInterfaceRequest) GetInstanceId() string {
@@ -2072,7 +2808,7 @@ type DetachNetworkInterfaceResponse struct {
 func (x *DetachNetworkInterfaceResponse) Reset() {
 	*x = DetachNetworkInterfaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2085,7 +2821,7 @@ func (x *DetachNetworkInterfaceResponse) String() string {
 func (*DetachNetworkInterfaceResponse) ProtoMessage() {}
 
 func (x *DetachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,7 +2834,7 @@ func (x *DetachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
 func (*DetachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{33}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{41}
 }
 
 type StatusRequest struct {
@@ -2110,7 +2846,7 @@ type StatusRequest struct {
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2123,7 +2859,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2136,7 +2872,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{34}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{42}
 }
 
 type RuntimeResources struct {
@@ -2152,7 +2888,7 @@ type RuntimeResources struct {
 func (x *RuntimeResources) Reset() {
 	*x = RuntimeResources{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2165,7 +2901,7 @@ func (x *RuntimeResources) String() string {
 func (*RuntimeResources) ProtoMessage() {}
 
 func (x *RuntimeResources) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2914,7 @@ func (x *RuntimeResources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeResources.ProtoReflect.Descriptor instead.
 func (*RuntimeResources) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{35}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RuntimeResources) GetCpuCount() int64 {
@@ -2214,7 +2950,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2227,7 +2963,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36]
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2240,7 +2976,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{36}
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *StatusResponse) GetCapacity() *RuntimeResources {
@@ -2257,31 +2993,37 @@ func (x *StatusResponse) GetAllocatable() *RuntimeResources {
 	return nil
 }
 
-type ExecRequest struct {
+type InstanceStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Time the statistics were collected at, in unix nanoseconds.
+	Timestamp         int64                    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Cpu               *CpuStats                `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory            *MemoryStats             `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Disks             []*DiskStats             `protobuf:"bytes,5,rep,name=disks,proto3" json:"disks,omitempty"`
+	NetworkInterfaces []*NetworkInterfaceStats `protobuf:"bytes,6,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`
 }
 
-func (x *ExecRequest) Reset() {
-	*x = ExecRequest{}
+func (x *InstanceStats) Reset() {
+	*x = InstanceStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecRequest) String() string {
+func (x *InstanceStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRequest) ProtoMessage() {}
+func (*InstanceStats) ProtoMessage() {}
 
-func (x *ExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37]
+func (x *InstanceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,43 +3034,984 @@ func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
-func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use InstanceStats.ProtoReflect.Descriptor instead.
+func (*InstanceStats) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ExecRequest) GetInstanceId() string {
+func (x *InstanceStats) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-type ExecResponse struct {
+func (x *InstanceStats) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InstanceStats) GetCpu() *CpuStats {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetMemory() *MemoryStats {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetDisks() []*DiskStats {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *InstanceStats) GetNetworkInterfaces() []*NetworkInterfaceStats {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return nil
+}
+
+type CpuStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Cumulative cpu time consumed by the instance, in nanoseconds.
+	UsageCoreNanoseconds uint64 `protobuf:"varint,1,opt,name=usage_core_nanoseconds,json=usageCoreNanoseconds,proto3" json:"usage_core_nanoseconds,omitempty"`
 }
 
-func (x *ExecResponse) Reset() {
-	*x = ExecResponse{}
+func (x *CpuStats) Reset() {
+	*x = CpuStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38]
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecResponse) String() string {
+func (x *CpuStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResponse) ProtoMessage() {}
+func (*CpuStats) ProtoMessage() {}
 
-func (x *ExecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38]
+func (x *CpuStats) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuStats.ProtoReflect.Descriptor instead.
+func (*CpuStats) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CpuStats) GetUsageCoreNanoseconds() uint64 {
+	if x != nil {
+		return x.UsageCoreNanoseconds
+	}
+	return 0
+}
+
+type MemoryStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Memory of the host in use by the instance.
+	UsageBytes uint64 `protobuf:"varint,1,opt,name=usage_bytes,json=usageBytes,proto3" json:"usage_bytes,omitempty"`
+	// Memory available to the instance.
+	AvailableBytes uint64 `protobuf:"varint,2,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+}
+
+func (x *MemoryStats) Reset() {
+	*x = MemoryStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStats) ProtoMessage() {}
+
+func (x *MemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStats.ProtoReflect.Descriptor instead.
+func (*MemoryStats) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MemoryStats) GetUsageBytes() uint64 {
+	if x != nil {
+		return x.UsageBytes
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+type DiskStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReadBytes  uint64 `protobuf:"varint,2,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes uint64 `protobuf:"varint,3,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadOps    uint64 `protobuf:"varint,4,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	WriteOps   uint64 `protobuf:"varint,5,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+}
+
+func (x *DiskStats) Reset() {
+	*x = DiskStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskStats) ProtoMessage() {}
+
+func (x *DiskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskStats.ProtoReflect.Descriptor instead.
+func (*DiskStats) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiskStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskStats) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *DiskStats) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *DiskStats) GetReadOps() uint64 {
+	if x != nil {
+		return x.ReadOps
+	}
+	return 0
+}
+
+func (x *DiskStats) GetWriteOps() uint64 {
+	if x != nil {
+		return x.WriteOps
+	}
+	return 0
+}
+
+type NetworkInterfaceStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytes   uint64 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,4,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxPackets uint64 `protobuf:"varint,5,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+}
+
+func (x *NetworkInterfaceStats) Reset() {
+	*x = NetworkInterfaceStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInterfaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterfaceStats) ProtoMessage() {}
+
+func (x *NetworkInterfaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterfaceStats.ProtoReflect.Descriptor instead.
+func (*NetworkInterfaceStats) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NetworkInterfaceStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterfaceStats) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+type InstanceStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *InstanceStatsRequest) Reset() {
+	*x = InstanceStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatsRequest) ProtoMessage() {}
+
+func (x *InstanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*InstanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InstanceStatsRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type InstanceStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *InstanceStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *InstanceStatsResponse) Reset() {
+	*x = InstanceStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatsResponse) ProtoMessage() {}
+
+func (x *InstanceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatsResponse.ProtoReflect.Descriptor instead.
+func (*InstanceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *InstanceStatsResponse) GetStats() *InstanceStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListInstanceStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *InstanceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListInstanceStatsRequest) Reset() {
+	*x = ListInstanceStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstanceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceStatsRequest) ProtoMessage() {}
+
+func (x *ListInstanceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstanceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListInstanceStatsRequest) GetFilter() *InstanceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListInstanceStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Statistics of the matching instances. Instances without statistics, e.g. as they are not running, are omitted.
+	Stats []*InstanceStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *ListInstanceStatsResponse) Reset() {
+	*x = ListInstanceStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstanceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstanceStatsResponse) ProtoMessage() {}
+
+func (x *ListInstanceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstanceStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstanceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListInstanceStatsResponse) GetStats() []*InstanceStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ExecRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest) ProtoMessage() {}
+
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExecRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type ExecResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResponse) ProtoMessage() {}
+
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExecResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type PortForwardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the instance to forward ports of.
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Ports of the instance to forward. Clients may also specify the ports per stream.
+	Port []int32 `protobuf:"varint,2,rep,packed,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *PortForwardRequest) Reset() {
+	*x = PortForwardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardRequest) ProtoMessage() {}
+
+func (x *PortForwardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardRequest.ProtoReflect.Descriptor instead.
+func (*PortForwardRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PortForwardRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PortForwardRequest) GetPort() []int32 {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+type PortForwardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Url to stream the forwarded ports via.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *PortForwardResponse) Reset() {
+	*x = PortForwardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardResponse) ProtoMessage() {}
+
+func (x *PortForwardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardResponse.ProtoReflect.Descriptor instead.
+func (*PortForwardResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PortForwardResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetConsoleLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the instance to read the serial console log of.
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Number of lines from the end of the log to read. If unset, the whole log is read.
+	TailLines *int64 `protobuf:"varint,2,opt,name=tail_lines,json=tailLines,proto3,oneof" json:"tail_lines,omitempty"`
+	// Unix timestamp in nanoseconds from which on output is read. If zero, the whole log is read.
+	SinceTime int64 `protobuf:"varint,3,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	// If set, new output is streamed until the client cancels the request.
+	Follow bool `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *GetConsoleLogRequest) Reset() {
+	*x = GetConsoleLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogRequest) ProtoMessage() {}
+
+func (x *GetConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetConsoleLogRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetConsoleLogRequest) GetTailLines() int64 {
+	if x != nil && x.TailLines != nil {
+		return *x.TailLines
+	}
+	return 0
+}
+
+func (x *GetConsoleLogRequest) GetSinceTime() int64 {
+	if x != nil {
+		return x.SinceTime
+	}
+	return 0
+}
+
+func (x *GetConsoleLogRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type GetConsoleLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chunk of serial console output.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetConsoleLogResponse) Reset() {
+	*x = GetConsoleLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsoleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogResponse) ProtoMessage() {}
+
+func (x *GetConsoleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogResponse.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetConsoleLogResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Image struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique id of the image.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference of the image by digest.
+	Ref string `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	// References the image was pulled by, e.g. by tag.
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+	// Size of the image on the image filesystem.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Whether the image is used by any instance of the runtime. Images in use cannot be removed.
+	InUse bool `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Image) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Image) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Image) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Image) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Image) GetInUse() bool {
+	if x != nil {
+		return x.InUse
+	}
+	return false
+}
+
+type ImageFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Image to filter by, matched against the id, the reference and the names of images.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *ImageFilter) Reset() {
+	*x = ImageFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageFilter) ProtoMessage() {}
+
+func (x *ImageFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageFilter.ProtoReflect.Descriptor instead.
+func (*ImageFilter) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ImageFilter) GetImage() *ImageSpec {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ListImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ImageFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListImagesRequest) Reset() {
+	*x = ListImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImagesRequest) ProtoMessage() {}
+
+func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,18 +4022,350 @@ func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
-func (*ExecResponse) Descriptor() ([]byte, []int) {
-	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListImagesRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ExecResponse) GetUrl() string {
+func (x *ListImagesRequest) GetFilter() *ImageFilter {
 	if x != nil {
-		return x.Url
+		return x.Filter
+	}
+	return nil
+}
+
+type ListImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images []*Image `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+}
+
+func (x *ListImagesResponse) Reset() {
+	*x = ListImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImagesResponse) ProtoMessage() {}
+
+func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListImagesResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListImagesResponse) GetImages() []*Image {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type PullImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *PullImageRequest) Reset() {
+	*x = PullImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullImageRequest) ProtoMessage() {}
+
+func (x *PullImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullImageRequest.ProtoReflect.Descriptor instead.
+func (*PullImageRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PullImageRequest) GetImage() *ImageSpec {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type PullImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reference of the pulled image by digest.
+	ImageRef string `protobuf:"bytes,1,opt,name=image_ref,json=imageRef,proto3" json:"image_ref,omitempty"`
+}
+
+func (x *PullImageResponse) Reset() {
+	*x = PullImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PullImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullImageResponse) ProtoMessage() {}
+
+func (x *PullImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullImageResponse.ProtoReflect.Descriptor instead.
+func (*PullImageResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PullImageResponse) GetImageRef() string {
+	if x != nil {
+		return x.ImageRef
 	}
 	return ""
 }
 
+type RemoveImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Image to remove, by id, reference or name.
+	Image *ImageSpec `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *RemoveImageRequest) Reset() {
+	*x = RemoveImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveImageRequest) ProtoMessage() {}
+
+func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveImageRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RemoveImageRequest) GetImage() *ImageSpec {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type RemoveImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveImageResponse) Reset() {
+	*x = RemoveImageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveImageResponse) ProtoMessage() {}
+
+func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveImageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveImageResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{67}
+}
+
+type ImageFsInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImageFsInfoRequest) Reset() {
+	*x = ImageFsInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageFsInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageFsInfoRequest) ProtoMessage() {}
+
+func (x *ImageFsInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageFsInfoRequest.ProtoReflect.Descriptor instead.
+func (*ImageFsInfoRequest) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{68}
+}
+
+type ImageFsInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes used by images.
+	UsedBytes uint64 `protobuf:"varint,1,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// Total size of the filesystem storing images.
+	CapacityBytes uint64 `protobuf:"varint,2,opt,name=capacity_bytes,json=capacityBytes,proto3" json:"capacity_bytes,omitempty"`
+	// Bytes available on the filesystem storing images.
+	AvailableBytes uint64 `protobuf:"varint,3,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+}
+
+func (x *ImageFsInfoResponse) Reset() {
+	*x = ImageFsInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageFsInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageFsInfoResponse) ProtoMessage() {}
+
+func (x *ImageFsInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageFsInfoResponse.ProtoReflect.Descriptor instead.
+func (*ImageFsInfoResponse) Descriptor() ([]byte, []int) {
+	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ImageFsInfoResponse) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *ImageFsInfoResponse) GetCapacityBytes() uint64 {
+	if x != nil {
+		return x.CapacityBytes
+	}
+	return 0
+}
+
+func (x *ImageFsInfoResponse) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
 var File_iri_api_apis_runtime_v1alpha1_api_proto protoreflect.FileDescriptor
 
 var file_iri_api_apis_runtime_v1alpha1_api_proto_rawDesc = []byte{
@@ -2404,301 +4419,556 @@ var file_iri_api_apis_runtime_v1alpha1_api_proto_rawDesc = []byte{
 	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5a, 0x0a, 0x0e, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5a, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x6a, 0x0a, 0x1b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x19, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x21, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x44, 0x69, 0x73,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xe3, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb6, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x21, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a,
-	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x44, 0x69, 0x73, 0x6b,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
-	0xe3, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61,
-	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64,
-	0x6c, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73,
-	0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x09, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69,
-	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1e, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x55, 0x69, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x69, 0x64, 0x22,
-	0xb6, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x03, 0x69, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f,
-	0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x43, 0x69, 0x64, 0x72, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x05, 0x64, 0x69, 0x73,
-	0x6b, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x09, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x1e, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x55, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x55, 0x69, 0x64,
+	0x22, 0xb6, 0x01, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x5f, 0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x43, 0x69, 0x64, 0x72, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x05, 0x64, 0x69,
+	0x73, 0x6b, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x32,
+	0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x64, 0x69, 0x73,
+	0x6b, 0x73, 0x12, 0x57, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
 	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
 	0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x66, 0x12, 0x32, 0x0a,
-	0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0a, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72,
 	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b,
-	0x73, 0x12, 0x57, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x83, 0x01, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x82, 0x03,
+	0x0a, 0x13, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x6f,
+	0x74, 0x70, 0x6c, 0x75, 0x67, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x68, 0x6f,
+	0x74, 0x70, 0x6c, 0x75, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18,
+	0x68, 0x6f, 0x74, 0x70, 0x6c, 0x75, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x62, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x79, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0a, 0x44, 0x69,
-	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44,
-	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x83, 0x01, 0x0a, 0x16, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x5d, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x50, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36,
-	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xea, 0x01, 0x0a,
-	0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x65, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c,
-	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x15,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x50, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x65, 0x0a, 0x0b, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x43, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x05,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x15, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x72,
 	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f,
-	0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x11, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x1e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x64,
+	0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a,
+	0x11, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x01,
+	0x0a, 0x1d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x4f, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52,
+	0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x44, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x02, 0x0a,
+	0x10, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x6b, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x45,
+	0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x08,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xbe,
+	0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
 	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x22, 0x14, 0x0a,
-	0x12, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2c, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x70, 0x75, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x35, 0x0a,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x56, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x11, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0x40, 0x0a, 0x08, 0x43, 0x70, 0x75, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x6f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x70, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x4e, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22,
+	0x54, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x0b, 0x45, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a,
-	0x12, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x1d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4f, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x1d, 0x44, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x20, 0x0a, 0x1e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x96, 0x01, 0x0a, 0x0e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x73, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x44,
-	0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x2a, 0x24, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12,
-	0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x2a, 0x30, 0x0a, 0x09,
-	0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53,
-	0x4b, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44,
-	0x49, 0x53, 0x4b, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x56,
-	0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x45, 0x54, 0x57, 0x4f,
-	0x52, 0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x4e,
-	0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
-	0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x41,
-	0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41,
-	0x4e, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a,
-	0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f,
-	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49,
-	0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54,
-	0x45, 0x44, 0x10, 0x03, 0x32, 0xd3, 0x09, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x49, 0x0a, 0x12, 0x50,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
+	0xa1, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x69,
+	0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x61, 0x69, 0x6c, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x75, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x0b, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x10,
+	0x50, 0x75, 0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x22, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x15,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x13,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x2a, 0xab, 0x01, 0x0a, 0x15, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e,
+	0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x10, 0x01,
+	0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x4f, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x53, 0x45,
+	0x4c, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x03,
+	0x2a, 0x39, 0x0a, 0x05, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x57,
+	0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4f, 0x57, 0x45, 0x52,
+	0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x53, 0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x30, 0x0a, 0x09, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x4b,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49,
+	0x53, 0x4b, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x56, 0x0a,
+	0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43,
+	0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
+	0x43, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53,
+	0x55, 0x53, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e,
+	0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x2a, 0x72, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x32, 0x0a, 0x0a, 0x52, 0x65, 0x62, 0x6f, 0x6f,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x42, 0x4f, 0x4f, 0x54, 0x5f,
+	0x47, 0x52, 0x41, 0x43, 0x45, 0x46, 0x55, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45,
+	0x42, 0x4f, 0x4f, 0x54, 0x5f, 0x48, 0x41, 0x52, 0x44, 0x10, 0x01, 0x32, 0xb1, 0x0f, 0x0a, 0x0e,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x65, 0x0a,
 	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
 	0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
@@ -2727,47 +4997,110 @@ var file_iri_api_apis_runtime_v1alpha1_api_proto_rawDesc = []byte{
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
 	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x6f,
+	0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x17, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0a, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
 	0x44, 0x69, 0x73, 0x6b, 0x12, 0x23, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69,
 	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74,
 	0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x59, 0x0a, 0x0a, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x12,
-	0x23, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x69,
-	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x16,
+	0x00, 0x12, 0x7b, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x16, 0x44, 0x65, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b,
+	0x0a, 0x16, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74,
 	0x61, 0x63, 0x68, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1d, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x78,
-	0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x73, 0x70,
-	0x68, 0x65, 0x72, 0x69, 0x63, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x73, 0x70, 0x68, 0x65,
-	0x72, 0x69, 0x63, 0x2f, 0x69, 0x72, 0x69, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73,
-	0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2a, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63,
+	0x12, 0x1d, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5a, 0x0a, 0x0b, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x24,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x26, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x32,
+	0xf5, 0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x09, 0x50, 0x75, 0x6c,
+	0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75,
+	0x6c, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5a, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x24,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0b, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x46, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x46, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x73, 0x70, 0x68, 0x65, 0x72,
+	0x69, 0x63, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x73, 0x70, 0x68, 0x65, 0x72, 0x69, 0x63,
+	0x2f, 0x69, 0x72, 0x69, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2782,125 +5115,201 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescGZIP() []byte {
 	return file_iri_api_apis_runtime_v1alpha1_api_proto_rawDescData
 }
 
-var file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_iri_api_apis_runtime_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_iri_api_apis_runtime_v1alpha1_api_proto_goTypes = []any{
-	(Power)(0),                                // 0: runtime.v1alpha1.Power
-	(DiskState)(0),                            // 1: runtime.v1alpha1.DiskState
-	(NetworkInterfaceState)(0),                // 2: runtime.v1alpha1.NetworkInterfaceState
-	(InstanceState)(0),                        // 3: runtime.v1alpha1.InstanceState
-	(*ObjectMetadata)(nil),                    // 4: runtime.v1alpha1.ObjectMetadata
-	(*DiskSpec)(nil),                          // 5: runtime.v1alpha1.DiskSpec
-	(*InstanceFilter)(nil),                    // 6: runtime.v1alpha1.InstanceFilter
-	(*Instance)(nil),                          // 7: runtime.v1alpha1.Instance
-	(*ImageSpec)(nil),                         // 8: runtime.v1alpha1.ImageSpec
-	(*EmptyDisk)(nil),                         // 9: runtime.v1alpha1.EmptyDisk
-	(*DiskConnection)(nil),                    // 10: runtime.v1alpha1.DiskConnection
-	(*Disk)(nil),                              // 11: runtime.v1alpha1.Disk
-	(*NetworkInterfaceSubnetMetadata)(nil),    // 12: runtime.v1alpha1.NetworkInterfaceSubnetMetadata
-	(*NetworkInterface)(nil),                  // 13: runtime.v1alpha1.NetworkInterface
-	(*InstanceSpec)(nil),                      // 14: runtime.v1alpha1.InstanceSpec
-	(*InstanceStatus)(nil),                    // 15: runtime.v1alpha1.InstanceStatus
-	(*DiskStatus)(nil),                        // 16: runtime.v1alpha1.DiskStatus
-	(*NetworkInterfaceStatus)(nil),            // 17: runtime.v1alpha1.NetworkInterfaceStatus
-	(*VersionRequest)(nil),                    // 18: runtime.v1alpha1.VersionRequest
-	(*VersionResponse)(nil),                   // 19: runtime.v1alpha1.VersionResponse
-	(*ListInstancesRequest)(nil),              // 20: runtime.v1alpha1.ListInstancesRequest
-	(*ListInstancesResponse)(nil),             // 21: runtime.v1alpha1.ListInstancesResponse
-	(*CreateInstanceRequest)(nil),             // 22: runtime.v1alpha1.CreateInstanceRequest
-	(*CreateInstanceResponse)(nil),            // 23: runtime.v1alpha1.CreateInstanceResponse
-	(*DeleteInstanceRequest)(nil),             // 24: runtime.v1alpha1.DeleteInstanceRequest
-	(*DeleteInstanceResponse)(nil),            // 25: runtime.v1alpha1.DeleteInstanceResponse
-	(*UpdateInstanceAnnotationsRequest)(nil),  // 26: runtime.v1alpha1.UpdateInstanceAnnotationsRequest
-	(*UpdateInstanceAnnotationsResponse)(nil), // 27: runtime.v1alpha1.UpdateInstanceAnnotationsResponse
-	(*UpdateInstancePowerRequest)(nil),        // 28: runtime.v1alpha1.UpdateInstancePowerRequest
-	(*UpdateInstancePowerResponse)(nil),       // 29: runtime.v1alpha1.UpdateInstancePowerResponse
-	(*AttachDiskRequest)(nil),                 // 30: runtime.v1alpha1.AttachDiskRequest
-	(*AttachDiskResponse)(nil),                // 31: runtime.v1alpha1.AttachDiskResponse
-	(*DetachDiskRequest)(nil),                 // 32: runtime.v1alpha1.DetachDiskRequest
-	(*DetachDiskResponse)(nil),                // 33: runtime.v1alpha1.DetachDiskResponse
-	(*AttachNetworkInterfaceRequest)(nil),     // 34: runtime.v1alpha1.AttachNetworkInterfaceRequest
-	(*AttachNetworkInterfaceResponse)(nil),    // 35: runtime.v1alpha1.AttachNetworkInterfaceResponse
-	(*DetachNetworkInterfaceRequest)(nil),     // 36: runtime.v1alpha1.DetachNetworkInterfaceRequest
-	(*DetachNetworkInterfaceResponse)(nil),    // 37: runtime.v1alpha1.DetachNetworkInterfaceResponse
-	(*StatusRequest)(nil),                     // 38: runtime.v1alpha1.StatusRequest
-	(*RuntimeResources)(nil),                  // 39: runtime.v1alpha1.RuntimeResources
-	(*StatusResponse)(nil),                    // 40: runtime.v1alpha1.StatusResponse
-	(*ExecRequest)(nil),                       // 41: runtime.v1alpha1.ExecRequest
-	(*ExecResponse)(nil),                      // 42: runtime.v1alpha1.ExecResponse
-	nil,                                       // 43: runtime.v1alpha1.ObjectMetadata.AnnotationsEntry
-	nil,                                       // 44: runtime.v1alpha1.ObjectMetadata.LabelsEntry
-	nil,                                       // 45: runtime.v1alpha1.DiskSpec.AttributesEntry
-	nil,                                       // 46: runtime.v1alpha1.DiskSpec.SecretDataEntry
-	nil,                                       // 47: runtime.v1alpha1.InstanceFilter.LabelSelectorEntry
-	nil,                                       // 48: runtime.v1alpha1.DiskConnection.AttributesEntry
-	nil,                                       // 49: runtime.v1alpha1.DiskConnection.SecretDataEntry
-	nil,                                       // 50: runtime.v1alpha1.UpdateInstanceAnnotationsRequest.AnnotationsEntry
-	nil,                                       // 51: runtime.v1alpha1.RuntimeResources.InstanceQuantitiesEntry
+	(LabelSelectorOperator)(0),                // 0: runtime.v1alpha1.LabelSelectorOperator
+	(Power)(0),                                // 1: runtime.v1alpha1.Power
+	(DiskState)(0),                            // 2: runtime.v1alpha1.DiskState
+	(NetworkInterfaceState)(0),                // 3: runtime.v1alpha1.NetworkInterfaceState
+	(InstanceState)(0),                        // 4: runtime.v1alpha1.InstanceState
+	(WatchEventType)(0),                       // 5: runtime.v1alpha1.WatchEventType
+	(RebootType)(0),                           // 6: runtime.v1alpha1.RebootType
+	(*ObjectMetadata)(nil),                    // 7: runtime.v1alpha1.ObjectMetadata
+	(*DiskSpec)(nil),                          // 8: runtime.v1alpha1.DiskSpec
+	(*LabelSelectorRequirement)(nil),          // 9: runtime.v1alpha1.LabelSelectorRequirement
+	(*InstanceFilter)(nil),                    // 10: runtime.v1alpha1.InstanceFilter
+	(*Instance)(nil),                          // 11: runtime.v1alpha1.Instance
+	(*ImageSpec)(nil),                         // 12: runtime.v1alpha1.ImageSpec
+	(*EmptyDisk)(nil),                         // 13: runtime.v1alpha1.EmptyDisk
+	(*DiskConnection)(nil),                    // 14: runtime.v1alpha1.DiskConnection
+	(*Disk)(nil),                              // 15: runtime.v1alpha1.Disk
+	(*NetworkInterfaceSubnetMetadata)(nil),    // 16: runtime.v1alpha1.NetworkInterfaceSubnetMetadata
+	(*NetworkInterface)(nil),                  // 17: runtime.v1alpha1.NetworkInterface
+	(*InstanceSpec)(nil),                      // 18: runtime.v1alpha1.InstanceSpec
+	(*InstanceStatus)(nil),                    // 19: runtime.v1alpha1.InstanceStatus
+	(*DiskStatus)(nil),                        // 20: runtime.v1alpha1.DiskStatus
+	(*NetworkInterfaceStatus)(nil),            // 21: runtime.v1alpha1.NetworkInterfaceStatus
+	(*VersionRequest)(nil),                    // 22: runtime.v1alpha1.VersionRequest
+	(*VersionResponse)(nil),                   // 23: runtime.v1alpha1.VersionResponse
+	(*RuntimeCapabilities)(nil),               // 24: runtime.v1alpha1.RuntimeCapabilities
+	(*ListInstancesRequest)(nil),              // 25: runtime.v1alpha1.ListInstancesRequest
+	(*ListInstancesResponse)(nil),             // 26: runtime.v1alpha1.ListInstancesResponse
+	(*WatchInstancesRequest)(nil),             // 27: runtime.v1alpha1.WatchInstancesRequest
+	(*WatchInstancesResponse)(nil),            // 28: runtime.v1alpha1.WatchInstancesResponse
+	(*CreateInstanceRequest)(nil),             // 29: runtime.v1alpha1.CreateInstanceRequest
+	(*CreateInstanceResponse)(nil),            // 30: runtime.v1alpha1.CreateInstanceResponse
+	(*DeleteInstanceRequest)(nil),             // 31: runtime.v1alpha1.DeleteInstanceRequest
+	(*DeleteInstanceResponse)(nil),            // 32: runtime.v1alpha1.DeleteInstanceResponse
+	(*UpdateInstanceAnnotationsRequest)(nil),  // 33: runtime.v1alpha1.UpdateInstanceAnnotationsRequest
+	(*UpdateInstanceAnnotationsResponse)(nil), // 34: runtime.v1alpha1.UpdateInstanceAnnotationsResponse
+	(*UpdateInstancePowerRequest)(nil),        // 35: runtime.v1alpha1.UpdateInstancePowerRequest
+	(*UpdateInstancePowerResponse)(nil),       // 36: runtime.v1alpha1.UpdateInstancePowerResponse
+	(*RebootInstanceRequest)(nil),             // 37: runtime.v1alpha1.RebootInstanceRequest
+	(*RebootInstanceResponse)(nil),            // 38: runtime.v1alpha1.RebootInstanceResponse
+	(*UpdateInstanceResourcesRequest)(nil),    // 39: runtime.v1alpha1.UpdateInstanceResourcesRequest
+	(*UpdateInstanceResourcesResponse)(nil),   // 40: runtime.v1alpha1.UpdateInstanceResourcesResponse
+	(*AttachDiskRequest)(nil),                 // 41: runtime.v1alpha1.AttachDiskRequest
+	(*AttachDiskResponse)(nil),                // 42: runtime.v1alpha1.AttachDiskResponse
+	(*DetachDiskRequest)(nil),                 // 43: runtime.v1alpha1.DetachDiskRequest
+	(*DetachDiskResponse)(nil),                // 44: runtime.v1alpha1.DetachDiskResponse
+	(*AttachNetworkInterfaceRequest)(nil),     // 45: runtime.v1alpha1.AttachNetworkInterfaceRequest
+	(*AttachNetworkInterfaceResponse)(nil),    // 46: runtime.v1alpha1.AttachNetworkInterfaceResponse
+	(*DetachNetworkInterfaceRequest)(nil),     // 47: runtime.v1alpha1.DetachNetworkInterfaceRequest
+	(*DetachNetworkInterfaceResponse)(nil),    // 48: runtime.v1alpha1.DetachNetworkInterfaceResponse
+	(*StatusRequest)(nil),                     // 49: runtime.v1alpha1.StatusRequest
+	(*RuntimeResources)(nil),                  // 50: runtime.v1alpha1.RuntimeResources
+	(*StatusResponse)(nil),                    // 51: runtime.v1alpha1.StatusResponse
+	(*InstanceStats)(nil),                     // 52: runtime.v1alpha1.InstanceStats
+	(*CpuStats)(nil),                          // 53: runtime.v1alpha1.CpuStats
+	(*MemoryStats)(nil),                       // 54: runtime.v1alpha1.MemoryStats
+	(*DiskStats)(nil),                         // 55: runtime.v1alpha1.DiskStats
+	(*NetworkInterfaceStats)(nil),             // 56: runtime.v1alpha1.NetworkInterfaceStats
+	(*InstanceStatsRequest)(nil),              // 57: runtime.v1alpha1.InstanceStatsRequest
+	(*InstanceStatsResponse)(nil),             // 58: runtime.v1alpha1.InstanceStatsResponse
+	(*ListInstanceStatsRequest)(nil),          // 59: runtime.v1alpha1.ListInstanceStatsRequest
+	(*ListInstanceStatsResponse)(nil),         // 60: runtime.v1alpha1.ListInstanceStatsResponse
+	(*ExecRequest)(nil),                       // 61: runtime.v1alpha1.ExecRequest
+	(*ExecResponse)(nil),                      // 62: runtime.v1alpha1.ExecResponse
+	(*PortForwardRequest)(nil),                // 63: runtime.v1alpha1.PortForwardRequest
+	(*PortForwardResponse)(nil),               // 64: runtime.v1alpha1.PortForwardResponse
+	(*GetConsoleLogRequest)(nil),              // 65: runtime.v1alpha1.GetConsoleLogRequest
+	(*GetConsoleLogResponse)(nil),             // 66: runtime.v1alpha1.GetConsoleLogResponse
+	(*Image)(nil),                             // 67: runtime.v1alpha1.Image
+	(*ImageFilter)(nil),                       // 68: runtime.v1alpha1.ImageFilter
+	(*ListImagesRequest)(nil),                 // 69: runtime.v1alpha1.ListImagesRequest
+	(*ListImagesResponse)(nil),                // 70: runtime.v1alpha1.ListImagesResponse
+	(*PullImageRequest)(nil),                  // 71: runtime.v1alpha1.PullImageRequest
+	(*PullImageResponse)(nil),                 // 72: runtime.v1alpha1.PullImageResponse
+	(*RemoveImageRequest)(nil),                // 73: runtime.v1alpha1.RemoveImageRequest
+	(*RemoveImageResponse)(nil),               // 74: runtime.v1alpha1.RemoveImageResponse
+	(*ImageFsInfoRequest)(nil),                // 75: runtime.v1alpha1.ImageFsInfoRequest
+	(*ImageFsInfoResponse)(nil),               // 76: runtime.v1alpha1.ImageFsInfoResponse
+	nil,                                       // 77: runtime.v1alpha1.ObjectMetadata.AnnotationsEntry
+	nil,                                       // 78: runtime.v1alpha1.ObjectMetadata.LabelsEntry
+	nil,                                       // 79: runtime.v1alpha1.DiskSpec.AttributesEntry
+	nil,                                       // 80: runtime.v1alpha1.DiskSpec.SecretDataEntry
+	nil,                                       // 81: runtime.v1alpha1.InstanceFilter.LabelSelectorEntry
+	nil,                                       // 82: runtime.v1alpha1.DiskConnection.AttributesEntry
+	nil,                                       // 83: runtime.v1alpha1.DiskConnection.SecretDataEntry
+	nil,                                       // 84: runtime.v1alpha1.UpdateInstanceAnnotationsRequest.AnnotationsEntry
+	nil,                                       // 85: runtime.v1alpha1.RuntimeResources.InstanceQuantitiesEntry
 }
 var file_iri_api_apis_runtime_v1alpha1_api_proto_depIdxs = []int32{
-	43, // 0: runtime.v1alpha1.ObjectMetadata.annotations:type_name -> runtime.v1alpha1.ObjectMetadata.AnnotationsEntry
-	44, // 1: runtime.v1alpha1.ObjectMetadata.labels:type_name -> runtime.v1alpha1.ObjectMetadata.LabelsEntry
-	45, // 2: runtime.v1alpha1.DiskSpec.attributes:type_name -> runtime.v1alpha1.DiskSpec.AttributesEntry
-	46, // 3: runtime.v1alpha1.DiskSpec.secret_data:type_name -> runtime.v1alpha1.DiskSpec.SecretDataEntry
-	47, // 4: runtime.v1alpha1.InstanceFilter.label_selector:type_name -> runtime.v1alpha1.InstanceFilter.LabelSelectorEntry
-	4,  // 5: runtime.v1alpha1.Instance.metadata:type_name -> runtime.v1alpha1.ObjectMetadata
-	14, // 6: runtime.v1alpha1.Instance.spec:type_name -> runtime.v1alpha1.InstanceSpec
-	15, // 7: runtime.v1alpha1.Instance.status:type_name -> runtime.v1alpha1.InstanceStatus
-	48, // 8: runtime.v1alpha1.DiskConnection.attributes:type_name -> runtime.v1alpha1.DiskConnection.AttributesEntry
-	49, // 9: runtime.v1alpha1.DiskConnection.secret_data:type_name -> runtime.v1alpha1.DiskConnection.SecretDataEntry
-	9,  // 10: runtime.v1alpha1.Disk.empty_disk:type_name -> runtime.v1alpha1.EmptyDisk
-	10, // 11: runtime.v1alpha1.Disk.connection:type_name -> runtime.v1alpha1.DiskConnection
-	12, // 12: runtime.v1alpha1.NetworkInterface.subnet_metadata:type_name -> runtime.v1alpha1.NetworkInterfaceSubnetMetadata
-	0,  // 13: runtime.v1alpha1.InstanceSpec.power:type_name -> runtime.v1alpha1.Power
-	8,  // 14: runtime.v1alpha1.InstanceSpec.image:type_name -> runtime.v1alpha1.ImageSpec
-	11, // 15: runtime.v1alpha1.InstanceSpec.disks:type_name -> runtime.v1alpha1.Disk
-	13, // 16: runtime.v1alpha1.InstanceSpec.network_interfaces:type_name -> runtime.v1alpha1.NetworkInterface
-	3,  // 17: runtime.v1alpha1.InstanceStatus.state:type_name -> runtime.v1alpha1.InstanceState
-	16, // 18: runtime.v1alpha1.InstanceStatus.disks:type_name -> runtime.v1alpha1.DiskStatus
-	17, // 19: runtime.v1alpha1.InstanceStatus.network_interfaces:type_name -> runtime.v1alpha1.NetworkInterfaceStatus
-	1,  // 20: runtime.v1alpha1.DiskStatus.state:type_name -> runtime.v1alpha1.DiskState
-	2,  // 21: runtime.v1alpha1.NetworkInterfaceStatus.state:type_name -> runtime.v1alpha1.NetworkInterfaceState
-	6,  // 22: runtime.v1alpha1.ListInstancesRequest.filter:type_name -> runtime.v1alpha1.InstanceFilter
-	7,  // 23: runtime.v1alpha1.ListInstancesResponse.instances:type_name -> runtime.v1alpha1.Instance
-	7,  // 24: runtime.v1alpha1.CreateInstanceRequest.instance:type_name -> runtime.v1alpha1.Instance
-	7,  // 25: runtime.v1alpha1.CreateInstanceResponse.instance:type_name -> runtime.v1alpha1.Instance
-	50, // 26: runtime.v1alpha1.UpdateInstanceAnnotationsRequest.annotations:type_name -> runtime.v1alpha1.UpdateInstanceAnnotationsRequest.AnnotationsEntry
-	0,  // 27: runtime.v1alpha1.UpdateInstancePowerRequest.power:type_name -> runtime.v1alpha1.Power
-	11, // 28: runtime.v1alpha1.AttachDiskRequest.disk:type_name -> runtime.v1alpha1.Disk
-	13, // 29: runtime.v1alpha1.AttachNetworkInterfaceRequest.network_interface:type_name -> runtime.v1alpha1.NetworkInterface
-	51, // 30: runtime.v1alpha1.RuntimeResources.instance_quantities:type_name -> runtime.v1alpha1.RuntimeResources.InstanceQuantitiesEntry
-	39, // 31: runtime.v1alpha1.StatusResponse.capacity:type_name -> runtime.v1alpha1.RuntimeResources
-	39, // 32: runtime.v1alpha1.StatusResponse.allocatable:type_name -> runtime.v1alpha1.RuntimeResources
-	18, // 33: runtime.v1alpha1.RuntimeService.Version:input_type -> runtime.v1alpha1.VersionRequest
-	20, // 34: runtime.v1alpha1.RuntimeService.ListInstances:input_type -> runtime.v1alpha1.ListInstancesRequest
-	22, // 35: runtime.v1alpha1.RuntimeService.CreateInstance:input_type -> runtime.v1alpha1.CreateInstanceRequest
-	24, // 36: runtime.v1alpha1.RuntimeService.DeleteInstance:input_type -> runtime.v1alpha1.DeleteInstanceRequest
-	26, // 37: runtime.v1alpha1.RuntimeService.UpdateInstanceAnnotations:input_type -> runtime.v1alpha1.UpdateInstanceAnnotationsRequest
-	28, // 38: runtime.v1alpha1.RuntimeService.UpdateInstancePower:input_type -> runtime.v1alpha1.UpdateInstancePowerRequest
-	30, // 39: runtime.v1alpha1.RuntimeService.AttachDisk:input_type -> runtime.v1alpha1.AttachDiskRequest
-	32, // 40: runtime.v1alpha1.RuntimeService.DetachDisk:input_type -> runtime.v1alpha1.DetachDiskRequest
-	34, // 41: runtime.v1alpha1.RuntimeService.AttachNetworkInterface:input_type -> runtime.v1alpha1.AttachNetworkInterfaceRequest
-	36, // 42: runtime.v1alpha1.RuntimeService.DetachNetworkInterface:input_type -> runtime.v1alpha1.DetachNetworkInterfaceRequest
-	38, // 43: runtime.v1alpha1.RuntimeService.Status:input_type -> runtime.v1alpha1.StatusRequest
-	41, // 44: runtime.v1alpha1.RuntimeService.Exec:input_type -> runtime.v1alpha1.ExecRequest
-	19, // 45: runtime.v1alpha1.RuntimeService.Version:output_type -> runtime.v1alpha1.VersionResponse
-	21, // 46: runtime.v1alpha1.RuntimeService.ListInstances:output_type -> runtime.v1alpha1.ListInstancesResponse
-	23, // 47: runtime.v1alpha1.RuntimeService.CreateInstance:output_type -> runtime.v1alpha1.CreateInstanceResponse
-	25, // 48: runtime.v1alpha1.RuntimeService.DeleteInstance:output_type -> runtime.v1alpha1.DeleteInstanceResponse
-	27, // 49: runtime.v1alpha1.RuntimeService.UpdateInstanceAnnotations:output_type -> runtime.v1alpha1.UpdateInstanceAnnotationsResponse
-	29, // 50: runtime.v1alpha1.RuntimeService.UpdateInstancePower:output_type -> runtime.v1alpha1.UpdateInstancePowerResponse
-	31, // 51: runtime.v1alpha1.RuntimeService.AttachDisk:output_type -> runtime.v1alpha1.AttachDiskResponse
-	33, // 52: runtime.v1alpha1.RuntimeService.DetachDisk:output_type -> runtime.v1alpha1.DetachDiskResponse
-	35, // 53: runtime.v1alpha1.RuntimeService.AttachNetworkInterface:output_type -> runtime.v1alpha1.AttachNetworkInterfaceResponse
-	37, // 54: runtime.v1alpha1.RuntimeService.DetachNetworkInterface:output_type -> runtime.v1alpha1.DetachNetworkInterfaceResponse
-	40, // 55: runtime.v1alpha1.RuntimeService.Status:output_type -> runtime.v1alpha1.StatusResponse
-	42, // 56: runtime.v1alpha1.RuntimeService.Exec:output_type -> runtime.v1alpha1.ExecResponse
-	45, // [45:57] is the sub-list for method output_type
-	33, // [33:45] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	77, // 0: runtime.v1alpha1.ObjectMetadata.annotations:type_name -> runtime.v1alpha1.ObjectMetadata.AnnotationsEntry
+	78, // 1: runtime.v1alpha1.ObjectMetadata.labels:type_name -> runtime.v1alpha1.ObjectMetadata.LabelsEntry
+	79, // 2: runtime.v1alpha1.DiskSpec.attributes:type_name -> runtime.v1alpha1.DiskSpec.AttributesEntry
+	80, // 3: runtime.v1alpha1.DiskSpec.secret_data:type_name -> runtime.v1alpha1.DiskSpec.SecretDataEntry
+	0,  // 4: runtime.v1alpha1.LabelSelectorRequirement.operator:type_name -> runtime.v1alpha1.LabelSelectorOperator
+	81, // 5: runtime.v1alpha1.InstanceFilter.label_selector:type_name -> runtime.v1alpha1.InstanceFilter.LabelSelectorEntry
+	9,  // 6: runtime.v1alpha1.InstanceFilter.label_selector_requirements:type_name -> runtime.v1alpha1.LabelSelectorRequirement
+	4,  // 7: runtime.v1alpha1.InstanceFilter.states:type_name -> runtime.v1alpha1.InstanceState
+	7,  // 8: runtime.v1alpha1.Instance.metadata:type_name -> runtime.v1alpha1.ObjectMetadata
+	18, // 9: runtime.v1alpha1.Instance.spec:type_name -> runtime.v1alpha1.InstanceSpec
+	19, // 10: runtime.v1alpha1.Instance.status:type_name -> runtime.v1alpha1.InstanceStatus
+	82, // 11: runtime.v1alpha1.DiskConnection.attributes:type_name -> runtime.v1alpha1.DiskConnection.AttributesEntry
+	83, // 12: runtime.v1alpha1.DiskConnection.secret_data:type_name -> runtime.v1alpha1.DiskConnection.SecretDataEntry
+	13, // 13: runtime.v1alpha1.Disk.empty_disk:type_name -> runtime.v1alpha1.EmptyDisk
+	14, // 14: runtime.v1alpha1.Disk.connection:type_name -> runtime.v1alpha1.DiskConnection
+	16, // 15: runtime.v1alpha1.NetworkInterface.subnet_metadata:type_name -> runtime.v1alpha1.NetworkInterfaceSubnetMetadata
+	1,  // 16: runtime.v1alpha1.InstanceSpec.power:type_name -> runtime.v1alpha1.Power
+	12, // 17: runtime.v1alpha1.InstanceSpec.image:type_name -> runtime.v1alpha1.ImageSpec
+	15, // 18: runtime.v1alpha1.InstanceSpec.disks:type_name -> runtime.v1alpha1.Disk
+	17, // 19: runtime.v1alpha1.InstanceSpec.network_interfaces:type_name -> runtime.v1alpha1.NetworkInterface
+	4,  // 20: runtime.v1alpha1.InstanceStatus.state:type_name -> runtime.v1alpha1.InstanceState
+	20, // 21: runtime.v1alpha1.InstanceStatus.disks:type_name -> runtime.v1alpha1.DiskStatus
+	21, // 22: runtime.v1alpha1.InstanceStatus.network_interfaces:type_name -> runtime.v1alpha1.NetworkInterfaceStatus
+	2,  // 23: runtime.v1alpha1.DiskStatus.state:type_name -> runtime.v1alpha1.DiskState
+	3,  // 24: runtime.v1alpha1.NetworkInterfaceStatus.state:type_name -> runtime.v1alpha1.NetworkInterfaceState
+	24, // 25: runtime.v1alpha1.VersionResponse.capabilities:type_name -> runtime.v1alpha1.RuntimeCapabilities
+	10, // 26: runtime.v1alpha1.ListInstancesRequest.filter:type_name -> runtime.v1alpha1.InstanceFilter
+	11, // 27: runtime.v1alpha1.ListInstancesResponse.instances:type_name -> runtime.v1alpha1.Instance
+	10, // 28: runtime.v1alpha1.WatchInstancesRequest.filter:type_name -> runtime.v1alpha1.InstanceFilter
+	5,  // 29: runtime.v1alpha1.WatchInstancesResponse.type:type_name -> runtime.v1alpha1.WatchEventType
+	11, // 30: runtime.v1alpha1.WatchInstancesResponse.instance:type_name -> runtime.v1alpha1.Instance
+	11, // 31: runtime.v1alpha1.CreateInstanceRequest.instance:type_name -> runtime.v1alpha1.Instance
+	11, // 32: runtime.v1alpha1.CreateInstanceResponse.instance:type_name -> runtime.v1alpha1.Instance
+	84, // 33: runtime.v1alpha1.UpdateInstanceAnnotationsRequest.annotations:type_name -> runtime.v1alpha1.UpdateInstanceAnnotationsRequest.AnnotationsEntry
+	1,  // 34: runtime.v1alpha1.UpdateInstancePowerRequest.power:type_name -> runtime.v1alpha1.Power
+	6,  // 35: runtime.v1alpha1.RebootInstanceRequest.type:type_name -> runtime.v1alpha1.RebootType
+	15, // 36: runtime.v1alpha1.AttachDiskRequest.disk:type_name -> runtime.v1alpha1.Disk
+	17, // 37: runtime.v1alpha1.AttachNetworkInterfaceRequest.network_interface:type_name -> runtime.v1alpha1.NetworkInterface
+	85, // 38: runtime.v1alpha1.RuntimeResources.instance_quantities:type_name -> runtime.v1alpha1.RuntimeResources.InstanceQuantitiesEntry
+	50, // 39: runtime.v1alpha1.StatusResponse.capacity:type_name -> runtime.v1alpha1.RuntimeResources
+	50, // 40: runtime.v1alpha1.StatusResponse.allocatable:type_name -> runtime.v1alpha1.RuntimeResources
+	53, // 41: runtime.v1alpha1.InstanceStats.cpu:type_name -> runtime.v1alpha1.CpuStats
+	54, // 42: runtime.v1alpha1.InstanceStats.memory:type_name -> runtime.v1alpha1.MemoryStats
+	55, // 43: runtime.v1alpha1.InstanceStats.disks:type_name -> runtime.v1alpha1.DiskStats
+	56, // 44: runtime.v1alpha1.InstanceStats.network_interfaces:type_name -> runtime.v1alpha1.NetworkInterfaceStats
+	52, // 45: runtime.v1alpha1.InstanceStatsResponse.stats:type_name -> runtime.v1alpha1.InstanceStats
+	10, // 46: runtime.v1alpha1.ListInstanceStatsRequest.filter:type_name -> runtime.v1alpha1.InstanceFilter
+	52, // 47: runtime.v1alpha1.ListInstanceStatsResponse.stats:type_name -> runtime.v1alpha1.InstanceStats
+	12, // 48: runtime.v1alpha1.ImageFilter.image:type_name -> runtime.v1alpha1.ImageSpec
+	68, // 49: runtime.v1alpha1.ListImagesRequest.filter:type_name -> runtime.v1alpha1.ImageFilter
+	67, // 50: runtime.v1alpha1.ListImagesResponse.images:type_name -> runtime.v1alpha1.Image
+	12, // 51: runtime.v1alpha1.PullImageRequest.image:type_name -> runtime.v1alpha1.ImageSpec
+	12, // 52: runtime.v1alpha1.RemoveImageRequest.image:type_name -> runtime.v1alpha1.ImageSpec
+	22, // 53: runtime.v1alpha1.RuntimeService.Version:input_type -> runtime.v1alpha1.VersionRequest
+	25, // 54: runtime.v1alpha1.RuntimeService.ListInstances:input_type -> runtime.v1alpha1.ListInstancesRequest
+	27, // 55: runtime.v1alpha1.RuntimeService.WatchInstances:input_type -> runtime.v1alpha1.WatchInstancesRequest
+	29, // 56: runtime.v1alpha1.RuntimeService.CreateInstance:input_type -> runtime.v1alpha1.CreateInstanceRequest
+	31, // 57: runtime.v1alpha1.RuntimeService.DeleteInstance:input_type -> runtime.v1alpha1.DeleteInstanceRequest
+	33, // 58: runtime.v1alpha1.RuntimeService.UpdateInstanceAnnotations:input_type -> runtime.v1alpha1.UpdateInstanceAnnotationsRequest
+	35, // 59: runtime.v1alpha1.RuntimeService.UpdateInstancePower:input_type -> runtime.v1alpha1.UpdateInstancePowerRequest
+	37, // 60: runtime.v1alpha1.RuntimeService.RebootInstance:input_type -> runtime.v1alpha1.RebootInstanceRequest
+	39, // 61: runtime.v1alpha1.RuntimeService.UpdateInstanceResources:input_type -> runtime.v1alpha1.UpdateInstanceResourcesRequest
+	41, // 62: runtime.v1alpha1.RuntimeService.AttachDisk:input_type -> runtime.v1alpha1.AttachDiskRequest
+	43, // 63: runtime.v1alpha1.RuntimeService.DetachDisk:input_type -> runtime.v1alpha1.DetachDiskRequest
+	45, // 64: runtime.v1alpha1.RuntimeService.AttachNetworkInterface:input_type -> runtime.v1alpha1.AttachNetworkInterfaceRequest
+	47, // 65: runtime.v1alpha1.RuntimeService.DetachNetworkInterface:input_type -> runtime.v1alpha1.DetachNetworkInterfaceRequest
+	49, // 66: runtime.v1alpha1.RuntimeService.Status:input_type -> runtime.v1alpha1.StatusRequest
+	57, // 67: runtime.v1alpha1.RuntimeService.InstanceStats:input_type -> runtime.v1alpha1.InstanceStatsRequest
+	59, // 68: runtime.v1alpha1.RuntimeService.ListInstanceStats:input_type -> runtime.v1alpha1.ListInstanceStatsRequest
+	61, // 69: runtime.v1alpha1.RuntimeService.Exec:input_type -> runtime.v1alpha1.ExecRequest
+	63, // 70: runtime.v1alpha1.RuntimeService.PortForward:input_type -> runtime.v1alpha1.PortForwardRequest
+	65, // 71: runtime.v1alpha1.RuntimeService.GetConsoleLog:input_type -> runtime.v1alpha1.GetConsoleLogRequest
+	69, // 72: runtime.v1alpha1.ImageService.ListImages:input_type -> runtime.v1alpha1.ListImagesRequest
+	71, // 73: runtime.v1alpha1.ImageService.PullImage:input_type -> runtime.v1alpha1.PullImageRequest
+	73, // 74: runtime.v1alpha1.ImageService.RemoveImage:input_type -> runtime.v1alpha1.RemoveImageRequest
+	75, // 75: runtime.v1alpha1.ImageService.ImageFsInfo:input_type -> runtime.v1alpha1.ImageFsInfoRequest
+	23, // 76: runtime.v1alpha1.RuntimeService.Version:output_type -> runtime.v1alpha1.VersionResponse
+	26, // 77: runtime.v1alpha1.RuntimeService.ListInstances:output_type -> runtime.v1alpha1.ListInstancesResponse
+	28, // 78: runtime.v1alpha1.RuntimeService.WatchInstances:output_type -> runtime.v1alpha1.WatchInstancesResponse
+	30, // 79: runtime.v1alpha1.RuntimeService.CreateInstance:output_type -> runtime.v1alpha1.CreateInstanceResponse
+	32, // 80: runtime.v1alpha1.RuntimeService.DeleteInstance:output_type -> runtime.v1alpha1.DeleteInstanceResponse
+	34, // 81: runtime.v1alpha1.RuntimeService.UpdateInstanceAnnotations:output_type -> runtime.v1alpha1.UpdateInstanceAnnotationsResponse
+	36, // 82: runtime.v1alpha1.RuntimeService.UpdateInstancePower:output_type -> runtime.v1alpha1.UpdateInstancePowerResponse
+	38, // 83: runtime.v1alpha1.RuntimeService.RebootInstance:output_type -> runtime.v1alpha1.RebootInstanceResponse
+	40, // 84: runtime.v1alpha1.RuntimeService.UpdateInstanceResources:output_type -> runtime.v1alpha1.UpdateInstanceResourcesResponse
+	42, // 85: runtime.v1alpha1.RuntimeService.AttachDisk:output_type -> runtime.v1alpha1.AttachDiskResponse
+	44, // 86: runtime.v1alpha1.RuntimeService.DetachDisk:output_type -> runtime.v1alpha1.DetachDiskResponse
+	46, // 87: runtime.v1alpha1.RuntimeService.AttachNetworkInterface:output_type -> runtime.v1alpha1.AttachNetworkInterfaceResponse
+	48, // 88: runtime.v1alpha1.RuntimeService.DetachNetworkInterface:output_type -> runtime.v1alpha1.DetachNetworkInterfaceResponse
+	51, // 89: runtime.v1alpha1.RuntimeService.Status:output_type -> runtime.v1alpha1.StatusResponse
+	58, // 90: runtime.v1alpha1.RuntimeService.InstanceStats:output_type -> runtime.v1alpha1.InstanceStatsResponse
+	60, // 91: runtime.v1alpha1.RuntimeService.ListInstanceStats:output_type -> runtime.v1alpha1.ListInstanceStatsResponse
+	62, // 92: runtime.v1alpha1.RuntimeService.Exec:output_type -> runtime.v1alpha1.ExecResponse
+	64, // 93: runtime.v1alpha1.RuntimeService.PortForward:output_type -> runtime.v1alpha1.PortForwardResponse
+	66, // 94: runtime.v1alpha1.RuntimeService.GetConsoleLog:output_type -> runtime.v1alpha1.GetConsoleLogResponse
+	70, // 95: runtime.v1alpha1.ImageService.ListImages:output_type -> runtime.v1alpha1.ListImagesResponse
+	72, // 96: runtime.v1alpha1.ImageService.PullImage:output_type -> runtime.v1alpha1.PullImageResponse
+	74, // 97: runtime.v1alpha1.ImageService.RemoveImage:output_type -> runtime.v1alpha1.RemoveImageResponse
+	76, // 98: runtime.v1alpha1.ImageService.ImageFsInfo:output_type -> runtime.v1alpha1.ImageFsInfoResponse
+	76, // [76:99] is the sub-list for method output_type
+	53, // [53:76] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_iri_api_apis_runtime_v1alpha1_api_proto_init() }
@@ -2921,8 +5330,212 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*DiskSpec); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*DiskSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*LabelSelectorRequirement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptyDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DiskConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Disk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*NetworkInterfaceSubnetMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*NetworkInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*DiskStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*NetworkInterfaceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RuntimeCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ListInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2933,8 +5546,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*InstanceFilter); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ListInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2945,8 +5558,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Instance); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchInstancesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2957,8 +5570,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*ImageSpec); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchInstancesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2969,8 +5582,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*EmptyDisk); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2981,8 +5594,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DiskConnection); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2993,8 +5606,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Disk); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3005,8 +5618,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*NetworkInterfaceSubnetMetadata); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3017,8 +5630,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*NetworkInterface); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstanceAnnotationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3029,8 +5642,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*InstanceSpec); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstanceAnnotationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3041,8 +5654,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*InstanceStatus); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstancePowerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3053,8 +5666,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DiskStatus); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstancePowerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3065,8 +5678,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*NetworkInterfaceStatus); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RebootInstanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3077,8 +5690,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*VersionRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*RebootInstanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3089,8 +5702,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*VersionResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstanceResourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3101,8 +5714,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ListInstancesRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateInstanceResourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3113,8 +5726,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListInstancesResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachDiskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3125,8 +5738,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateInstanceRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachDiskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3137,8 +5750,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateInstanceResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachDiskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3149,8 +5762,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteInstanceRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachDiskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3161,8 +5774,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteInstanceResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachNetworkInterfaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3173,8 +5786,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateInstanceAnnotationsRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachNetworkInterfaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3185,8 +5798,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateInstanceAnnotationsResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachNetworkInterfaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3197,8 +5810,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateInstancePowerRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachNetworkInterfaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3209,8 +5822,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateInstancePowerResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3221,8 +5834,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachDiskRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RuntimeResources); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3233,8 +5846,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachDiskResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3245,8 +5858,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachDiskRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3257,8 +5870,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachDiskResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*CpuStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3269,8 +5882,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachNetworkInterfaceRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*MemoryStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3281,8 +5894,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*AttachNetworkInterfaceResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*DiskStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3293,8 +5906,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachNetworkInterfaceRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*NetworkInterfaceStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3305,8 +5918,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*DetachNetworkInterfaceResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3317,8 +5930,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*StatusRequest); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*InstanceStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3329,8 +5942,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*RuntimeResources); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ListInstanceStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3341,8 +5954,8 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*StatusResponse); i {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*ListInstanceStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3353,7 +5966,7 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*ExecRequest); i {
 			case 0:
 				return &v.state
@@ -3365,7 +5978,7 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
-		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[38].Exporter = func(v any, i int) any {
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*ExecResponse); i {
 			case 0:
 				return &v.state
@@ -3377,16 +5990,185 @@ func file_iri_api_apis_runtime_v1alpha1_api_proto_init() {
 				return nil
 			}
 		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*PortForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*PortForwardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConsoleLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*GetConsoleLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*Image); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*ListImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*ListImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*PullImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*PullImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveImageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageFsInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageFsInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_iri_api_apis_runtime_v1alpha1_api_proto_msgTypes[58].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_iri_api_apis_runtime_v1alpha1_api_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   48,
+			NumEnums:      7,
+			NumMessages:   79,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_iri_api_apis_runtime_v1alpha1_api_proto_goTypes,
 		DependencyIndexes: file_iri_api_apis_runtime_v1alpha1_api_proto_depIdxs,
@@ -22,16 +22,23 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	RuntimeService_Version_FullMethodName                   = "/runtime.v1alpha1.RuntimeService/Version"
 	RuntimeService_ListInstances_FullMethodName             = "/runtime.v1alpha1.RuntimeService/ListInstances"
+	RuntimeService_WatchInstances_FullMethodName            = "/runtime.v1alpha1.RuntimeService/WatchInstances"
 	RuntimeService_CreateInstance_FullMethodName            = "/runtime.v1alpha1.RuntimeService/CreateInstance"
 	RuntimeService_DeleteInstance_FullMethodName            = "/runtime.v1alpha1.RuntimeService/DeleteInstance"
 	RuntimeService_UpdateInstanceAnnotations_FullMethodName = "/runtime.v1alpha1.RuntimeService/UpdateInstanceAnnotations"
 	RuntimeService_UpdateInstancePower_FullMethodName       = "/runtime.v1alpha1.RuntimeService/UpdateInstancePower"
+	RuntimeService_RebootInstance_FullMethodName            = "/runtime.v1alpha1.RuntimeService/RebootInstance"
+	RuntimeService_UpdateInstanceResources_FullMethodName   = "/runtime.v1alpha1.RuntimeService/UpdateInstanceResources"
 	RuntimeService_AttachDisk_FullMethodName                = "/runtime.v1alpha1.RuntimeService/AttachDisk"
 	RuntimeService_DetachDisk_FullMethodName                = "/runtime.v1alpha1.RuntimeService/DetachDisk"
 	RuntimeService_AttachNetworkInterface_FullMethodName    = "/runtime.v1alpha1.RuntimeService/AttachNetworkInterface"
 	RuntimeService_DetachNetworkInterface_FullMethodName    = "/runtime.v1alpha1.RuntimeService/DetachNetworkInterface"
 	RuntimeService_Status_FullMethodName                    = "/runtime.v1alpha1.RuntimeService/Status"
+	RuntimeService_InstanceStats_FullMethodName             = "/runtime.v1alpha1.RuntimeService/InstanceStats"
+	RuntimeService_ListInstanceStats_FullMethodName         = "/runtime.v1alpha1.RuntimeService/ListInstanceStats"
 	RuntimeService_Exec_FullMethodName                      = "/runtime.v1alpha1.RuntimeService/Exec"
+	RuntimeService_PortForward_FullMethodName               = "/runtime.v1alpha1.RuntimeService/PortForward"
+	RuntimeService_GetConsoleLog_FullMethodName             = "/runtime.v1alpha1.RuntimeService/GetConsoleLog"
 )
 
 // RuntimeServiceClient is the client API for RuntimeService service.
@@ -40,16 +47,23 @@ const (
 type RuntimeServiceClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
+	WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInstancesResponse], error)
 	CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*CreateInstanceResponse, error)
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
 	UpdateInstanceAnnotations(ctx context.Context, in *UpdateInstanceAnnotationsRequest, opts ...grpc.CallOption) (*UpdateInstanceAnnotationsResponse, error)
 	UpdateInstancePower(ctx context.Context, in *UpdateInstancePowerRequest, opts ...grpc.CallOption) (*UpdateInstancePowerResponse, error)
+	RebootInstance(ctx context.Context, in *RebootInstanceRequest, opts ...grpc.CallOption) (*RebootInstanceResponse, error)
+	UpdateInstanceResources(ctx context.Context, in *UpdateInstanceResourcesRequest, opts ...grpc.CallOption) (*UpdateInstanceResourcesResponse, error)
 	AttachDisk(ctx context.Context, in *AttachDiskRequest, opts ...grpc.CallOption) (*AttachDiskResponse, error)
 	DetachDisk(ctx context.Context, in *DetachDiskRequest, opts ...grpc.CallOption) (*DetachDiskResponse, error)
 	AttachNetworkInterface(ctx context.Context, in *AttachNetworkInterfaceRequest, opts ...grpc.CallOption) (*AttachNetworkInterfaceResponse, error)
 	DetachNetworkInterface(ctx context.Context, in *DetachNetworkInterfaceRequest, opts ...grpc.CallOption) (*DetachNetworkInterfaceResponse, error)
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	InstanceStats(ctx context.Context, in *InstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStatsResponse, error)
+	ListInstanceStats(ctx context.Context, in *ListInstanceStatsRequest, opts ...grpc.CallOption) (*ListInstanceStatsResponse, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error)
+	GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetConsoleLogResponse], error)
 }
 
 type runtimeServiceClient struct {
@@ -80,6 +94,25 @@ func (c *runtimeServiceClient) ListInstances(ctx context.Context, in *ListInstan
 	return out, nil
 }
 
+func (c *runtimeServiceClient) WatchInstances(ctx context.Context, in *WatchInstancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchInstancesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[0], RuntimeService_WatchInstances_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInstancesRequest, WatchInstancesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuntimeService_WatchInstancesClient = grpc.ServerStreamingClient[WatchInstancesResponse]
+
 func (c *runtimeServiceClient) CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*CreateInstanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateInstanceResponse)
@@ -120,6 +153,26 @@ func (c *runtimeServiceClient) UpdateInstancePower(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *runtimeServiceClient) RebootInstance(ctx context.Context, in *RebootInstanceRequest, opts ...grpc.CallOption) (*RebootInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebootInstanceResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_RebootInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) UpdateInstanceResources(ctx context.Context, in *UpdateInstanceResourcesRequest, opts ...grpc.CallOption) (*UpdateInstanceResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInstanceResourcesResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_UpdateInstanceResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) AttachDisk(ctx context.Context, in *AttachDiskRequest, opts ...grpc.CallOption) (*AttachDiskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachDiskResponse)
@@ -170,6 +223,26 @@ func (c *runtimeServiceClient) Status(ctx context.Context, in *StatusRequest, op
 	return out, nil
 }
 
+func (c *runtimeServiceClient) InstanceStats(ctx context.Context, in *InstanceStatsRequest, opts ...grpc.CallOption) (*InstanceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceStatsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_InstanceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListInstanceStats(ctx context.Context, in *ListInstanceStatsRequest, opts ...grpc.CallOption) (*ListInstanceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstanceStatsResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListInstanceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecResponse)
@@ -180,22 +253,58 @@ func (c *runtimeServiceClient) Exec(ctx context.Context, in *ExecRequest, opts .
 	return out, nil
 }
 
+func (c *runtimeServiceClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortForwardResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_PortForward_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetConsoleLogResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[1], RuntimeService_GetConsoleLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetConsoleLogRequest, GetConsoleLogResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuntimeService_GetConsoleLogClient = grpc.ServerStreamingClient[GetConsoleLogResponse]
+
 // RuntimeServiceServer is the server API for RuntimeService service.
 // All implementations must embed UnimplementedRuntimeServiceServer
 // for forward compatibility.
 type RuntimeServiceServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error)
+	WatchInstances(*WatchInstancesRequest, grpc.ServerStreamingServer[WatchInstancesResponse]) error
 	CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error)
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
 	UpdateInstanceAnnotations(context.Context, *UpdateInstanceAnnotationsRequest) (*UpdateInstanceAnnotationsResponse, error)
 	UpdateInstancePower(context.Context, *UpdateInstancePowerRequest) (*UpdateInstancePowerResponse, error)
+	RebootInstance(context.Context, *RebootInstanceRequest) (*RebootInstanceResponse, error)
+	UpdateInstanceResources(context.Context, *UpdateInstanceResourcesRequest) (*UpdateInstanceResourcesResponse, error)
 	AttachDisk(context.Context, *AttachDiskRequest) (*AttachDiskResponse, error)
 	DetachDisk(context.Context, *DetachDiskRequest) (*DetachDiskResponse, error)
 	AttachNetworkInterface(context.Context, *AttachNetworkInterfaceRequest) (*AttachNetworkInterfaceResponse, error)
 	DetachNetworkInterface(context.Context, *DetachNetworkInterfaceRequest) (*DetachNetworkInterfaceResponse, error)
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	InstanceStats(context.Context, *InstanceStatsRequest) (*InstanceStatsResponse, error)
+	ListInstanceStats(context.Context, *ListInstanceStatsRequest) (*ListInstanceStatsResponse, error)
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	PortForward(context.Context, *PortForwardRequest) (*PortForwardResponse, error)
+	GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[GetConsoleLogResponse]) error
 	mustEmbedUnimplementedRuntimeServiceServer()
 }
 
@@ -212,6 +321,9 @@ func (UnimplementedRuntimeServiceServer) Version(context.Context, *VersionReques
 func (UnimplementedRuntimeServiceServer) ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
 }
+func (UnimplementedRuntimeServiceServer) WatchInstances(*WatchInstancesRequest, grpc.ServerStreamingServer[WatchInstancesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInstances not implemented")
+}
 func (UnimplementedRuntimeServiceServer) CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstance not implemented")
 }
@@ -224,6 +336,12 @@ func (UnimplementedRuntimeServiceServer) UpdateInstanceAnnotations(context.Conte
 func (UnimplementedRuntimeServiceServer) UpdateInstancePower(context.Context, *UpdateInstancePowerRequest) (*UpdateInstancePowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstancePower not implemented")
 }
+func (UnimplementedRuntimeServiceServer) RebootInstance(context.Context, *RebootInstanceRequest) (*RebootInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootInstance not implemented")
+}
+func (UnimplementedRuntimeServiceServer) UpdateInstanceResources(context.Context, *UpdateInstanceResourcesRequest) (*UpdateInstanceResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstanceResources not implemented")
+}
 func (UnimplementedRuntimeServiceServer) AttachDisk(context.Context, *AttachDiskRequest) (*AttachDiskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttachDisk not implemented")
 }
@@ -239,9 +357,21 @@ func (UnimplementedRuntimeServiceServer) DetachNetworkInterface(context.Context,
 func (UnimplementedRuntimeServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedRuntimeServiceServer) InstanceStats(context.Context, *InstanceStatsRequest) (*InstanceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceStats not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListInstanceStats(context.Context, *ListInstanceStatsRequest) (*ListInstanceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstanceStats not implemented")
+}
 func (UnimplementedRuntimeServiceServer) Exec(context.Context, *ExecRequest) (*ExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedRuntimeServiceServer) PortForward(context.Context, *PortForwardRequest) (*PortForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortForward not implemented")
+}
+func (UnimplementedRuntimeServiceServer) GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[GetConsoleLogResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsoleLog not implemented")
+}
 func (UnimplementedRuntimeServiceServer) mustEmbedUnimplementedRuntimeServiceServer() {}
 func (UnimplementedRuntimeServiceServer) testEmbeddedByValue()                        {}
 
@@ -299,6 +429,17 @@ func _RuntimeService_ListInstances_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_WatchInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).WatchInstances(m, &grpc.GenericServerStream[WatchInstancesRequest, WatchInstancesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuntimeService_WatchInstancesServer = grpc.ServerStreamingServer[WatchInstancesResponse]
+
 func _RuntimeService_CreateInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateInstanceRequest)
 	if err := dec(in); err != nil {
@@ -371,6 +512,42 @@ func _RuntimeService_UpdateInstancePower_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_RebootInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RebootInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_RebootInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RebootInstance(ctx, req.(*RebootInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_UpdateInstanceResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInstanceResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).UpdateInstanceResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_UpdateInstanceResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).UpdateInstanceResources(ctx, req.(*UpdateInstanceResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_AttachDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttachDiskRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +638,42 @@ func _RuntimeService_Status_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_InstanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).InstanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_InstanceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).InstanceStats(ctx, req.(*InstanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListInstanceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstanceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListInstanceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListInstanceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListInstanceStats(ctx, req.(*ListInstanceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecRequest)
 	if err := dec(in); err != nil {
@@ -479,6 +692,35 @@ func _RuntimeService_Exec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_PortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_PortForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PortForward(ctx, req.(*PortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_GetConsoleLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsoleLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).GetConsoleLog(m, &grpc.GenericServerStream[GetConsoleLogRequest, GetConsoleLogResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuntimeService_GetConsoleLogServer = grpc.ServerStreamingServer[GetConsoleLogResponse]
+
 // RuntimeService_ServiceDesc is the grpc.ServiceDesc for RuntimeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,6 +752,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInstancePower",
 			Handler:    _RuntimeService_UpdateInstancePower_Handler,
 		},
+		{
+			MethodName: "RebootInstance",
+			Handler:    _RuntimeService_RebootInstance_Handler,
+		},
+		{
+			MethodName: "UpdateInstanceResources",
+			Handler:    _RuntimeService_UpdateInstanceResources_Handler,
+		},
 		{
 			MethodName: "AttachDisk",
 			Handler:    _RuntimeService_AttachDisk_Handler,
@@ -530,10 +780,249 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _RuntimeService_Status_Handler,
 		},
+		{
+			MethodName: "InstanceStats",
+			Handler:    _RuntimeService_InstanceStats_Handler,
+		},
+		{
+			MethodName: "ListInstanceStats",
+			Handler:    _RuntimeService_ListInstanceStats_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _RuntimeService_Exec_Handler,
 		},
+		{
+			MethodName: "PortForward",
+			Handler:    _RuntimeService_PortForward_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInstances",
+			Handler:       _RuntimeService_WatchInstances_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetConsoleLog",
+			Handler:       _RuntimeService_GetConsoleLog_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "iri-api/apis/runtime/v1alpha1/api.proto",
+}
+
+const (
+	ImageService_ListImages_FullMethodName  = "/runtime.v1alpha1.ImageService/ListImages"
+	ImageService_PullImage_FullMethodName   = "/runtime.v1alpha1.ImageService/PullImage"
+	ImageService_RemoveImage_FullMethodName = "/runtime.v1alpha1.ImageService/RemoveImage"
+	ImageService_ImageFsInfo_FullMethodName = "/runtime.v1alpha1.ImageService/ImageFsInfo"
+)
+
+// ImageServiceClient is the client API for ImageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImageServiceClient interface {
+	ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error)
+	PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (*PullImageResponse, error)
+	RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error)
+	ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error)
+}
+
+type imageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImageServiceClient(cc grpc.ClientConnInterface) ImageServiceClient {
+	return &imageServiceClient{cc}
+}
+
+func (c *imageServiceClient) ListImages(ctx context.Context, in *ListImagesRequest, opts ...grpc.CallOption) (*ListImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_ListImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) PullImage(ctx context.Context, in *PullImageRequest, opts ...grpc.CallOption) (*PullImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_PullImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) RemoveImage(ctx context.Context, in *RemoveImageRequest, opts ...grpc.CallOption) (*RemoveImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_RemoveImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ImageFsInfo(ctx context.Context, in *ImageFsInfoRequest, opts ...grpc.CallOption) (*ImageFsInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageFsInfoResponse)
+	err := c.cc.Invoke(ctx, ImageService_ImageFsInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImageServiceServer is the server API for ImageService service.
+// All implementations must embed UnimplementedImageServiceServer
+// for forward compatibility.
+type ImageServiceServer interface {
+	ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error)
+	PullImage(context.Context, *PullImageRequest) (*PullImageResponse, error)
+	RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error)
+	ImageFsInfo(context.Context, *ImageFsInfoRequest) (*ImageFsInfoResponse, error)
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+// UnimplementedImageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImageServiceServer struct{}
+
+func (UnimplementedImageServiceServer) ListImages(context.Context, *ListImagesRequest) (*ListImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImages not implemented")
+}
+func (UnimplementedImageServiceServer) PullImage(context.Context, *PullImageRequest) (*PullImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullImage not implemented")
+}
+func (UnimplementedImageServiceServer) RemoveImage(context.Context, *RemoveImageRequest) (*RemoveImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveImage not implemented")
+}
+func (UnimplementedImageServiceServer) ImageFsInfo(context.Context, *ImageFsInfoRequest) (*ImageFsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageFsInfo not implemented")
+}
+func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
+func (UnimplementedImageServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImageServiceServer will
+// result in compilation errors.
+type UnsafeImageServiceServer interface {
+	mustEmbedUnimplementedImageServiceServer()
+}
+
+func RegisterImageServiceServer(s grpc.ServiceRegistrar, srv ImageServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImageService_ServiceDesc, srv)
+}
+
+func _ImageService_ListImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListImages(ctx, req.(*ListImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_PullImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).PullImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_PullImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).PullImage(ctx, req.(*PullImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_RemoveImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).RemoveImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_RemoveImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).RemoveImage(ctx, req.(*RemoveImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ImageFsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageFsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ImageFsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ImageFsInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ImageFsInfo(ctx, req.(*ImageFsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ImageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "runtime.v1alpha1.ImageService",
+	HandlerType: (*ImageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListImages",
+			Handler:    _ImageService_ListImages_Handler,
+		},
+		{
+			MethodName: "PullImage",
+			Handler:    _ImageService_PullImage_Handler,
+		},
+		{
+			MethodName: "RemoveImage",
+			Handler:    _ImageService_RemoveImage_Handler,
+		},
+		{
+			MethodName: "ImageFsInfo",
+			Handler:    _ImageService_ImageFsInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iri-api/apis/runtime/v1alpha1/api.proto",
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package listing implements filtering and pagination of IRI list requests for runtimes.
+package listing
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+var labelSelectorOperators = map[iri.LabelSelectorOperator]selection.Operator{
+	iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN:             selection.In,
+	iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN:         selection.NotIn,
+	iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS:         selection.Exists,
+	iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST: selection.DoesNotExist,
+}
+
+// LabelSelector converts the label selector and label selector requirements of the filter into a labels.Selector.
+func LabelSelector(filter *iri.InstanceFilter) (labels.Selector, error) {
+	sel := labels.SelectorFromSet(filter.GetLabelSelector())
+	for _, req := range filter.GetLabelSelectorRequirements() {
+		op, ok := labelSelectorOperators[req.Operator]
+		if !ok {
+			return nil, fmt.Errorf("unknown label selector operator %v", req.Operator)
+		}
+
+		r, err := labels.NewRequirement(req.Key, op, req.Values)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector requirement: %w", err)
+		}
+		sel = sel.Add(*r)
+	}
+	return sel, nil
+}
+
+// LabelSelectorRequirements converts the requirements of the given selector into IRI label selector requirements.
+func LabelSelectorRequirements(sel labels.Selector) ([]*iri.LabelSelectorRequirement, error) {
+	reqs, _ := sel.Requirements()
+
+	res := make([]*iri.LabelSelectorRequirement, 0, len(reqs))
+	for _, req := range reqs {
+		var op iri.LabelSelectorOperator
+		switch req.Operator() {
+		case selection.In, selection.Equals, selection.DoubleEquals:
+			op = iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN
+		case selection.NotIn, selection.NotEquals:
+			op = iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN
+		case selection.Exists:
+			op = iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS
+		case selection.DoesNotExist:
+			op = iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST
+		default:
+			return nil, fmt.Errorf("unsupported label selector operator %s", req.Operator())
+		}
+
+		res = append(res, &iri.LabelSelectorRequirement{
+			Key:      req.Key(),
+			Operator: op,
+			Values:   req.Values().List(),
+		})
+	}
+	return res, nil
+}
+
+// InstanceMatcher matches instances against an iri.InstanceFilter.
+type InstanceMatcher struct {
+	id       string
+	selector labels.Selector
+	states   []iri.InstanceState
+}
+
+// NewInstanceMatcher creates a new InstanceMatcher for the given filter. A nil filter matches all instances.
+func NewInstanceMatcher(filter *iri.InstanceFilter) (*InstanceMatcher, error) {
+	sel, err := LabelSelector(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstanceMatcher{
+		id:       filter.GetId(),
+		selector: sel,
+		states:   filter.GetStates(),
+	}, nil
+}
+
+// Matches reports whether an instance with the given id, labels and state matches the filter.
+func (m *InstanceMatcher) Matches(id string, lbls map[string]string, state iri.InstanceState) bool {
+	if m.id != "" && m.id != id {
+		return false
+	}
+	if len(m.states) > 0 && !slices.Contains(m.states, state) {
+		return false
+	}
+	return m.selector.Matches(labels.Set(lbls))
+}
+
+// MatchesInstance reports whether the instance matches the filter.
+func (m *InstanceMatcher) MatchesInstance(inst *iri.Instance) bool {
+	return m.Matches(inst.GetMetadata().GetId(), inst.GetMetadata().GetLabels(), inst.GetStatus().GetState())
+}
+
+func encodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+func decodePageToken(token string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page token: %w", err)
+	}
+	return string(data), nil
+}
+
+// Paginate sorts the items by the given key and returns the page of at most pageSize items following the
+// page token, along with the token of the next page. The next page token is empty if there are no more items.
+// A pageSize of 0 returns all items following the page token.
+func Paginate[T any](items []T, key func(T) string, pageSize int32, pageToken string) ([]T, string, error) {
+	if pageSize < 0 {
+		return nil, "", fmt.Errorf("invalid page size %d: must not be negative", pageSize)
+	}
+
+	items = slices.Clone(items)
+	slices.SortFunc(items, func(a, b T) int {
+		return strings.Compare(key(a), key(b))
+	})
+
+	if pageToken != "" {
+		after, err := decodePageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+
+		start, _ := slices.BinarySearchFunc(items, after, func(item T, after string) int {
+			if key(item) <= after {
+				return -1
+			}
+			return 1
+		})
+		items = items[start:]
+	}
+
+	if pageSize == 0 || len(items) <= int(pageSize) {
+		return items, "", nil
+	}
+
+	page := items[:pageSize]
+	return page, encodePageToken(key(page[len(page)-1])), nil
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package listing_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/labels"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	. "spheric.cloud/spheric/iri-api/listing"
+)
+
+var _ = Describe("Listing", func() {
+	Describe("InstanceMatcher", func() {
+		newInstance := func(id string, labels map[string]string, state iri.InstanceState) *iri.Instance {
+			return &iri.Instance{
+				Metadata: &iri.ObjectMetadata{Id: id, Labels: labels},
+				Status:   &iri.InstanceStatus{State: state},
+			}
+		}
+
+		It("should match all instances for a nil filter", func() {
+			m, err := NewInstanceMatcher(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.MatchesInstance(newInstance("foo", nil, iri.InstanceState_INSTANCE_RUNNING))).To(BeTrue())
+		})
+
+		It("should match by id, labels, label requirements and states", func() {
+			m, err := NewInstanceMatcher(&iri.InstanceFilter{
+				LabelSelector: map[string]string{"app": "web"},
+				LabelSelectorRequirements: []*iri.LabelSelectorRequirement{
+					{Key: "tier", Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN, Values: []string{"a", "b"}},
+					{Key: "legacy", Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_DOES_NOT_EXIST},
+				},
+				States: []iri.InstanceState{iri.InstanceState_INSTANCE_RUNNING, iri.InstanceState_INSTANCE_PENDING},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(m.MatchesInstance(newInstance("1", map[string]string{"app": "web", "tier": "a"}, iri.InstanceState_INSTANCE_RUNNING))).To(BeTrue())
+			Expect(m.MatchesInstance(newInstance("2", map[string]string{"app": "web", "tier": "c"}, iri.InstanceState_INSTANCE_RUNNING))).To(BeFalse())
+			Expect(m.MatchesInstance(newInstance("3", map[string]string{"app": "web", "tier": "b", "legacy": ""}, iri.InstanceState_INSTANCE_RUNNING))).To(BeFalse())
+			Expect(m.MatchesInstance(newInstance("4", map[string]string{"app": "db", "tier": "a"}, iri.InstanceState_INSTANCE_RUNNING))).To(BeFalse())
+			Expect(m.MatchesInstance(newInstance("5", map[string]string{"app": "web", "tier": "a"}, iri.InstanceState_INSTANCE_TERMINATED))).To(BeFalse())
+
+			m, err = NewInstanceMatcher(&iri.InstanceFilter{Id: "1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Matches("1", nil, iri.InstanceState_INSTANCE_PENDING)).To(BeTrue())
+			Expect(m.Matches("2", nil, iri.InstanceState_INSTANCE_PENDING)).To(BeFalse())
+		})
+
+		It("should reject invalid requirements", func() {
+			_, err := NewInstanceMatcher(&iri.InstanceFilter{
+				LabelSelectorRequirements: []*iri.LabelSelectorRequirement{
+					{Key: "tier", Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("LabelSelectorRequirements", func() {
+		It("should convert set-based selectors", func() {
+			sel, err := labels.Parse("app in (foo,bar),tier!=db,legacy,!deprecated,env=prod")
+			Expect(err).NotTo(HaveOccurred())
+
+			reqs, err := LabelSelectorRequirements(sel)
+			Expect(err).NotTo(HaveOccurred())
+
+			m, err := NewInstanceMatcher(&iri.InstanceFilter{LabelSelectorRequirements: reqs})
+			Expect(err).NotTo(HaveOccurred())
+			for _, lbls := range []map[string]string{
+				{"app": "foo", "legacy": "", "env": "prod"},
+				{"app": "bar", "tier": "web", "legacy": "", "env": "prod"},
+				{"app": "baz", "legacy": "", "env": "prod"},
+				{"app": "foo", "tier": "db", "legacy": "", "env": "prod"},
+				{"app": "foo", "env": "prod"},
+				{"app": "foo", "legacy": "", "deprecated": "", "env": "prod"},
+			} {
+				Expect(m.Matches("", lbls, iri.InstanceState_INSTANCE_PENDING)).To(Equal(sel.Matches(labels.Set(lbls))), "labels %v", lbls)
+			}
+		})
+
+		It("should reject unsupported operators", func() {
+			sel, err := labels.Parse("replicas>1")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = LabelSelectorRequirements(sel)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Paginate", func() {
+		identity := func(s string) string { return s }
+
+		It("should page through all items in order", func() {
+			items := []string{"d", "b", "e", "a", "c"}
+
+			page, token, err := Paginate(items, identity, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"a", "b"}))
+			Expect(token).NotTo(BeEmpty())
+
+			page, token, err = Paginate(items, identity, 2, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"c", "d"}))
+			Expect(token).NotTo(BeEmpty())
+
+			page, token, err = Paginate(items, identity, 2, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"e"}))
+			Expect(token).To(BeEmpty())
+		})
+
+		It("should return all items without a page size", func() {
+			page, token, err := Paginate([]string{"b", "a"}, identity, 0, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"a", "b"}))
+			Expect(token).To(BeEmpty())
+		})
+
+		It("should continue after removed items", func() {
+			_, token, err := Paginate([]string{"a", "b", "c"}, identity, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			page, _, err := Paginate([]string{"a", "c"}, identity, 2, token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"c"}))
+		})
+
+		It("should reject invalid page sizes and tokens", func() {
+			_, _, err := Paginate([]string{"a"}, identity, -1, "")
+			Expect(err).To(HaveOccurred())
+
+			_, _, err = Paginate([]string{"a"}, identity, 1, "!invalid!")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -16,14 +16,15 @@ import (
 
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
 	"spheric.cloud/spheric/irictl/renderer"
 	"spheric.cloud/spheric/irictl/tableconverter"
+	utilgrpc "spheric.cloud/spheric/utils/grpc"
 )
 
 type Factory interface {
 	Client() (iri.RuntimeServiceClient, func() error, error)
+	ImageClient() (iri.ImageServiceClient, func() error, error)
 	Config() (*clientcmd.Config, error)
 	Registry() (*renderer.Registry, error)
 	OutputOptions() *OutputOptions
@@ -32,6 +33,7 @@ type Factory interface {
 type Options struct {
 	Address    string
 	ConfigFile string
+	Security   utilgrpc.ClientSecurityOptions
 }
 
 func NewOptions() *Options {
@@ -44,6 +46,7 @@ func NewOptions() *Options {
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ConfigFile, clientcmd.RecommendedConfigPathFlag, o.ConfigFile, "Config file to use")
 	fs.StringVar(&o.Address, "address", o.Address, "Address to the iri server.")
+	o.Security.BindFlags(fs, "")
 }
 
 func (o *Options) Config() (*clientcmd.Config, error) {
@@ -68,20 +71,42 @@ func (o *Options) Registry() (*renderer.Registry, error) {
 	return registry, nil
 }
 
-func (o *Options) Client() (iri.RuntimeServiceClient, func() error, error) {
+func (o *Options) dial() (*grpc.ClientConn, error) {
 	address, err := iriremote.GetAddressWithTimeout(3*time.Second, o.Address)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	dialOpts, err := o.Security.DialOptions()
+	if err != nil {
+		return nil, err
 	}
 
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(address, dialOpts...)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error dialing: %w", err)
+		return nil, fmt.Errorf("error dialing: %w", err)
+	}
+	return conn, nil
+}
+
+func (o *Options) Client() (iri.RuntimeServiceClient, func() error, error) {
+	conn, err := o.dial()
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return iri.NewRuntimeServiceClient(conn), conn.Close, nil
 }
 
+func (o *Options) ImageClient() (iri.ImageServiceClient, func() error, error) {
+	conn, err := o.dial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return iri.NewImageServiceClient(conn), conn.Close, nil
+}
+
 func (o *Options) OutputOptions() *OutputOptions {
 	return &OutputOptions{
 		factory: o,
@@ -129,4 +154,5 @@ var (
 	InstanceAliases         = []string{"instances", "inst", "insts"}
 	DiskAliases             = []string{"disks", "dsk", "dsks"}
 	NetworkInterfaceAliases = []string{"networkinterfaces", "nic", "nics"}
+	ImageAliases            = []string{"images", "img", "imgs"}
 )
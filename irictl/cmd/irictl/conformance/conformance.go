@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/conformance"
+)
+
+type Options struct {
+	Image        string
+	InstanceType string
+	CPUCount     int64
+	MemoryBytes  uint64
+	TestTimeout  time.Duration
+	Focus        string
+	Skip         string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Image, "image", o.Image, "Image of the instances created by the tests.")
+	fs.StringVar(&o.InstanceType, "instance-type", o.InstanceType, "Type of the instances created by the tests.")
+	fs.Int64Var(&o.CPUCount, "cpu-count", conformance.DefaultCPUCount, "CPU count of the instances created by the tests.")
+	fs.Uint64Var(&o.MemoryBytes, "memory-bytes", conformance.DefaultMemoryBytes, "Memory of the instances created by the tests.")
+	fs.DurationVar(&o.TestTimeout, "test-timeout", conformance.DefaultTestTimeout, "Timeout of a single test.")
+	fs.StringVar(&o.Focus, "focus", o.Focus, "Only run tests whose name matches this regular expression.")
+	fs.StringVar(&o.Skip, "skip", o.Skip, "Skip tests whose name matches this regular expression.")
+}
+
+func (o *Options) ConformanceOptions() (conformance.Options, error) {
+	opts := conformance.Options{
+		Image:        o.Image,
+		InstanceType: o.InstanceType,
+		CPUCount:     o.CPUCount,
+		MemoryBytes:  o.MemoryBytes,
+		TestTimeout:  o.TestTimeout,
+	}
+
+	if o.Focus != "" {
+		focus, err := regexp.Compile(o.Focus)
+		if err != nil {
+			return conformance.Options{}, fmt.Errorf("error compiling focus: %w", err)
+		}
+		opts.Focus = focus
+	}
+	if o.Skip != "" {
+		skip, err := regexp.Compile(o.Skip)
+		if err != nil {
+			return conformance.Options{}, fmt.Errorf("error compiling skip: %w", err)
+		}
+		opts.Skip = skip
+	}
+	return opts, nil
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "conformance",
+		Short: "Run the IRI conformance tests against the runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			conformanceOpts, err := opts.ConformanceOptions()
+			if err != nil {
+				return err
+			}
+
+			return Run(ctx, streams, client, conformanceOpts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.RuntimeServiceClient, opts conformance.Options) error {
+	var passed, failed, skipped int
+	if _, err := conformance.Run(ctx, client, opts, nil, func(res conformance.Result) {
+		switch {
+		case res.Skipped:
+			skipped++
+			_, _ = fmt.Fprintf(streams.Out, "SKIP %s\n", res.Name)
+		case res.Failed():
+			failed++
+			_, _ = fmt.Fprintf(streams.Out, "FAIL %s (%s): %v\n", res.Name, res.Duration, res.Err)
+		default:
+			passed++
+			_, _ = fmt.Fprintf(streams.Out, "PASS %s (%s)\n", res.Name, res.Duration)
+		}
+	}); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(streams.Out, "%d passed, %d failed, %d skipped\n", passed, failed, skipped)
+	if failed > 0 {
+		return fmt.Errorf("%d conformance test(s) failed", failed)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	clicommon "spheric.cloud/spheric/irictl/cmd"
 	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/cmd/irictl/delete/image"
 	"spheric.cloud/spheric/irictl/cmd/irictl/delete/instance"
 )
 
@@ -18,6 +19,7 @@ func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Com
 	}
 
 	cmd.AddCommand(
+		image.Command(streams, clientFactory),
 		instance.Command(streams, clientFactory),
 	)
 
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+)
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "image name [names...]",
+		Aliases: common.ImageAliases,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.ImageClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			names := args
+
+			return Run(cmd.Context(), streams, client, names)
+		},
+	}
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.ImageServiceClient, names []string) error {
+	for _, name := range names {
+		if _, err := client.RemoveImage(ctx, &iri.RemoveImageRequest{
+			Image: &iri.ImageSpec{Image: name},
+		}); err != nil {
+			if status.Code(err) != codes.NotFound {
+				return fmt.Errorf("error removing image %s: %w", name, err)
+			}
+
+			_, _ = fmt.Fprintf(streams.Out, "Image %s not found\n", name)
+		} else {
+			_, _ = fmt.Fprintf(streams.Out, "Image %s deleted\n", name)
+		}
+	}
+	return nil
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/spf13/cobra"
 	clicommon "spheric.cloud/spheric/irictl/cmd"
 	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/cmd/irictl/get/image"
+	"spheric.cloud/spheric/irictl/cmd/irictl/get/imagefsinfo"
 	"spheric.cloud/spheric/irictl/cmd/irictl/get/instance"
+	"spheric.cloud/spheric/irictl/cmd/irictl/get/stats"
 	"spheric.cloud/spheric/irictl/cmd/irictl/get/status"
 )
 
@@ -19,7 +22,10 @@ func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Com
 	}
 
 	cmd.AddCommand(
+		image.Command(streams, clientFactory),
+		imagefsinfo.Command(streams, clientFactory),
 		instance.Command(streams, clientFactory),
+		stats.Command(streams, clientFactory),
 		status.Command(streams, clientFactory),
 	)
 
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/renderer"
+)
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		outputOpts = clientFactory.OutputOptions()
+	)
+
+	cmd := &cobra.Command{
+		Use:     "image name",
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: common.ImageAliases,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.ImageClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			render, err := outputOpts.Renderer("table")
+			if err != nil {
+				return err
+			}
+
+			var name string
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			return Run(cmd.Context(), streams, client, render, name)
+		},
+	}
+
+	outputOpts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.ImageServiceClient, render renderer.Renderer, name string) error {
+	var filter *iri.ImageFilter
+	if name != "" {
+		filter = &iri.ImageFilter{Image: &iri.ImageSpec{Image: name}}
+	}
+
+	res, err := client.ListImages(ctx, &iri.ListImagesRequest{Filter: filter})
+	if err != nil {
+		return fmt.Errorf("error listing images: %w", err)
+	}
+
+	return render.Render(res.Images, streams.Out)
+}
@@ -8,22 +8,70 @@ package instance
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/iri-api/listing"
 	clicommon "spheric.cloud/spheric/irictl/cmd"
 	"spheric.cloud/spheric/irictl/cmd/irictl/common"
 	"spheric.cloud/spheric/irictl/renderer"
 )
 
 type Options struct {
-	Labels map[string]string
+	Labels   map[string]string
+	Selector string
+	States   []string
+	PageSize int32
 }
 
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringToStringVarP(&o.Labels, "labels", "l", o.Labels, "Labels to filter the instances by.")
+	fs.StringVar(&o.Selector, "selector", o.Selector, "Label selector to filter the instances by, e.g. 'app in (foo,bar),!legacy'.")
+	fs.StringSliceVar(&o.States, "state", o.States, "States to filter the instances by, e.g. 'running'.")
+	fs.Int32Var(&o.PageSize, "page-size", 500, "Number of instances to request per page. If 0, all instances are requested at once.")
+}
+
+func parseState(s string) (iri.InstanceState, error) {
+	state, ok := iri.InstanceState_value["INSTANCE_"+strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("invalid instance state %q", s)
+	}
+	return iri.InstanceState(state), nil
+}
+
+func (o *Options) filter(name string) (*iri.InstanceFilter, error) {
+	if name == "" && o.Labels == nil && o.Selector == "" && len(o.States) == 0 {
+		return nil, nil
+	}
+
+	sel, err := labels.Parse(o.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	reqs, err := listing.LabelSelectorRequirements(sel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	var states []iri.InstanceState
+	for _, s := range o.States {
+		state, err := parseState(s)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+
+	return &iri.InstanceFilter{
+		Id:                        name,
+		LabelSelector:             o.Labels,
+		LabelSelectorRequirements: reqs,
+		States:                    states,
+	}, nil
 }
 
 func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
@@ -78,18 +126,31 @@ func Run(
 	name string,
 	opts Options,
 ) error {
-	var filter *iri.InstanceFilter
-	if name != "" || opts.Labels != nil {
-		filter = &iri.InstanceFilter{
-			Id:            name,
-			LabelSelector: opts.Labels,
-		}
+	filter, err := opts.filter(name)
+	if err != nil {
+		return err
 	}
 
-	res, err := client.ListInstances(ctx, &iri.ListInstancesRequest{Filter: filter})
-	if err != nil {
-		return fmt.Errorf("error listing instances: %w", err)
+	var (
+		instances []*iri.Instance
+		pageToken string
+	)
+	for {
+		res, err := client.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter:    filter,
+			PageSize:  opts.PageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing instances: %w", err)
+		}
+
+		instances = append(instances, res.Instances...)
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = res.NextPageToken
 	}
 
-	return render.Render(res.Instances, streams.Out)
+	return render.Render(instances, streams.Out)
 }
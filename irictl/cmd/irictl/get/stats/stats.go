@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/renderer"
+)
+
+type Options struct {
+	Labels map[string]string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringToStringVarP(&o.Labels, "labels", "l", o.Labels, "Labels to filter the instances by.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		opts       Options
+		outputOpts = clientFactory.OutputOptions()
+	)
+
+	cmd := &cobra.Command{
+		Use:  "stats [instance-id]",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			render, err := outputOpts.Renderer("table")
+			if err != nil {
+				return err
+			}
+
+			var id string
+			if len(args) > 0 {
+				id = args[0]
+			}
+
+			return Run(cmd.Context(), streams, client, render, id, opts)
+		},
+	}
+
+	outputOpts.AddFlags(cmd.Flags())
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(
+	ctx context.Context,
+	streams clicommon.Streams,
+	client iri.RuntimeServiceClient,
+	render renderer.Renderer,
+	id string,
+	opts Options,
+) error {
+	var filter *iri.InstanceFilter
+	if id != "" || opts.Labels != nil {
+		filter = &iri.InstanceFilter{
+			Id:            id,
+			LabelSelector: opts.Labels,
+		}
+	}
+
+	res, err := client.ListInstanceStats(ctx, &iri.ListInstanceStatsRequest{Filter: filter})
+	if err != nil {
+		return fmt.Errorf("error listing instance stats: %w", err)
+	}
+
+	return render.Render(res.Stats, streams.Out)
+}
@@ -16,11 +16,16 @@ import (
 	clicommon "spheric.cloud/spheric/irictl/cmd"
 	"spheric.cloud/spheric/irictl/cmd/irictl/attach"
 	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/cmd/irictl/conformance"
 	"spheric.cloud/spheric/irictl/cmd/irictl/create"
 	"spheric.cloud/spheric/irictl/cmd/irictl/delete"
 	"spheric.cloud/spheric/irictl/cmd/irictl/detach"
 	"spheric.cloud/spheric/irictl/cmd/irictl/exec"
 	"spheric.cloud/spheric/irictl/cmd/irictl/get"
+	"spheric.cloud/spheric/irictl/cmd/irictl/logs"
+	"spheric.cloud/spheric/irictl/cmd/irictl/portforward"
+	"spheric.cloud/spheric/irictl/cmd/irictl/prune"
+	"spheric.cloud/spheric/irictl/cmd/irictl/reboot"
 	"spheric.cloud/spheric/irictl/cmd/irictl/update"
 )
 
@@ -49,11 +54,16 @@ func Command(streams clicommon.Streams) *cobra.Command {
 		get.Command(streams, clientOpts),
 		create.Command(streams, clientOpts),
 		delete.Command(streams, clientOpts),
+		prune.Command(streams, clientOpts),
 		update.Command(streams, clientOpts),
 		exec.Command(streams, clientOpts),
+		portforward.Command(streams, clientOpts),
+		logs.Command(streams, clientOpts),
+		reboot.Command(streams, clientOpts),
 		attach.Command(streams, clientOpts),
 		detach.Command(streams, clientOpts),
 		version.Command(streams, clientOpts),
+		conformance.Command(streams, clientOpts),
 	)
 
 	return cmd
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+)
+
+type Options struct {
+	Follow bool
+	Tail   int64
+	Since  time.Duration
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Follow, "follow", "f", false, "Stream new output of the serial console.")
+	fs.Int64Var(&o.Tail, "tail", -1, "Number of lines from the end of the log to show. -1 shows the whole log.")
+	fs.DurationVar(&o.Since, "since", 0, "Only show output newer than a relative duration like 5s, 2m, or 3h.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "logs instance-id",
+		Short: "Print the serial console log of an instance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			instanceID := args[0]
+
+			return Run(ctx, streams, client, instanceID, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.RuntimeServiceClient, instanceID string, opts Options) error {
+	req := &iri.GetConsoleLogRequest{
+		InstanceId: instanceID,
+		Follow:     opts.Follow,
+	}
+	if opts.Tail >= 0 {
+		req.TailLines = &opts.Tail
+	}
+	if opts.Since > 0 {
+		req.SinceTime = time.Now().Add(-opts.Since).UnixNano()
+	}
+
+	stream, err := client.GetConsoleLog(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error getting console log: %w", err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error getting console log: %w", err)
+		}
+
+		if _, err := streams.Out.Write(res.Data); err != nil {
+			return err
+		}
+	}
+}
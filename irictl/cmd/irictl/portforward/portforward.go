@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/tools/portforward"
+	spdytransport "k8s.io/client-go/transport/spdy"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+)
+
+type Options struct {
+	ListenAddresses []string
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.ListenAddresses, "listen-address", []string{"localhost"},
+		"Addresses to listen on for the forwarded ports.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "port-forward instance-id [local-port:]remote-port...",
+		Short: "Forward local ports to ports of an instance.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			instanceID := args[0]
+			ports := args[1:]
+
+			return Run(ctx, streams, client, instanceID, ports, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// remotePorts returns the remote ports of the given port specifications.
+func remotePorts(ports []string) ([]int32, error) {
+	res := make([]int32, 0, len(ports))
+	for _, port := range ports {
+		_, remote, _ := strings.Cut(port, ":")
+		if remote == "" {
+			remote = port
+		}
+		p, err := strconv.ParseUint(remote, 10, 16)
+		if err != nil || p == 0 {
+			return nil, fmt.Errorf("invalid port %q", port)
+		}
+		res = append(res, int32(p))
+	}
+	return res, nil
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.RuntimeServiceClient, instanceID string, ports []string, opts Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	remote, err := remotePorts(ports)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.PortForward(ctx, &iri.PortForwardRequest{
+		InstanceId: instanceID,
+		Port:       remote,
+	})
+	if err != nil {
+		return fmt.Errorf("error running port forward: %w", err)
+	}
+
+	u, err := url.ParseRequestURI(res.Url)
+	if err != nil {
+		return fmt.Errorf("error parsing request url %q: %w", res.Url, err)
+	}
+
+	log.V(1).Info("Got port forward url", "URL", res.Url)
+
+	roundTripper, err := spdy.NewRoundTripperWithConfig(spdy.RoundTripperConfig{
+		TLS:        http.DefaultTransport.(*http.Transport).TLSClientConfig,
+		Proxier:    http.ProxyFromEnvironment,
+		PingPeriod: 5 * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	dialer := spdytransport.NewDialer(roundTripper, &http.Client{Transport: roundTripper}, http.MethodGet, u)
+
+	stopCh := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stopCh)
+	}()
+
+	fw, err := portforward.NewOnAddresses(dialer, opts.ListenAddresses, ports, stopCh, nil, streams.Out, streams.Err)
+	if err != nil {
+		return fmt.Errorf("error creating port forwarder: %w", err)
+	}
+	return fw.ForwardPorts()
+}
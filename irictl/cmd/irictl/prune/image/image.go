@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+)
+
+type Options struct {
+	DryRun bool
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only print the images that would be removed.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		opts Options
+	)
+
+	cmd := &cobra.Command{
+		Use:     "image",
+		Short:   "Remove all images not in use.",
+		Aliases: common.ImageAliases,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.ImageClient()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			return Run(cmd.Context(), streams, client, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.ImageServiceClient, opts Options) error {
+	res, err := client.ListImages(ctx, &iri.ListImagesRequest{})
+	if err != nil {
+		return fmt.Errorf("error listing images: %w", err)
+	}
+
+	var freed uint64
+	for _, image := range res.Images {
+		if image.InUse {
+			continue
+		}
+
+		if opts.DryRun {
+			_, _ = fmt.Fprintf(streams.Out, "Image %s would be deleted\n", image.Id)
+			freed += image.SizeBytes
+			continue
+		}
+
+		if _, err := client.RemoveImage(ctx, &iri.RemoveImageRequest{
+			Image: &iri.ImageSpec{Image: image.Id},
+		}); err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				continue
+			case codes.FailedPrecondition:
+				_, _ = fmt.Fprintf(streams.Out, "Image %s is in use, skipping\n", image.Id)
+				continue
+			default:
+				return fmt.Errorf("error removing image %s: %w", image.Id, err)
+			}
+		}
+
+		_, _ = fmt.Fprintf(streams.Out, "Image %s deleted\n", image.Id)
+		freed += image.SizeBytes
+	}
+
+	_, _ = fmt.Fprintf(streams.Out, "Total reclaimed space: %d bytes\n", freed)
+	return nil
+}
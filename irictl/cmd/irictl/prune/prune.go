@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package prune
+
+import (
+	"github.com/spf13/cobra"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+	"spheric.cloud/spheric/irictl/cmd/irictl/prune/image"
+)
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "prune",
+	}
+
+	cmd.AddCommand(
+		image.Command(streams, clientFactory),
+	)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	clicommon "spheric.cloud/spheric/irictl/cmd"
+	"spheric.cloud/spheric/irictl/cmd/irictl/common"
+)
+
+type Options struct {
+	Hard bool
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.Hard, "hard", false, "Reset the instance instead of asking its guest to reboot.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:  "reboot instance-id [instance-ids...]",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			return Run(ctx, streams, client, args, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.RuntimeServiceClient, ids []string, opts Options) error {
+	rebootType := iri.RebootType_REBOOT_GRACEFUL
+	if opts.Hard {
+		rebootType = iri.RebootType_REBOOT_HARD
+	}
+
+	for _, id := range ids {
+		if _, err := client.RebootInstance(ctx, &iri.RebootInstanceRequest{
+			InstanceId: id,
+			Type:       rebootType,
+		}); err != nil {
+			return fmt.Errorf("error rebooting instance %s: %w", id, err)
+		}
+
+		_, _ = fmt.Fprintf(streams.Out, "Instance %s rebooted\n", id)
+	}
+	return nil
+}
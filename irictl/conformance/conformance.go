@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance contains tests that check an IRI runtime against the semantics spherelet relies on.
+package conformance
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+const (
+	// RunLabel is the label set on all instances created by a conformance run.
+	// Its value identifies the run, which allows cleaning up after it.
+	RunLabel = "conformance.spheric.cloud/run"
+
+	// DefaultCPUCount is the default cpu count of instances created by conformance tests.
+	DefaultCPUCount = 1
+	// DefaultMemoryBytes is the default memory of instances created by conformance tests.
+	DefaultMemoryBytes = 512 * 1024 * 1024
+	// DefaultTestTimeout is the default timeout of a single conformance test.
+	DefaultTestTimeout = 1 * time.Minute
+)
+
+// cleanupTimeout is the timeout for deleting the instances of a single test.
+const cleanupTimeout = 30 * time.Second
+
+// Options configure a conformance run.
+type Options struct {
+	// Image is the image of instances created by conformance tests.
+	Image string
+	// InstanceType is the type of instances created by conformance tests.
+	InstanceType string
+	// CPUCount is the cpu count of instances created by conformance tests. Defaults to DefaultCPUCount.
+	CPUCount int64
+	// MemoryBytes is the memory of instances created by conformance tests. Defaults to DefaultMemoryBytes.
+	MemoryBytes uint64
+	// TestTimeout is the timeout of a single test. Defaults to DefaultTestTimeout.
+	TestTimeout time.Duration
+	// Focus, if set, only runs tests whose name matches.
+	Focus *regexp.Regexp
+	// Skip, if set, skips tests whose name matches.
+	Skip *regexp.Regexp
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.CPUCount == 0 {
+		o.CPUCount = DefaultCPUCount
+	}
+	if o.MemoryBytes == 0 {
+		o.MemoryBytes = DefaultMemoryBytes
+	}
+	if o.TestTimeout == 0 {
+		o.TestTimeout = DefaultTestTimeout
+	}
+}
+
+// Test is a single conformance test.
+type Test struct {
+	// Name is the unique name of the test.
+	Name string
+	// Requires, if set, reports whether the runtime has the capabilities the test needs.
+	// Tests whose requirements are not met are skipped.
+	Requires func(capabilities *iri.RuntimeCapabilities) bool
+	// Run runs the test. A non-nil error fails the test.
+	Run func(ctx context.Context, f *Framework) error
+}
+
+// Result is the result of running a single conformance test.
+type Result struct {
+	// Name is the name of the test.
+	Name string
+	// Skipped reports whether the test was skipped.
+	Skipped bool
+	// Err is the error the test failed with, if any.
+	Err error
+	// Duration is the time it took to run the test.
+	Duration time.Duration
+}
+
+// Failed reports whether the test failed.
+func (r Result) Failed() bool {
+	return r.Err != nil
+}
+
+// Framework gives conformance tests access to the runtime under test.
+type Framework struct {
+	// Client is the client to the runtime under test.
+	Client iri.RuntimeServiceClient
+	// Options are the options of the conformance run.
+	Options Options
+	// Capabilities are the capabilities reported by the runtime.
+	Capabilities *iri.RuntimeCapabilities
+
+	runID string
+}
+
+// Labels returns the labels identifying instances of the current conformance run.
+func (f *Framework) Labels() map[string]string {
+	return map[string]string{RunLabel: f.runID}
+}
+
+// NewInstance returns a new instance template labeled with the current conformance run.
+func (f *Framework) NewInstance() *iri.Instance {
+	var image *iri.ImageSpec
+	if f.Options.Image != "" {
+		image = &iri.ImageSpec{Image: f.Options.Image}
+	}
+
+	return &iri.Instance{
+		Metadata: &iri.ObjectMetadata{
+			Labels: f.Labels(),
+		},
+		Spec: &iri.InstanceSpec{
+			Power:       iri.Power_POWER_OFF,
+			Image:       image,
+			Type:        f.Options.InstanceType,
+			CpuCount:    f.Options.CPUCount,
+			MemoryBytes: f.Options.MemoryBytes,
+		},
+	}
+}
+
+// CreateInstance creates the given instance, making sure it carries the labels of the current conformance run.
+func (f *Framework) CreateInstance(ctx context.Context, inst *iri.Instance) (*iri.Instance, error) {
+	if inst.Metadata == nil {
+		inst.Metadata = &iri.ObjectMetadata{}
+	}
+	if inst.Metadata.Labels == nil {
+		inst.Metadata.Labels = make(map[string]string)
+	}
+	inst.Metadata.Labels[RunLabel] = f.runID
+
+	res, err := f.Client.CreateInstance(ctx, &iri.CreateInstanceRequest{Instance: inst})
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance: %w", err)
+	}
+	if res.Instance.GetMetadata().GetId() == "" {
+		return nil, fmt.Errorf("created instance has no id")
+	}
+	return res.Instance, nil
+}
+
+// GetInstance gets the instance with the given id. It returns nil if there is no such instance.
+func (f *Framework) GetInstance(ctx context.Context, id string) (*iri.Instance, error) {
+	res, err := f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{Id: id},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instances with id %s: %w", id, err)
+	}
+
+	switch len(res.Instances) {
+	case 0:
+		return nil, nil
+	case 1:
+		inst := res.Instances[0]
+		if actualID := inst.GetMetadata().GetId(); actualID != id {
+			return nil, fmt.Errorf("listing instances with id %s returned instance %s", id, actualID)
+		}
+		return inst, nil
+	default:
+		return nil, fmt.Errorf("listing instances with id %s returned %d instances", id, len(res.Instances))
+	}
+}
+
+// MustGetInstance gets the instance with the given id and fails if there is no such instance.
+func (f *Framework) MustGetInstance(ctx context.Context, id string) (*iri.Instance, error) {
+	inst, err := f.GetInstance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inst == nil {
+		return nil, fmt.Errorf("instance %s not found", id)
+	}
+	return inst, nil
+}
+
+// cleanup deletes all instances of the current conformance run.
+func (f *Framework) cleanup(ctx context.Context) error {
+	res, err := f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{LabelSelector: f.Labels()},
+	})
+	if err != nil {
+		return fmt.Errorf("error listing instances: %w", err)
+	}
+
+	var errs []error
+	for _, inst := range res.Instances {
+		id := inst.GetMetadata().GetId()
+		if _, err := f.Client.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: id}); err != nil && status.Code(err) != codes.NotFound {
+			errs = append(errs, fmt.Errorf("error deleting instance %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func generateRunID() string {
+	data := make([]byte, 8)
+	_, _ = rand.Read(data)
+	return hex.EncodeToString(data)
+}
+
+// Run runs the given tests against the runtime. If tests is nil, all Tests are run.
+// Every test runs with its own run id, and all instances created by a test are deleted after it.
+// The onResult callback, if set, is called after each test.
+func Run(ctx context.Context, client iri.RuntimeServiceClient, opts Options, tests []Test, onResult func(Result)) ([]Result, error) {
+	setOptionsDefaults(&opts)
+	if tests == nil {
+		tests = Tests
+	}
+
+	version, err := client.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting runtime version: %w", err)
+	}
+
+	var results []Result
+	for _, test := range tests {
+		if (opts.Focus != nil && !opts.Focus.MatchString(test.Name)) ||
+			(opts.Skip != nil && opts.Skip.MatchString(test.Name)) {
+			continue
+		}
+
+		f := &Framework{
+			Client:       client,
+			Options:      opts,
+			Capabilities: version.Capabilities,
+			runID:        generateRunID(),
+		}
+		res := runTest(ctx, f, test)
+		results = append(results, res)
+		if onResult != nil {
+			onResult(res)
+		}
+	}
+	return results, nil
+}
+
+func runTest(ctx context.Context, f *Framework, test Test) Result {
+	if test.Requires != nil && !test.Requires(f.Capabilities) {
+		return Result{Name: test.Name, Skipped: true}
+	}
+
+	testCtx, cancel := context.WithTimeout(ctx, f.Options.TestTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := test.Run(testCtx, f)
+
+	// Clean up with a fresh context so instances of timed out tests are still deleted.
+	cleanupCtx, cancelCleanup := context.WithTimeout(context.WithoutCancel(ctx), cleanupTimeout)
+	defer cancelCleanup()
+	if cleanupErr := f.cleanup(cleanupCtx); cleanupErr != nil {
+		err = errors.Join(err, fmt.Errorf("error cleaning up: %w", cleanupErr))
+	}
+	return Result{
+		Name:     test.Name,
+		Err:      err,
+		Duration: time.Since(start),
+	}
+}
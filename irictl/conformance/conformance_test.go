@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/irictl/conformance"
+	iriremote "spheric.cloud/spheric/spherelet/iri/remote"
+	"spheric.cloud/spheric/spherelet/iri/remote/fake"
+	. "spheric.cloud/spheric/utils/testing"
+)
+
+var _ = Describe("Conformance", func() {
+	var (
+		runtime *fake.FakeRuntimeService
+		client  iri.RuntimeServiceClient
+	)
+
+	BeforeEach(func() {
+		runtime = fake.NewFakeRuntimeService()
+		runtime.SetStatus(
+			&iri.RuntimeResources{CpuCount: 4, MemoryBytes: 8 * 1024 * 1024 * 1024},
+			&iri.RuntimeResources{CpuCount: 2, MemoryBytes: 4 * 1024 * 1024 * 1024},
+		)
+
+		dir := ShortSocketDir(GinkgoT())
+
+		grpcSrv := grpc.NewServer()
+		iri.RegisterRuntimeServiceServer(grpcSrv, iriremote.NewRuntimeServer(runtime))
+
+		socket := filepath.Join(dir, "iri.sock")
+		l, err := net.Listen("unix", socket)
+		Expect(err).NotTo(HaveOccurred())
+		go func() { _ = grpcSrv.Serve(l) }()
+		DeferCleanup(grpcSrv.Stop)
+
+		conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		client = iri.NewRuntimeServiceClient(conn)
+	})
+
+	It("should pass all tests against the fake runtime", func(ctx SpecContext) {
+		results, err := conformance.Run(ctx, client, conformance.Options{}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(HaveLen(len(conformance.Tests)))
+		for _, res := range results {
+			Expect(res.Skipped).To(BeFalse(), "test %q was skipped", res.Name)
+			Expect(res.Err).NotTo(HaveOccurred(), "test %q failed", res.Name)
+		}
+
+		By("checking all instances were cleaned up")
+		Expect(runtime.Instances).To(BeEmpty())
+	})
+
+	It("should skip tests the runtime lacks the capabilities for", func(ctx SpecContext) {
+		runtime.Capabilities = &iri.RuntimeCapabilities{}
+
+		results, err := conformance.Run(ctx, client, conformance.Options{}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ContainElement(SatisfyAll(
+			HaveField("Name", "instance disks are attached and detached"),
+			HaveField("Skipped", true),
+		)))
+		for _, res := range results {
+			Expect(res.Err).NotTo(HaveOccurred(), "test %q failed", res.Name)
+		}
+	})
+
+	It("should report runtimes violating the expected semantics", func(ctx SpecContext) {
+		results, err := conformance.Run(ctx, client, conformance.Options{}, []conformance.Test{
+			{
+				Name: "failing",
+				Run: func(ctx context.Context, f *conformance.Framework) error {
+					_, err := f.Client.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: "unknown"})
+					return err
+				},
+			},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ConsistOf(SatisfyAll(
+			HaveField("Name", "failing"),
+			HaveField("Err", HaveOccurred()),
+		)))
+	})
+
+	It("should clean up the instances of timed out tests", func(ctx SpecContext) {
+		results, err := conformance.Run(ctx, client, conformance.Options{TestTimeout: 100 * time.Millisecond}, []conformance.Test{
+			{
+				Name: "timing out",
+				Run: func(ctx context.Context, f *conformance.Framework) error {
+					if _, err := f.CreateInstance(ctx, f.NewInstance()); err != nil {
+						return err
+					}
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ConsistOf(SatisfyAll(
+			HaveField("Name", "timing out"),
+			HaveField("Err", MatchError(context.DeadlineExceeded)),
+		)))
+
+		By("checking all instances were cleaned up")
+		Expect(runtime.Instances).To(BeEmpty())
+	})
+})
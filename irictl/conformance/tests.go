@@ -0,0 +1,640 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+// unknownInstanceID is an instance id no runtime is expected to know.
+const unknownInstanceID = "conformance-unknown-instance"
+
+// pollInterval is the interval to poll for asynchronous changes in.
+const pollInterval = 500 * time.Millisecond
+
+// Tests are all conformance tests, in the order they are run.
+var Tests = []Test{
+	{Name: "version reports runtime name and version", Run: testVersion},
+	{Name: "status reports consistent resources", Run: testStatus},
+	{Name: "instance create preserves metadata and spec", Run: testCreateInstance},
+	{Name: "instance list filters by id", Run: testListInstancesByID},
+	{Name: "instance list filters by label selector", Run: testListInstancesByLabelSelector},
+	{Name: "instance list filters by label requirements and state", Run: testListInstancesByRequirementsAndState},
+	{Name: "instance list pages through results", Run: testListInstancesPaginated},
+	{Name: "instance annotations are replaced", Run: testUpdateInstanceAnnotations},
+	{Name: "instance power is updated", Run: testUpdateInstancePower},
+	{
+		Name:     "instance disks are attached and detached",
+		Requires: func(c *iri.RuntimeCapabilities) bool { return c.GetHotplugDisks() },
+		Run:      testAttachDetachDisk,
+	},
+	{
+		Name:     "instance network interfaces are attached and detached",
+		Requires: func(c *iri.RuntimeCapabilities) bool { return c.GetHotplugNetworkInterfaces() },
+		Run:      testAttachDetachNetworkInterface,
+	},
+	{
+		Name:     "instance reboots require power on",
+		Requires: func(c *iri.RuntimeCapabilities) bool { return c.GetReboot() },
+		Run:      testRebootInstance,
+	},
+	{
+		Name:     "instance resources are updated",
+		Requires: func(c *iri.RuntimeCapabilities) bool { return c.GetResize() },
+		Run:      testUpdateInstanceResources,
+	},
+	{
+		Name:     "instance watch reports initial sync and additions",
+		Requires: func(c *iri.RuntimeCapabilities) bool { return c.GetWatchInstances() },
+		Run:      testWatchInstances,
+	},
+	{Name: "instance delete removes the instance", Run: testDeleteInstance},
+	{Name: "unknown instances are reported as not found", Run: testUnknownInstance},
+}
+
+func expectCode(err error, code codes.Code) error {
+	if actual := status.Code(err); actual != code {
+		return fmt.Errorf("expected status code %s but got %s (error: %v)", code, actual, err)
+	}
+	return nil
+}
+
+func testVersion(ctx context.Context, f *Framework) error {
+	res, err := f.Client.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return fmt.Errorf("error getting version: %w", err)
+	}
+	if res.RuntimeName == "" {
+		return fmt.Errorf("runtime name is empty")
+	}
+	if res.RuntimeVersion == "" {
+		return fmt.Errorf("runtime version is empty")
+	}
+	return nil
+}
+
+func testStatus(ctx context.Context, f *Framework) error {
+	res, err := f.Client.Status(ctx, &iri.StatusRequest{})
+	if err != nil {
+		return fmt.Errorf("error getting status: %w", err)
+	}
+
+	capacity, allocatable := res.Capacity, res.Allocatable
+	if capacity == nil || allocatable == nil {
+		return nil
+	}
+	if allocatable.CpuCount > capacity.CpuCount {
+		return fmt.Errorf("allocatable cpu count %d exceeds capacity %d", allocatable.CpuCount, capacity.CpuCount)
+	}
+	if allocatable.MemoryBytes > capacity.MemoryBytes {
+		return fmt.Errorf("allocatable memory %d exceeds capacity %d", allocatable.MemoryBytes, capacity.MemoryBytes)
+	}
+	return nil
+}
+
+func testCreateInstance(ctx context.Context, f *Framework) error {
+	inst := f.NewInstance()
+	inst.Metadata.Labels["conformance.spheric.cloud/label"] = "value"
+	inst.Metadata.Annotations = map[string]string{"conformance.spheric.cloud/annotation": "value"}
+
+	created, err := f.CreateInstance(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	actual, err := f.MustGetInstance(ctx, created.Metadata.Id)
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(actual.Metadata.Labels, inst.Metadata.Labels) {
+		return fmt.Errorf("expected labels %v but got %v", inst.Metadata.Labels, actual.Metadata.Labels)
+	}
+	if !maps.Equal(actual.Metadata.Annotations, inst.Metadata.Annotations) {
+		return fmt.Errorf("expected annotations %v but got %v", inst.Metadata.Annotations, actual.Metadata.Annotations)
+	}
+	if actual.Metadata.CreatedAt == 0 {
+		return fmt.Errorf("instance has no creation timestamp")
+	}
+	if actual.Spec.GetCpuCount() != inst.Spec.CpuCount {
+		return fmt.Errorf("expected cpu count %d but got %d", inst.Spec.CpuCount, actual.Spec.GetCpuCount())
+	}
+	if actual.Spec.GetMemoryBytes() != inst.Spec.MemoryBytes {
+		return fmt.Errorf("expected memory %d but got %d", inst.Spec.MemoryBytes, actual.Spec.GetMemoryBytes())
+	}
+	if actual.Spec.GetPower() != inst.Spec.Power {
+		return fmt.Errorf("expected power %s but got %s", inst.Spec.Power, actual.Spec.GetPower())
+	}
+	return nil
+}
+
+func testListInstancesByID(ctx context.Context, f *Framework) error {
+	first, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	if _, err := f.CreateInstance(ctx, f.NewInstance()); err != nil {
+		return err
+	}
+
+	if _, err := f.MustGetInstance(ctx, first.Metadata.Id); err != nil {
+		return err
+	}
+
+	unknown, err := f.GetInstance(ctx, unknownInstanceID)
+	if err != nil {
+		return err
+	}
+	if unknown != nil {
+		return fmt.Errorf("listing instances with unknown id returned instance %s", unknown.Metadata.Id)
+	}
+	return nil
+}
+
+func listInstanceIDs(ctx context.Context, f *Framework, selector map[string]string) ([]string, error) {
+	res, err := f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{LabelSelector: selector},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instances by label selector %v: %w", selector, err)
+	}
+
+	ids := make([]string, 0, len(res.Instances))
+	for _, inst := range res.Instances {
+		ids = append(ids, inst.GetMetadata().GetId())
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func testListInstancesByLabelSelector(ctx context.Context, f *Framework) error {
+	const key = "conformance.spheric.cloud/selector"
+
+	fooInst := f.NewInstance()
+	fooInst.Metadata.Labels[key] = "foo"
+	foo, err := f.CreateInstance(ctx, fooInst)
+	if err != nil {
+		return err
+	}
+
+	barInst := f.NewInstance()
+	barInst.Metadata.Labels[key] = "bar"
+	bar, err := f.CreateInstance(ctx, barInst)
+	if err != nil {
+		return err
+	}
+
+	selector := f.Labels()
+	selector[key] = "foo"
+	ids, err := listInstanceIDs(ctx, f, selector)
+	if err != nil {
+		return err
+	}
+	if expected := []string{foo.Metadata.Id}; !slices.Equal(ids, expected) {
+		return fmt.Errorf("expected instances %v for selector %v but got %v", expected, selector, ids)
+	}
+
+	ids, err = listInstanceIDs(ctx, f, f.Labels())
+	if err != nil {
+		return err
+	}
+	expected := []string{foo.Metadata.Id, bar.Metadata.Id}
+	slices.Sort(expected)
+	if !slices.Equal(ids, expected) {
+		return fmt.Errorf("expected instances %v for selector %v but got %v", expected, f.Labels(), ids)
+	}
+	return nil
+}
+
+func testListInstancesByRequirementsAndState(ctx context.Context, f *Framework) error {
+	const key = "conformance.spheric.cloud/requirement"
+
+	var ids []string
+	for _, value := range []string{"foo", "bar", "baz"} {
+		inst := f.NewInstance()
+		inst.Metadata.Labels[key] = value
+		created, err := f.CreateInstance(ctx, inst)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, created.Metadata.Id)
+	}
+
+	filter := &iri.InstanceFilter{
+		LabelSelector: f.Labels(),
+		LabelSelectorRequirements: []*iri.LabelSelectorRequirement{{
+			Key:      key,
+			Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_NOT_IN,
+			Values:   []string{"bar"},
+		}},
+	}
+	res, err := f.Client.ListInstances(ctx, &iri.ListInstancesRequest{Filter: filter})
+	if err != nil {
+		return fmt.Errorf("error listing instances by label requirements: %w", err)
+	}
+	actual := make([]string, 0, len(res.Instances))
+	for _, inst := range res.Instances {
+		actual = append(actual, inst.GetMetadata().GetId())
+	}
+	slices.Sort(actual)
+	expected := []string{ids[0], ids[2]}
+	slices.Sort(expected)
+	if !slices.Equal(actual, expected) {
+		return fmt.Errorf("expected instances %v for label requirements but got %v", expected, actual)
+	}
+
+	// Instances are powered off, so none of them may be running.
+	res, err = f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{
+			LabelSelector: f.Labels(),
+			States:        []iri.InstanceState{iri.InstanceState_INSTANCE_RUNNING},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error listing instances by state: %w", err)
+	}
+	if len(res.Instances) > 0 {
+		return fmt.Errorf("expected no running instances but got %d", len(res.Instances))
+	}
+
+	_, err = f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{
+			LabelSelectorRequirements: []*iri.LabelSelectorRequirement{{
+				Key:      key,
+				Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+			}},
+		},
+	})
+	return expectCode(err, codes.InvalidArgument)
+}
+
+func testListInstancesPaginated(ctx context.Context, f *Framework) error {
+	const noOfInstances = 5
+
+	var expected []string
+	for range noOfInstances {
+		created, err := f.CreateInstance(ctx, f.NewInstance())
+		if err != nil {
+			return err
+		}
+		expected = append(expected, created.Metadata.Id)
+	}
+
+	var (
+		actual    []string
+		pageToken string
+	)
+	for page := 0; ; page++ {
+		if page > noOfInstances {
+			return fmt.Errorf("listing did not terminate after %d pages", page)
+		}
+
+		res, err := f.Client.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter:    &iri.InstanceFilter{LabelSelector: f.Labels()},
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing instances page %d: %w", page, err)
+		}
+		if len(res.Instances) > 2 {
+			return fmt.Errorf("expected at most 2 instances on page %d but got %d", page, len(res.Instances))
+		}
+		for _, inst := range res.Instances {
+			actual = append(actual, inst.GetMetadata().GetId())
+		}
+
+		if res.NextPageToken == "" {
+			break
+		}
+		pageToken = res.NextPageToken
+	}
+
+	slices.Sort(expected)
+	slices.Sort(actual)
+	if !slices.Equal(actual, expected) {
+		return fmt.Errorf("expected paged instances %v but got %v", expected, actual)
+	}
+	return nil
+}
+
+func testUpdateInstanceAnnotations(ctx context.Context, f *Framework) error {
+	inst := f.NewInstance()
+	inst.Metadata.Annotations = map[string]string{"conformance.spheric.cloud/old": "value"}
+	created, err := f.CreateInstance(ctx, inst)
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	annotations := map[string]string{"conformance.spheric.cloud/new": "value"}
+	if _, err := f.Client.UpdateInstanceAnnotations(ctx, &iri.UpdateInstanceAnnotationsRequest{
+		InstanceId:  id,
+		Annotations: annotations,
+	}); err != nil {
+		return fmt.Errorf("error updating instance annotations: %w", err)
+	}
+
+	actual, err := f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(actual.Metadata.Annotations, annotations) {
+		return fmt.Errorf("expected annotations %v but got %v", annotations, actual.Metadata.Annotations)
+	}
+	return nil
+}
+
+func testUpdateInstancePower(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	for _, power := range []iri.Power{iri.Power_POWER_ON, iri.Power_POWER_OFF} {
+		if _, err := f.Client.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+			InstanceId: id,
+			Power:      power,
+		}); err != nil {
+			return fmt.Errorf("error updating instance power to %s: %w", power, err)
+		}
+
+		actual, err := f.MustGetInstance(ctx, id)
+		if err != nil {
+			return err
+		}
+		if actual.Spec.GetPower() != power {
+			return fmt.Errorf("expected power %s but got %s", power, actual.Spec.GetPower())
+		}
+	}
+	return nil
+}
+
+func testAttachDetachDisk(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	const name = "conformance"
+	if _, err := f.Client.AttachDisk(ctx, &iri.AttachDiskRequest{
+		InstanceId: id,
+		Disk: &iri.Disk{
+			Name:      name,
+			Device:    "oda",
+			EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024 * 1024 * 1024},
+		},
+	}); err != nil {
+		return fmt.Errorf("error attaching disk: %w", err)
+	}
+
+	actual, err := f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(actual.Spec.GetDisks(), func(disk *iri.Disk) bool { return disk.Name == name }) {
+		return fmt.Errorf("attached disk %s is not in instance spec", name)
+	}
+
+	if _, err := f.Client.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: id, Name: name}); err != nil {
+		return fmt.Errorf("error detaching disk: %w", err)
+	}
+
+	actual, err = f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(actual.Spec.GetDisks(), func(disk *iri.Disk) bool { return disk.Name == name }) {
+		return fmt.Errorf("detached disk %s is still in instance spec", name)
+	}
+
+	_, err = f.Client.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: id, Name: name})
+	if err := expectCode(err, codes.NotFound); err != nil {
+		return fmt.Errorf("detaching a detached disk: %w", err)
+	}
+	return nil
+}
+
+func testAttachDetachNetworkInterface(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	const name = "conformance"
+	if _, err := f.Client.AttachNetworkInterface(ctx, &iri.AttachNetworkInterfaceRequest{
+		InstanceId: id,
+		NetworkInterface: &iri.NetworkInterface{
+			Name: name,
+			Ips:  []string{"10.0.0.1"},
+		},
+	}); err != nil {
+		return fmt.Errorf("error attaching network interface: %w", err)
+	}
+
+	hasNIC := func(nic *iri.NetworkInterface) bool { return nic.Name == name }
+
+	actual, err := f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(actual.Spec.GetNetworkInterfaces(), hasNIC) {
+		return fmt.Errorf("attached network interface %s is not in instance spec", name)
+	}
+
+	if _, err := f.Client.DetachNetworkInterface(ctx, &iri.DetachNetworkInterfaceRequest{InstanceId: id, Name: name}); err != nil {
+		return fmt.Errorf("error detaching network interface: %w", err)
+	}
+
+	actual, err = f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(actual.Spec.GetNetworkInterfaces(), hasNIC) {
+		return fmt.Errorf("detached network interface %s is still in instance spec", name)
+	}
+
+	_, err = f.Client.DetachNetworkInterface(ctx, &iri.DetachNetworkInterfaceRequest{InstanceId: id, Name: name})
+	if err := expectCode(err, codes.NotFound); err != nil {
+		return fmt.Errorf("detaching a detached network interface: %w", err)
+	}
+	return nil
+}
+
+func testRebootInstance(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	_, err = f.Client.RebootInstance(ctx, &iri.RebootInstanceRequest{InstanceId: id})
+	if err := expectCode(err, codes.FailedPrecondition); err != nil {
+		return fmt.Errorf("rebooting a powered off instance: %w", err)
+	}
+
+	if _, err := f.Client.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+		InstanceId: id,
+		Power:      iri.Power_POWER_ON,
+	}); err != nil {
+		return fmt.Errorf("error powering on instance: %w", err)
+	}
+
+	if _, err := f.Client.RebootInstance(ctx, &iri.RebootInstanceRequest{InstanceId: id}); err != nil {
+		return fmt.Errorf("error rebooting instance: %w", err)
+	}
+	return nil
+}
+
+func testUpdateInstanceResources(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	cpuCount, memoryBytes := created.Spec.CpuCount, created.Spec.MemoryBytes
+	if _, err := f.Client.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{
+		InstanceId:  id,
+		Type:        created.Spec.Type,
+		CpuCount:    cpuCount,
+		MemoryBytes: memoryBytes,
+	}); err != nil {
+		return fmt.Errorf("error updating instance resources: %w", err)
+	}
+
+	actual, err := f.MustGetInstance(ctx, id)
+	if err != nil {
+		return err
+	}
+	if actual.Spec.CpuCount != cpuCount || actual.Spec.MemoryBytes != memoryBytes {
+		return fmt.Errorf("expected %d cpus and %d bytes of memory but got %d cpus and %d bytes of memory",
+			cpuCount, memoryBytes, actual.Spec.CpuCount, actual.Spec.MemoryBytes)
+	}
+
+	_, err = f.Client.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{InstanceId: id, Type: created.Spec.Type})
+	if err := expectCode(err, codes.InvalidArgument); err != nil {
+		return fmt.Errorf("updating instance resources to zero: %w", err)
+	}
+	return nil
+}
+
+func testWatchInstances(ctx context.Context, f *Framework) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := f.Client.WatchInstances(ctx, &iri.WatchInstancesRequest{
+		Filter: &iri.InstanceFilter{LabelSelector: f.Labels()},
+	})
+	if err != nil {
+		return fmt.Errorf("error watching instances: %w", err)
+	}
+
+	evt, err := w.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving initial event: %w", err)
+	}
+	if evt.Type != iri.WatchEventType_WATCH_EVENT_SYNCED {
+		return fmt.Errorf("expected initial event %s but got %s", iri.WatchEventType_WATCH_EVENT_SYNCED, evt.Type)
+	}
+
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+
+	evt, err = w.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving event: %w", err)
+	}
+	if evt.Type != iri.WatchEventType_WATCH_EVENT_ADDED {
+		return fmt.Errorf("expected event %s but got %s", iri.WatchEventType_WATCH_EVENT_ADDED, evt.Type)
+	}
+	if actualID := evt.Instance.GetMetadata().GetId(); actualID != created.Metadata.Id {
+		return fmt.Errorf("expected added instance %s but got %s", created.Metadata.Id, actualID)
+	}
+	return nil
+}
+
+func testDeleteInstance(ctx context.Context, f *Framework) error {
+	created, err := f.CreateInstance(ctx, f.NewInstance())
+	if err != nil {
+		return err
+	}
+	id := created.Metadata.Id
+
+	if _, err := f.Client.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: id}); err != nil {
+		return fmt.Errorf("error deleting instance: %w", err)
+	}
+
+	// Runtimes may delete asynchronously, but have to mark the instance as deleted until they are done.
+	for {
+		inst, err := f.GetInstance(ctx, id)
+		if err != nil {
+			return err
+		}
+		if inst == nil {
+			break
+		}
+		if inst.Metadata.DeletedAt == 0 {
+			return fmt.Errorf("deleted instance is not marked as deleted")
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("instance was not removed: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+
+	_, err = f.Client.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: id})
+	if err := expectCode(err, codes.NotFound); err != nil {
+		return fmt.Errorf("deleting a removed instance: %w", err)
+	}
+	return nil
+}
+
+func testUnknownInstance(ctx context.Context, f *Framework) error {
+	calls := map[string]func() error{
+		"DeleteInstance": func() error {
+			_, err := f.Client.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: unknownInstanceID})
+			return err
+		},
+		"UpdateInstanceAnnotations": func() error {
+			_, err := f.Client.UpdateInstanceAnnotations(ctx, &iri.UpdateInstanceAnnotationsRequest{InstanceId: unknownInstanceID})
+			return err
+		},
+		"UpdateInstancePower": func() error {
+			_, err := f.Client.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{InstanceId: unknownInstanceID})
+			return err
+		},
+		"DetachDisk": func() error {
+			_, err := f.Client.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: unknownInstanceID, Name: "conformance"})
+			return err
+		},
+		"DetachNetworkInterface": func() error {
+			_, err := f.Client.DetachNetworkInterface(ctx, &iri.DetachNetworkInterfaceRequest{InstanceId: unknownInstanceID, Name: "conformance"})
+			return err
+		},
+	}
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(calls)) {
+		if err := expectCode(calls[name](), codes.NotFound); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tableconverters
+
+import (
+	"fmt"
+	"strings"
+
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/irictl/api"
+	"spheric.cloud/spheric/irictl/tableconverter"
+)
+
+var (
+	imageHeaders = []api.Header{
+		{Name: "ID"},
+		{Name: "Names"},
+		{Name: "Size"},
+		{Name: "In Use"},
+	}
+
+	imageFsInfoHeaders = []api.Header{
+		{Name: "Used"},
+		{Name: "Capacity"},
+		{Name: "Available"},
+		{Name: "Usage"},
+	}
+)
+
+func shortImageID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+var (
+	Image = tableconverter.Funcs[*iri.Image]{
+		Headers: tableconverter.Headers(imageHeaders),
+		Rows: tableconverter.SingleRowFrom(func(image *iri.Image) (api.Row, error) {
+			return api.Row{
+				shortImageID(image.Id),
+				strings.Join(image.Names, ","),
+				formatBytes(image.SizeBytes),
+				image.InUse,
+			}, nil
+		}),
+	}
+	ImageSlice = tableconverter.SliceFuncs[*iri.Image](Image)
+
+	ImageFsInfo = tableconverter.Funcs[*iri.ImageFsInfoResponse]{
+		Headers: tableconverter.Headers(imageFsInfoHeaders),
+		Rows: tableconverter.SingleRowFrom(func(info *iri.ImageFsInfoResponse) (api.Row, error) {
+			var usage string
+			if info.CapacityBytes > 0 {
+				usage = fmt.Sprintf("%d%%", (info.CapacityBytes-info.AvailableBytes)*100/info.CapacityBytes)
+			}
+			return api.Row{
+				formatBytes(info.UsedBytes),
+				formatBytes(info.CapacityBytes),
+				formatBytes(info.AvailableBytes),
+				usage,
+			}, nil
+		}),
+	}
+)
+
+func init() {
+	RegistryBuilder.Register(
+		tableconverter.ToTagAndTypedAny[*iri.Image](Image),
+		tableconverter.ToTagAndTypedAny[[]*iri.Image](ImageSlice),
+		tableconverter.ToTagAndTypedAny[*iri.ImageFsInfoResponse](ImageFsInfo),
+	)
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tableconverters
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/irictl/api"
+	"spheric.cloud/spheric/irictl/tableconverter"
+)
+
+var (
+	instanceStatsHeaders = []api.Header{
+		{Name: "ID"},
+		{Name: "CPU Time"},
+		{Name: "Memory"},
+		{Name: "Disk Read"},
+		{Name: "Disk Write"},
+		{Name: "Net RX"},
+		{Name: "Net TX"},
+	}
+)
+
+func formatBytes(bytes uint64) string {
+	return resource.NewQuantity(int64(bytes), resource.BinarySI).String()
+}
+
+var (
+	InstanceStats = tableconverter.Funcs[*iri.InstanceStats]{
+		Headers: tableconverter.Headers(instanceStatsHeaders),
+		Rows: tableconverter.SingleRowFrom(func(stats *iri.InstanceStats) (api.Row, error) {
+			var diskRead, diskWrite uint64
+			for _, disk := range stats.Disks {
+				diskRead += disk.ReadBytes
+				diskWrite += disk.WriteBytes
+			}
+
+			var rx, tx uint64
+			for _, nic := range stats.NetworkInterfaces {
+				rx += nic.RxBytes
+				tx += nic.TxBytes
+			}
+
+			return api.Row{
+				stats.InstanceId,
+				time.Duration(stats.GetCpu().GetUsageCoreNanoseconds()).Round(time.Millisecond).String(),
+				fmt.Sprintf("%s/%s", formatBytes(stats.GetMemory().GetUsageBytes()), formatBytes(stats.GetMemory().GetAvailableBytes())),
+				formatBytes(diskRead),
+				formatBytes(diskWrite),
+				formatBytes(rx),
+				formatBytes(tx),
+			}, nil
+		}),
+	}
+	InstanceStatsSlice = tableconverter.SliceFuncs[*iri.InstanceStats](InstanceStats)
+)
+
+func init() {
+	RegistryBuilder.Register(
+		tableconverter.ToTagAndTypedAny[*iri.InstanceStats](InstanceStats),
+		tableconverter.ToTagAndTypedAny[[]*iri.InstanceStats](InstanceStatsSlice),
+	)
+}
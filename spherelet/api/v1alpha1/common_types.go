@@ -17,6 +17,8 @@ const (
 
 	InstanceGenerationAnnotation    = "spherelet.spheric.cloud/instance-generation"
 	IRIInstanceGenerationAnnotation = "spherelet.spheric.cloud/iriinstance-generation"
+	// RestartGenerationAnnotation records the last restart generation of an instance that was carried out.
+	RestartGenerationAnnotation = "spherelet.spheric.cloud/restart-generation"
 
 	FieldOwner        = "spherelet.spheric.cloud/field-owner"
 	InstanceFinalizer = "spherelet.spheric.cloud/instance"
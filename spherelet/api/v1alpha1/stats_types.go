@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstanceStats are the resource usage statistics of an instance, as served by the stats endpoint of the spherelet.
+type InstanceStats struct {
+	// Time is the time the statistics were collected at.
+	Time metav1.Time `json:"time"`
+	// CPU are the cpu statistics of the instance.
+	CPU *CPUStats `json:"cpu,omitempty"`
+	// Memory are the memory statistics of the instance.
+	Memory *MemoryStats `json:"memory,omitempty"`
+	// Disks are the statistics of the disks of the instance.
+	Disks []DiskStats `json:"disks,omitempty"`
+	// NetworkInterfaces are the statistics of the network interfaces of the instance.
+	NetworkInterfaces []NetworkInterfaceStats `json:"networkInterfaces,omitempty"`
+}
+
+type CPUStats struct {
+	// UsageCoreNanoSeconds is the cumulative cpu time consumed by the instance.
+	UsageCoreNanoSeconds uint64 `json:"usageCoreNanoSeconds"`
+}
+
+type MemoryStats struct {
+	// UsageBytes is the host memory in use by the instance.
+	UsageBytes uint64 `json:"usageBytes"`
+	// AvailableBytes is the memory available to the instance.
+	AvailableBytes uint64 `json:"availableBytes"`
+}
+
+type DiskStats struct {
+	Name       string `json:"name"`
+	ReadBytes  uint64 `json:"readBytes"`
+	WriteBytes uint64 `json:"writeBytes"`
+	ReadOps    uint64 `json:"readOps"`
+	WriteOps   uint64 `json:"writeOps"`
+}
+
+type NetworkInterfaceStats struct {
+	Name      string `json:"name"`
+	RxBytes   uint64 `json:"rxBytes"`
+	RxPackets uint64 `json:"rxPackets"`
+	TxBytes   uint64 `json:"txBytes"`
+	TxPackets uint64 `json:"txPackets"`
+}
@@ -39,8 +39,11 @@ import (
 	sphereletclient "spheric.cloud/spheric/spherelet/client"
 	sphereletclientconfig "spheric.cloud/spheric/spherelet/client/config"
 	"spheric.cloud/spheric/spherelet/controllers"
+	"spheric.cloud/spheric/spherelet/imagegc"
+	iriinstance "spheric.cloud/spheric/spherelet/instance"
 	"spheric.cloud/spheric/spherelet/server"
 	"spheric.cloud/spheric/utils/client/config"
+	utilgrpc "spheric.cloud/spheric/utils/grpc"
 )
 
 var (
@@ -66,9 +69,16 @@ type Options struct {
 	ProviderID                            string
 	InstanceRuntimeEndpoint               string
 	InstanceRuntimeSocketDiscoveryTimeout time.Duration
+	InstanceRuntimeSecurity               utilgrpc.ClientSecurityOptions
 	DialTimeout                           time.Duration
 	InstanceTypeMapperSyncTimeout         time.Duration
 
+	ImagePrePull                bool
+	ImageGCHighThresholdPercent int
+	ImageGCLowThresholdPercent  int
+	ImageMinimumGCAge           time.Duration
+	ImageGCPeriod               time.Duration
+
 	ServerFlags server.Flags
 
 	AddressesOptions addresses.GetOptions
@@ -92,8 +102,17 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ProviderID, "provider-id", "", "Provider id to announce on the instance pool.")
 	fs.StringVar(&o.InstanceRuntimeEndpoint, "instance-runtime-endpoint", o.InstanceRuntimeEndpoint, "Endpoint of the remote instance runtime service.")
 	fs.DurationVar(&o.InstanceRuntimeSocketDiscoveryTimeout, "instance-runtime-socket-discovery-timeout", 20*time.Second, "Timeout for discovering the instance runtime socket.")
+	o.InstanceRuntimeSecurity.BindFlags(fs, "instance-runtime-")
 	fs.DurationVar(&o.DialTimeout, "dial-timeout", 1*time.Second, "Timeout for dialing to the instance runtime endpoint.")
 
+	fs.BoolVar(&o.ImagePrePull, "image-pre-pull", true, "Pull images of scheduled instances before creating them.")
+	fs.IntVar(&o.ImageGCHighThresholdPercent, "image-gc-high-threshold-percent", imagegc.DefaultHighThresholdPercent,
+		"Image filesystem usage percent above which unused images are garbage collected.")
+	fs.IntVar(&o.ImageGCLowThresholdPercent, "image-gc-low-threshold-percent", imagegc.DefaultLowThresholdPercent,
+		"Image filesystem usage percent image garbage collection frees down to.")
+	fs.DurationVar(&o.ImageMinimumGCAge, "image-minimum-gc-age", imagegc.DefaultMinAge, "Minimum age of unused images before they are garbage collected.")
+	fs.DurationVar(&o.ImageGCPeriod, "image-gc-period", imagegc.DefaultPeriod, "Period in which image garbage collection runs.")
+
 	o.ServerFlags.BindFlags(fs)
 
 	o.AddressesOptions.BindFlags(fs)
@@ -184,10 +203,19 @@ func Run(ctx context.Context, opts Options) error {
 
 	setupLog.V(1).Info("Discovered addresses to report", "FleetAddresses", fleetAddresses)
 
-	instanceRuntime, err := remote.NewRemoteRuntime(endpoint)
+	dialOpts, err := opts.InstanceRuntimeSecurity.DialOptions()
+	if err != nil {
+		return fmt.Errorf("error configuring instance runtime connection security: %w", err)
+	}
+
+	conn, err := remote.Dial(endpoint, dialOpts...)
 	if err != nil {
-		return fmt.Errorf("error creating remote instance runtime: %w", err)
+		return fmt.Errorf("error connecting to instance runtime: %w", err)
 	}
+	defer func() { _ = conn.Close() }()
+
+	instanceRuntime := remote.NewRemoteRuntimeFromConn(conn)
+	imageService := remote.NewRemoteImageServiceFromConn(conn)
 
 	cfg, configCtrl, err := getter.GetConfig(ctx, &opts.GetConfigOptions)
 	if err != nil {
@@ -233,6 +261,26 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error getting instance runtime version: %w", err)
 	}
 
+	imagesSupported := version.GetCapabilities().GetImages()
+	if imagesSupported {
+		gc, err := imagegc.NewGarbageCollector(imageService, imagegc.Options{
+			Policy: imagegc.Policy{
+				HighThresholdPercent: opts.ImageGCHighThresholdPercent,
+				LowThresholdPercent:  opts.ImageGCLowThresholdPercent,
+				MinAge:               opts.ImageMinimumGCAge,
+			},
+			Period: opts.ImageGCPeriod,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating image garbage collector: %w", err)
+		}
+		if err := mgr.Add(gc); err != nil {
+			return fmt.Errorf("error adding image garbage collector: %w", err)
+		}
+	} else {
+		setupLog.Info("Instance runtime does not support images, disabling image pre-pulling and garbage collection")
+	}
+
 	srvOpts := opts.ServerFlags.ServerOptions(
 		opts.FleetName,
 		instanceRuntime,
@@ -247,13 +295,18 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error adding spherelet server to manager: %w", err)
 	}
 
+	var watchInstances instanceevent.WatchFunc
+	if version.GetCapabilities().GetWatchInstances() {
+		watchInstances = instanceRuntime.WatchInstances
+	} else {
+		setupLog.Info("Instance runtime does not support watching instances, relisting instances")
+	}
+
 	instanceEvents := instanceevent.NewGenerator(func(ctx context.Context) ([]*iri.Instance, error) {
-		res, err := instanceRuntime.ListInstances(ctx, &iri.ListInstancesRequest{})
-		if err != nil {
-			return nil, err
-		}
-		return res.Instances, nil
-	}, instanceevent.GeneratorOptions{})
+		return iriinstance.ListAllInstances(ctx, instanceRuntime, nil)
+	}, instanceevent.GeneratorOptions{
+		Watch: watchInstances,
+	})
 	if err := mgr.Add(instanceEvents); err != nil {
 		return fmt.Errorf("error adding instance event generator: %w", err)
 	}
@@ -302,6 +355,19 @@ func Run(ctx context.Context, opts Options) error {
 			return fmt.Errorf("error setting up instance reconciler with manager: %w", err)
 		}
 
+		if imagesSupported && opts.ImagePrePull {
+			if err := (&controllers.ImagePullReconciler{
+				EventRecorder:    mgr.GetEventRecorderFor("images"),
+				Client:           mgr.GetClient(),
+				InstanceRuntime:  instanceRuntime,
+				ImageService:     imageService,
+				FleetName:        opts.FleetName,
+				WatchFilterValue: opts.WatchFilterValue,
+			}).SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("error setting up image pull reconciler with manager: %w", err)
+			}
+		}
+
 		if err := (&controllers.InstanceAnnotatorReconciler{
 			Client:         mgr.GetClient(),
 			InstanceEvents: instanceEvents,
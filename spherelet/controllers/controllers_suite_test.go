@@ -50,6 +50,7 @@ var (
 	testEnvExt *utilsenvtest.EnvironmentExtensions
 	k8sClient  = NewClientPromise()
 	srv        *fake.FakeRuntimeService
+	images     *fake.FakeImageService
 )
 
 const (
@@ -151,6 +152,7 @@ var _ = BeforeSuite(func() {
 
 	onInitialized := func() {
 		srv = fake.NewFakeRuntimeService()
+		images = fake.NewFakeImageService(srv)
 
 		Expect((&controllers.InstanceReconciler{
 			EventRecorder:          &record.LogRecorder{Logger: GinkgoLogr},
@@ -164,13 +166,23 @@ var _ = BeforeSuite(func() {
 			},
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
+		Expect((&controllers.ImagePullReconciler{
+			EventRecorder:   &record.LogRecorder{Logger: GinkgoLogr},
+			Client:          k8sManager.GetClient(),
+			InstanceRuntime: srv,
+			ImageService:    images,
+			FleetName:       fleetName,
+		}).SetupWithManager(k8sManager)).To(Succeed())
+
 		instanceEvents := instanceevent.NewGenerator(func(ctx context.Context) ([]*iri.Instance, error) {
 			res, err := srv.ListInstances(ctx, &iri.ListInstancesRequest{})
 			if err != nil {
 				return nil, err
 			}
 			return res.Instances, nil
-		}, instanceevent.GeneratorOptions{})
+		}, instanceevent.GeneratorOptions{
+			Watch: srv.WatchInstances,
+		})
 
 		Expect(k8sManager.Add(instanceEvents)).To(Succeed())
 
@@ -8,4 +8,7 @@ const (
 	NetworkInterfaceNotReady = "NetworkInterfaceNotReady"
 	DiskNotReady             = "DiskNotReady"
 	IgnitionNotReady         = "IgnitionNotReady"
+
+	PulledImage     = "PulledImage"
+	FailedPullImage = "FailedPullImage"
 )
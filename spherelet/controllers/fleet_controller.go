@@ -90,17 +90,63 @@ func (r *FleetReconciler) calculateCapacity(
 	return capacity, allocatable, nil
 }
 
+func getFleetCapabilities(iriCapabilities *iri.RuntimeCapabilities) []corev1alpha1.FleetCapability {
+	var res []corev1alpha1.FleetCapability
+	for _, c := range []struct {
+		supported  bool
+		capability corev1alpha1.FleetCapability
+	}{
+		{iriCapabilities.GetHotplugDisks(), corev1alpha1.FleetCapabilityHotplugDisks},
+		{iriCapabilities.GetHotplugNetworkInterfaces(), corev1alpha1.FleetCapabilityHotplugNetworkInterfaces},
+		{iriCapabilities.GetWatchInstances(), corev1alpha1.FleetCapabilityWatchInstances},
+		{iriCapabilities.GetInstanceStats(), corev1alpha1.FleetCapabilityInstanceStats},
+		{iriCapabilities.GetExec(), corev1alpha1.FleetCapabilityExec},
+		{iriCapabilities.GetReboot(), corev1alpha1.FleetCapabilityReboot},
+		{iriCapabilities.GetSuspend(), corev1alpha1.FleetCapabilitySuspend},
+		{iriCapabilities.GetImages(), corev1alpha1.FleetCapabilityImages},
+		{iriCapabilities.GetPortForward(), corev1alpha1.FleetCapabilityPortForward},
+		{iriCapabilities.GetConsoleLog(), corev1alpha1.FleetCapabilityConsoleLog},
+		{iriCapabilities.GetResize(), corev1alpha1.FleetCapabilityResize},
+	} {
+		if c.supported {
+			res = append(res, c.capability)
+		}
+	}
+	return res
+}
+
+func (r *FleetReconciler) getRuntimeInfo(ctx context.Context, log logr.Logger) (*corev1alpha1.FleetRuntimeInfo, error) {
+	log.V(1).Info("Determining runtime version and capabilities")
+
+	res, err := r.InstanceRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting instance runtime version: %w", err)
+	}
+
+	return &corev1alpha1.FleetRuntimeInfo{
+		RuntimeName:    res.RuntimeName,
+		RuntimeVersion: res.RuntimeVersion,
+		Capabilities:   getFleetCapabilities(res.Capabilities),
+	}, nil
+}
+
 func (r *FleetReconciler) updateStatus(ctx context.Context, log logr.Logger, fleet *corev1alpha1.Fleet) error {
 	capacity, allocatable, err := r.calculateCapacity(ctx, log)
 	if err != nil {
 		return fmt.Errorf("error calculating pool resources:%w", err)
 	}
 
+	runtimeInfo, err := r.getRuntimeInfo(ctx, log)
+	if err != nil {
+		return err
+	}
+
 	base := fleet.DeepCopy()
 	fleet.Status.State = corev1alpha1.FleetStateReady
 	fleet.Status.Addresses = r.Addresses
 	fleet.Status.Capacity = capacity
 	fleet.Status.Allocatable = allocatable
+	fleet.Status.RuntimeInfo = runtimeInfo
 	fleet.Status.DaemonEndpoints.SphereletEndpoint.Port = r.Port
 
 	if err := r.Status().Patch(ctx, fleet, client.MergeFrom(base)); err != nil {
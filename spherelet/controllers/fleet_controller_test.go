@@ -13,6 +13,7 @@ import (
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/iri/remote/fake"
 	"spheric.cloud/spheric/utils/generic"
 	. "spheric.cloud/spheric/utils/testing"
 )
@@ -51,4 +52,26 @@ var _ = Describe("FleetController", func() {
 			})),
 		))
 	})
+
+	It("should report the runtime version and capabilities", func(ctx SpecContext) {
+		Eventually(Object(&corev1alpha1.Fleet{
+			ObjectMeta: metav1.ObjectMeta{Name: fleetName},
+		})).Should(HaveField("Status.RuntimeInfo", Equal(&corev1alpha1.FleetRuntimeInfo{
+			RuntimeName:    fake.RuntimeName,
+			RuntimeVersion: fake.Version,
+			Capabilities: []corev1alpha1.FleetCapability{
+				corev1alpha1.FleetCapabilityHotplugDisks,
+				corev1alpha1.FleetCapabilityHotplugNetworkInterfaces,
+				corev1alpha1.FleetCapabilityWatchInstances,
+				corev1alpha1.FleetCapabilityInstanceStats,
+				corev1alpha1.FleetCapabilityExec,
+				corev1alpha1.FleetCapabilityReboot,
+				corev1alpha1.FleetCapabilitySuspend,
+				corev1alpha1.FleetCapabilityImages,
+				corev1alpha1.FleetCapabilityPortForward,
+				corev1alpha1.FleetCapabilityConsoleLog,
+				corev1alpha1.FleetCapabilityResize,
+			},
+		})))
+	})
 })
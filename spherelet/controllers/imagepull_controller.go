@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/api/v1alpha1"
+	"spheric.cloud/spheric/spherelet/controllers/events"
+	iriinstance "spheric.cloud/spheric/spherelet/instance"
+	"spheric.cloud/spheric/utils/predicates"
+)
+
+// ImagePullReconciler pulls the images of instances scheduled onto the fleet before their IRI instances are created,
+// so that creating them does not have to wait for the image.
+type ImagePullReconciler struct {
+	record.EventRecorder
+	client.Client
+
+	InstanceRuntime iriinstance.RuntimeService
+	ImageService    iriinstance.ImageService
+
+	FleetName string
+
+	WatchFilterValue string
+}
+
+func (r *ImagePullReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instance := &corev1alpha1.Instance{}
+	if err := r.Get(ctx, req.NamespacedName, instance); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting instance %s: %w", req.NamespacedName, err)
+		}
+		return ctrl.Result{}, nil
+	}
+	return r.reconcile(ctx, log, instance)
+}
+
+func (r *ImagePullReconciler) reconcile(ctx context.Context, log logr.Logger, instance *corev1alpha1.Instance) (ctrl.Result, error) {
+	image := instance.Spec.Image
+	if image == "" || !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("Image", image)
+
+	created, err := r.iriInstanceExists(ctx, instance)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if created {
+		log.V(2).Info("IRI instance already exists, nothing to pre-pull")
+		return ctrl.Result{}, nil
+	}
+
+	imageSpec := &iri.ImageSpec{Image: image}
+	res, err := r.ImageService.ListImages(ctx, &iri.ListImagesRequest{
+		Filter: &iri.ImageFilter{Image: imageSpec},
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing images: %w", err)
+	}
+	if len(res.Images) > 0 {
+		log.V(2).Info("Image is already present")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Pulling image")
+	pullRes, err := r.ImageService.PullImage(ctx, &iri.PullImageRequest{Image: imageSpec})
+	if err != nil {
+		r.Eventf(instance, corev1.EventTypeWarning, events.FailedPullImage, "Failed to pull image %s: %v", image, err)
+		return ctrl.Result{}, fmt.Errorf("error pulling image %s: %w", image, err)
+	}
+
+	log.V(1).Info("Pulled image", "ImageRef", pullRes.ImageRef)
+	r.Eventf(instance, corev1.EventTypeNormal, events.PulledImage, "Pulled image %s", image)
+	return ctrl.Result{}, nil
+}
+
+func (r *ImagePullReconciler) iriInstanceExists(ctx context.Context, instance *corev1alpha1.Instance) (bool, error) {
+	res, err := r.InstanceRuntime.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{LabelSelector: map[string]string{
+			v1alpha1.InstanceUIDLabel: string(instance.UID),
+		}},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing instances by instance uid: %w", err)
+	}
+	return len(res.Instances) > 0, nil
+}
+
+func (r *ImagePullReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	log := ctrl.Log.WithName("spherelet")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("imagepull").
+		For(
+			&corev1alpha1.Instance{},
+			builder.WithPredicates(
+				InstanceRunsInFleetPredicate(r.FleetName),
+				predicates.ResourceHasFilterLabel(log, r.WatchFilterValue),
+				predicates.ResourceIsNotExternallyManaged(log),
+			),
+		).
+		Complete(r)
+}
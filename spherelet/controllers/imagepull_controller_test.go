@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1alpha1 "spheric.cloud/spheric/api/core/v1alpha1"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	sphereletv1alpha1 "spheric.cloud/spheric/spherelet/api/v1alpha1"
+	. "spheric.cloud/spheric/utils/testing"
+)
+
+var _ = Describe("ImagePullController", func() {
+	ns := SetupNamespace(k8sClient)
+	instanceType := SetupInstanceType()
+
+	It("should pull the image of an instance that is not yet created", func(ctx SpecContext) {
+		By("creating an instance whose ignition is not yet available")
+		const image = "example.org/pre-pull:latest"
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				InstanceTypeRef: corev1alpha1.LocalObjRef(instanceType.Name),
+				FleetRef:        corev1alpha1.NewLocalObjRef(fleetName),
+				Image:           image,
+				IgnitionRef:     &corev1alpha1.SecretKeySelector{Name: "not-yet-available"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+
+		By("waiting for the image to be pulled")
+		Eventually(ctx, func(g Gomega) {
+			res, err := images.ListImages(ctx, &iri.ListImagesRequest{
+				Filter: &iri.ImageFilter{Image: &iri.ImageSpec{Image: image}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(res.Images).To(HaveLen(1))
+		}).Should(Succeed())
+
+		By("asserting the instance was not created")
+		res, err := srv.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{LabelSelector: map[string]string{
+				sphereletv1alpha1.InstanceUIDLabel: string(instance.UID),
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(BeEmpty())
+	})
+})
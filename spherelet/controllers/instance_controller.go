@@ -12,10 +12,12 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/controller-utils/clientutils"
+	"github.com/ironcore-dev/controller-utils/conditionutils"
 	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -88,23 +90,23 @@ func (r *InstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 }
 
 func (r *InstanceReconciler) getIRIInstancesForInstance(ctx context.Context, instance *corev1alpha1.Instance) ([]*iri.Instance, error) {
-	res, err := r.InstanceRuntime.ListInstances(ctx, &iri.ListInstancesRequest{
-		Filter: &iri.InstanceFilter{LabelSelector: r.instanceUIDLabelSelector(instance.UID)},
-	})
+	instances, err := iriinstance.ListAllInstances(ctx, r.InstanceRuntime,
+		&iri.InstanceFilter{LabelSelector: r.instanceUIDLabelSelector(instance.UID)},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error listing instances by instance uid: %w", err)
 	}
-	return res.Instances, nil
+	return instances, nil
 }
 
 func (r *InstanceReconciler) listInstancesByInstanceKey(ctx context.Context, instanceKey client.ObjectKey) ([]*iri.Instance, error) {
-	res, err := r.InstanceRuntime.ListInstances(ctx, &iri.ListInstancesRequest{
-		Filter: &iri.InstanceFilter{LabelSelector: r.instanceKeyLabelSelector(instanceKey)},
-	})
+	instances, err := iriinstance.ListAllInstances(ctx, r.InstanceRuntime,
+		&iri.InstanceFilter{LabelSelector: r.instanceKeyLabelSelector(instanceKey)},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error listing instances by instance key: %w", err)
 	}
-	return res.Instances, nil
+	return instances, nil
 }
 
 func (r *InstanceReconciler) getInstanceByID(ctx context.Context, id string) (*iri.Instance, error) {
@@ -336,6 +338,7 @@ func (r *InstanceReconciler) iriInstanceAnnotations(
 	annotations := map[string]string{
 		v1alpha1.InstanceGenerationAnnotation:    strconv.FormatInt(instance.Generation, 10),
 		v1alpha1.IRIInstanceGenerationAnnotation: strconv.FormatInt(iriInstanceGeneration, 10),
+		v1alpha1.RestartGenerationAnnotation:     strconv.FormatInt(instance.Spec.RestartGeneration, 10),
 	}
 
 	for name, fieldPath := range r.DownwardAPIAnnotations {
@@ -400,6 +403,18 @@ func (r *InstanceReconciler) getIRIInstanceGeneration(iriInstance *iri.Instance)
 	)
 }
 
+// getRestartGeneration returns the last restart generation carried out for the instance.
+// IRI instances created before restarts were tracked are treated as never restarted.
+func (r *InstanceReconciler) getRestartGeneration(iriInstance *iri.Instance) (int64, error) {
+	if _, ok := iriInstance.GetMetadata().GetAnnotations()[v1alpha1.RestartGenerationAnnotation]; !ok {
+		return 0, nil
+	}
+	return getAndParseFromStringMap(iriInstance.GetMetadata().GetAnnotations(),
+		v1alpha1.RestartGenerationAnnotation,
+		parseInt64,
+	)
+}
+
 func (r *InstanceReconciler) updateStatus(
 	ctx context.Context,
 	log logr.Logger,
@@ -438,6 +453,11 @@ func (r *InstanceReconciler) updateInstanceStatus(ctx context.Context, instance
 		return err
 	}
 
+	restartGeneration, err := r.getRestartGeneration(iriInstance)
+	if err != nil {
+		return err
+	}
+
 	instanceID := iriinstance.MakeID(r.InstanceRuntimeName, iriInstance.Metadata.Id)
 
 	state, err := r.convertIRIInstanceState(iriInstance.Status.State)
@@ -460,6 +480,11 @@ func (r *InstanceReconciler) updateInstanceStatus(ctx context.Context, instance
 	instance.Status.State = state
 	instance.Status.InstanceID = instanceID.String()
 	instance.Status.ObservedGeneration = generation
+	instance.Status.ObservedRestartGeneration = restartGeneration
+	instance.Status.InstanceTypeRef = nil
+	if typeName := iriInstance.GetSpec().GetType(); typeName != "" {
+		instance.Status.InstanceTypeRef = corev1alpha1.NewLocalObjRef(typeName)
+	}
 	instance.Status.Disks = diskStatuses
 	instance.Status.NetworkInterfaces = nicStatuses
 
@@ -475,6 +500,8 @@ func (r *InstanceReconciler) prepareIRIPower(power corev1alpha1.Power) (iri.Powe
 		return iri.Power_POWER_ON, nil
 	case corev1alpha1.PowerOff:
 		return iri.Power_POWER_OFF, nil
+	case corev1alpha1.PowerSuspended:
+		return iri.Power_POWER_SUSPENDED, nil
 	default:
 		return 0, fmt.Errorf("unknown power %q", power)
 	}
@@ -501,6 +528,157 @@ func (r *InstanceReconciler) updateIRIPower(ctx context.Context, log logr.Logger
 	return nil
 }
 
+func (r *InstanceReconciler) prepareIRIRebootType(restartType corev1alpha1.RestartType) (iri.RebootType, error) {
+	switch restartType {
+	case corev1alpha1.RestartGraceful, "":
+		return iri.RebootType_REBOOT_GRACEFUL, nil
+	case corev1alpha1.RestartHard:
+		return iri.RebootType_REBOOT_HARD, nil
+	default:
+		return 0, fmt.Errorf("unknown restart type %q", restartType)
+	}
+}
+
+// updateIRIRestart reboots the iri instance if the restart generation of the instance is newer than
+// the one recorded on the iri instance. The new restart generation is recorded right after a successful
+// reboot, so the reboot is not repeated; a failed reboot is retried. Instances that are not powered on
+// are not rebooted.
+func (r *InstanceReconciler) updateIRIRestart(ctx context.Context, log logr.Logger, instance *corev1alpha1.Instance, iriInstance *iri.Instance) error {
+	restartGeneration, err := r.getRestartGeneration(iriInstance)
+	if err != nil {
+		return err
+	}
+
+	if instance.Spec.RestartGeneration <= restartGeneration {
+		log.V(1).Info("Restart generation is up-to-date", "RestartGeneration", restartGeneration)
+		return nil
+	}
+	if instance.Spec.Power != corev1alpha1.PowerOn || iriInstance.Spec.Power != iri.Power_POWER_ON {
+		log.V(1).Info("Instance is not powered on, skipping restart", "RestartGeneration", instance.Spec.RestartGeneration)
+		return nil
+	}
+
+	rebootType, err := r.prepareIRIRebootType(instance.Spec.RestartType)
+	if err != nil {
+		return fmt.Errorf("error preparing iri reboot type: %w", err)
+	}
+
+	log.V(1).Info("Restarting instance", "RestartGeneration", instance.Spec.RestartGeneration, "RebootType", rebootType)
+	if _, err := r.InstanceRuntime.RebootInstance(ctx, &iri.RebootInstanceRequest{
+		InstanceId: iriInstance.Metadata.Id,
+		Type:       rebootType,
+	}); err != nil {
+		return fmt.Errorf("error rebooting instance: %w", err)
+	}
+
+	// Record the restart generation right away so failing updates of other fields don't restart the
+	// instance again on the next reconciliation.
+	log.V(1).Info("Recording restart generation", "RestartGeneration", instance.Spec.RestartGeneration)
+	annotations := maps.Clone(iriInstance.GetMetadata().GetAnnotations())
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[v1alpha1.RestartGenerationAnnotation] = strconv.FormatInt(instance.Spec.RestartGeneration, 10)
+	if _, err := r.InstanceRuntime.UpdateInstanceAnnotations(ctx, &iri.UpdateInstanceAnnotationsRequest{
+		InstanceId:  iriInstance.Metadata.Id,
+		Annotations: annotations,
+	}); err != nil {
+		return fmt.Errorf("error recording restart generation: %w", err)
+	}
+	iriInstance.Metadata.Annotations = annotations
+	return nil
+}
+
+const (
+	// resizedReason is the reason of a False OfflineResizeRequired condition once the instance was resized.
+	resizedReason = "Resized"
+	// powerOffRequiredReason is the reason of an OfflineResizeRequired condition if the runtime can only
+	// resize the instance once it is powered off.
+	powerOffRequiredReason = "PowerOffRequired"
+	// resizeUnsupportedReason is the reason of an OfflineResizeRequired condition if the runtime cannot
+	// resize existing instances at all.
+	resizeUnsupportedReason = "ResizeUnsupported"
+)
+
+// updateIRIResources updates the resources of the iri instance if the instance type of the instance changed.
+// If the runtime cannot resize the instance in its current state, the OfflineResizeRequired condition is
+// reported and the resize is retried on later reconciliations, e.g. once the instance is powered off.
+func (r *InstanceReconciler) updateIRIResources(ctx context.Context, log logr.Logger, instance *corev1alpha1.Instance, iriInstance *iri.Instance) error {
+	typeName, cpu, memory, ok, err := r.prepareIRIInstanceReqs(ctx, instance)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.V(1).Info("Instance type is not ready, skipping resource update")
+		return nil
+	}
+
+	spec := iriInstance.Spec
+	if spec.Type == typeName && spec.CpuCount == cpu && spec.MemoryBytes == memory {
+		log.V(1).Info("Resources are up-to-date", "Type", typeName)
+		return r.patchResized(ctx, instance, typeName)
+	}
+
+	log.V(1).Info("Updating resources", "Type", typeName, "CPUCount", cpu, "MemoryBytes", memory)
+	_, err = r.InstanceRuntime.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{
+		InstanceId:  iriInstance.Metadata.Id,
+		Type:        typeName,
+		CpuCount:    cpu,
+		MemoryBytes: memory,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		spec.Type = typeName
+		spec.CpuCount = cpu
+		spec.MemoryBytes = memory
+		return r.patchResized(ctx, instance, typeName)
+	case codes.FailedPrecondition:
+		log.V(1).Info("Instance has to be powered off to be resized", "Error", err)
+		return r.patchOfflineResizeRequired(ctx, instance, corev1.ConditionTrue, powerOffRequiredReason,
+			fmt.Sprintf("Instance has to be powered off to be resized to instance type %s: %s", typeName, status.Convert(err).Message()))
+	case codes.Unimplemented:
+		log.V(1).Info("Runtime does not support resizing instances")
+		return r.patchOfflineResizeRequired(ctx, instance, corev1.ConditionTrue, resizeUnsupportedReason,
+			fmt.Sprintf("Runtime does not support resizing instances, the instance has to be re-created to use instance type %s", typeName))
+	default:
+		return fmt.Errorf("error updating instance resources: %w", err)
+	}
+}
+
+// patchResized marks a previously required offline resize as done.
+func (r *InstanceReconciler) patchResized(ctx context.Context, instance *corev1alpha1.Instance, typeName string) error {
+	cond := &corev1alpha1.InstanceCondition{}
+	if !conditionutils.MustFindSlice(instance.Status.Conditions, string(corev1alpha1.InstanceOfflineResizeRequired), cond) ||
+		cond.Status == corev1.ConditionFalse {
+		return nil
+	}
+	return r.patchOfflineResizeRequired(ctx, instance, corev1.ConditionFalse, resizedReason,
+		fmt.Sprintf("Instance was resized to instance type %s", typeName))
+}
+
+func (r *InstanceReconciler) patchOfflineResizeRequired(
+	ctx context.Context,
+	instance *corev1alpha1.Instance,
+	conditionStatus corev1.ConditionStatus,
+	reason, message string,
+) error {
+	base := instance.DeepCopy()
+	conditionutils.MustUpdateSlice(&instance.Status.Conditions, string(corev1alpha1.InstanceOfflineResizeRequired),
+		conditionutils.UpdateStatus(conditionStatus),
+		conditionutils.UpdateReason(reason),
+		conditionutils.UpdateMessage(message),
+		conditionutils.UpdateObserved(instance),
+	)
+	if equality.Semantic.DeepEqual(base.Status.Conditions, instance.Status.Conditions) {
+		return nil
+	}
+
+	if err := r.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error patching offline resize required condition: %w", err)
+	}
+	return nil
+}
+
 func (r *InstanceReconciler) update(
 	ctx context.Context,
 	log logr.Logger,
@@ -527,6 +705,16 @@ func (r *InstanceReconciler) update(
 		errs = append(errs, fmt.Errorf("error updating power state: %w", err))
 	}
 
+	log.V(1).Info("Updating restart")
+	if err := r.updateIRIRestart(ctx, log, instance, iriInstance); err != nil {
+		errs = append(errs, fmt.Errorf("error updating restart: %w", err))
+	}
+
+	log.V(1).Info("Updating resources")
+	if err := r.updateIRIResources(ctx, log, instance, iriInstance); err != nil {
+		errs = append(errs, fmt.Errorf("error updating resources: %w", err))
+	}
+
 	if len(errs) > 0 {
 		return ctrl.Result{}, fmt.Errorf("error(s) updating instance: %v", errs)
 	}
@@ -581,7 +769,7 @@ func (r *InstanceReconciler) updateIRIAnnotations(
 var iriInstanceStateToInstanceState = map[iri.InstanceState]corev1alpha1.InstanceState{
 	iri.InstanceState_INSTANCE_PENDING:    corev1alpha1.InstanceStatePending,
 	iri.InstanceState_INSTANCE_RUNNING:    corev1alpha1.InstanceStateRunning,
-	iri.InstanceState_INSTANCE_SUSPENDED:  corev1alpha1.InstanceStateShutdown,
+	iri.InstanceState_INSTANCE_SUSPENDED:  corev1alpha1.InstanceStateSuspended,
 	iri.InstanceState_INSTANCE_TERMINATED: corev1alpha1.InstanceStateTerminated,
 }
 
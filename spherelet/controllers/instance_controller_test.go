@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
@@ -199,5 +200,126 @@ var _ = Describe("InstanceController", func() {
 
 		By("waiting for the iri instance to be updated")
 		Eventually(Instance(srv, iriInstance)).Should(HaveField("Spec.Power", Equal(iri.Power_POWER_OFF)))
+
+		By("suspending the instance")
+		base = instance.DeepCopy()
+		instance.Spec.Power = corev1alpha1.PowerSuspended
+		Expect(k8sClient.Patch(ctx, instance, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri instance to be updated")
+		Eventually(Instance(srv, iriInstance)).Should(HaveField("Spec.Power", Equal(iri.Power_POWER_SUSPENDED)))
+
+		By("reporting the iri instance as suspended")
+		Eventually(UpdateInstance(srv, iriInstance, func() {
+			iriInstance.Metadata.Generation = 1
+			iriInstance.Status.ObservedGeneration = 1
+			iriInstance.Status.State = iri.InstanceState_INSTANCE_SUSPENDED
+		})).Should(Succeed())
+		Eventually(Object(instance)).Should(HaveField("Status.State", Equal(corev1alpha1.InstanceStateSuspended)))
+	})
+
+	It("should restart a instance when its restart generation is increased", func(ctx SpecContext) {
+		By("creating a instance")
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				InstanceTypeRef:   corev1alpha1.LocalObjRef(instanceType.Name),
+				FleetRef:          corev1alpha1.NewLocalObjRef(fleetName),
+				RestartGeneration: 1,
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+
+		By("waiting for the instance to be created")
+		iriInstance := NewFakeInstanceWithUID(instance.UID)
+		Eventually(GetInstanceByUID(srv, iriInstance)).Should(Succeed())
+
+		By("inspecting the instance")
+		Expect(iriInstance.Metadata.Annotations).To(HaveKeyWithValue(sphereletv1alpha1.RestartGenerationAnnotation, "1"))
+		Expect(iriInstance.Reboots).To(BeEmpty())
+
+		By("increasing the restart generation")
+		base := instance.DeepCopy()
+		instance.Spec.RestartGeneration = 2
+		instance.Spec.RestartType = corev1alpha1.RestartHard
+		Expect(k8sClient.Patch(ctx, instance, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri instance to be rebooted")
+		Eventually(Instance(srv, iriInstance)).Should(SatisfyAll(
+			HaveField("Reboots", Equal([]iri.RebootType{iri.RebootType_REBOOT_HARD})),
+			HaveField("Metadata.Annotations", HaveKeyWithValue(sphereletv1alpha1.RestartGenerationAnnotation, "2")),
+		))
+		Eventually(Object(instance)).Should(HaveField("Status.ObservedRestartGeneration", BeEquivalentTo(2)))
+	})
+
+	It("should resize an instance when its instance type changes", func(ctx SpecContext) {
+		By("creating a larger instance type")
+		largeInstanceType := &corev1alpha1.InstanceType{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-type-large-",
+			},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("2"),
+				corev1alpha1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, largeInstanceType)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, largeInstanceType)
+
+		By("creating a instance")
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "instance-",
+			},
+			Spec: corev1alpha1.InstanceSpec{
+				InstanceTypeRef: corev1alpha1.LocalObjRef(instanceType.Name),
+				FleetRef:        corev1alpha1.NewLocalObjRef(fleetName),
+			},
+		}
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+
+		By("waiting for the instance to be created")
+		iriInstance := NewFakeInstanceWithUID(instance.UID)
+		Eventually(GetInstanceByUID(srv, iriInstance)).Should(Succeed())
+
+		By("limiting the resources running instances can be resized to")
+		srv.SetOnlineResizeLimit(&iri.RuntimeResources{CpuCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		DeferCleanup(srv.SetOnlineResizeLimit, (*iri.RuntimeResources)(nil))
+
+		By("changing the instance type")
+		base := instance.DeepCopy()
+		instance.Spec.InstanceTypeRef = corev1alpha1.LocalObjRef(largeInstanceType.Name)
+		Expect(k8sClient.Patch(ctx, instance, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the offline resize to be reported")
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(corev1alpha1.InstanceOfflineResizeRequired),
+			"Status": BeEquivalentTo(corev1.ConditionTrue),
+			"Reason": Equal("PowerOffRequired"),
+		}))))
+		Expect(Instance(srv, iriInstance)()).To(HaveField("Spec.Type", Equal(instanceType.Name)))
+		Eventually(Object(instance)).Should(HaveField("Status.InstanceTypeRef", Equal(corev1alpha1.NewLocalObjRef(instanceType.Name))))
+
+		By("powering off the instance")
+		base = instance.DeepCopy()
+		instance.Spec.Power = corev1alpha1.PowerOff
+		Expect(k8sClient.Patch(ctx, instance, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri instance to be resized")
+		Eventually(Instance(srv, iriInstance)).Should(HaveField("Spec", SatisfyAll(
+			HaveField("Type", Equal(largeInstanceType.Name)),
+			HaveField("CpuCount", BeEquivalentTo(2)),
+			HaveField("MemoryBytes", BeEquivalentTo(2*1024*1024*1024)),
+		)))
+		Eventually(Object(instance)).Should(HaveField("Status.Conditions", ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":   Equal(corev1alpha1.InstanceOfflineResizeRequired),
+			"Status": BeEquivalentTo(corev1.ConditionFalse),
+			"Reason": Equal("Resized"),
+		}))))
+		Eventually(Object(instance)).Should(HaveField("Status.InstanceTypeRef", Equal(corev1alpha1.NewLocalObjRef(largeInstanceType.Name))))
 	})
 })
@@ -7,6 +7,7 @@ package instanceevent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
@@ -14,8 +15,11 @@ import (
 	"time"
 
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/instance"
 
 	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/server/healthz"
@@ -119,12 +123,25 @@ type generator struct {
 	items oldNewMap
 
 	list func(ctx context.Context) ([]*iri.Instance, error)
+
+	// watch starts a watch of instances. If nil, the generator only relists.
+	watch WatchFunc
+	// watching reports whether a watch is currently established.
+	watching atomic.Bool
+	// watched are the instances observed via watch.
+	watched map[string]*iri.Instance
 }
 
+// WatchFunc starts a watch of instances, see instance.RuntimeService.WatchInstances.
+type WatchFunc func(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error)
+
 type GeneratorOptions struct {
 	ChannelCapacity int
 	RelistPeriod    time.Duration
 	RelistThreshold time.Duration
+	// Watch is used to watch instances instead of relisting them. If the runtime does not
+	// implement watching instances, the generator falls back to relisting.
+	Watch WatchFunc
 }
 
 func setGeneratorOptionsDefaults(o *GeneratorOptions) {
@@ -150,6 +167,8 @@ func NewGenerator(list func(ctx context.Context) ([]*iri.Instance, error), opts
 		firstListTime:   time.Time{},
 		items:           make(oldNewMap),
 		list:            list,
+		watch:           opts.Watch,
+		watched:         make(map[string]*iri.Instance),
 		handlers:        sets.New[*handler](),
 	}
 }
@@ -159,6 +178,10 @@ func (g *generator) Name() string {
 }
 
 func (g *generator) Check(_ *http.Request) error {
+	if g.watching.Load() {
+		return nil
+	}
+
 	relistTime := g.relistTime.Load()
 	if relistTime == nil {
 		return fmt.Errorf("mleg did not relist yet")
@@ -202,6 +225,13 @@ func (g *generator) Start(ctx context.Context) error {
 	go func() {
 		defer close(g.eventChannel)
 
+		if g.watch != nil {
+			if err := g.runWatch(ctx, log); !errors.Is(err, errWatchUnsupported) {
+				return
+			}
+			log.Info("Runtime does not support watching instances, falling back to relisting")
+		}
+
 		t := time.NewTicker(g.relistPeriod)
 		defer t.Stop()
 
@@ -255,12 +285,8 @@ func (g *generator) relist(ctx context.Context, log logr.Logger) error {
 	for machineID, events := range eventsByKey {
 		g.items.update(machineID)
 		for i := range events {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case g.eventChannel <- events[i]:
-			default:
-				log.Info("Event channel is full, discarding event", "InstanceID", machineID)
+			if err := g.emit(ctx, log, machineID, events[i]); err != nil {
+				return err
 			}
 		}
 	}
@@ -268,6 +294,131 @@ func (g *generator) relist(ctx context.Context, log logr.Logger) error {
 	return nil
 }
 
+func (g *generator) emit(ctx context.Context, log logr.Logger, id string, evt *event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case g.eventChannel <- evt:
+	default:
+		log.Info("Event channel is full, discarding event", "InstanceID", id)
+	}
+	return nil
+}
+
+var errWatchUnsupported = errors.New("watching instances is not supported")
+
+// runWatch watches instances until the context is done, re-establishing the watch on errors.
+// If the runtime does not implement watching instances, errWatchUnsupported is returned.
+func (g *generator) runWatch(ctx context.Context, log logr.Logger) error {
+	var resumeToken string
+	for {
+		err := g.watchOnce(ctx, log, &resumeToken)
+		g.watching.Store(false)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		switch status.Code(err) {
+		case codes.Unimplemented:
+			return errWatchUnsupported
+		case codes.OutOfRange:
+			log.V(1).Info("Resume token expired, restarting watch from scratch")
+			resumeToken = ""
+		default:
+			log.Error(err, "Error watching instances, restarting watch")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(g.relistPeriod):
+		}
+	}
+}
+
+// watchOnce establishes a single watch and dispatches its events until it fails.
+// The resume token is advanced with every event received.
+func (g *generator) watchOnce(ctx context.Context, log logr.Logger, resumeToken *string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w, err := g.watch(ctx, &iri.WatchInstancesRequest{ResumeToken: *resumeToken})
+	if err != nil {
+		return err
+	}
+
+	// Without resume token, the watch starts with all existing instances. Instances that are
+	// not among them were deleted while not being watched.
+	var seen sets.Set[string]
+	if *resumeToken == "" {
+		seen = sets.New[string]()
+	}
+
+	for {
+		resp, err := w.Recv()
+		if err != nil {
+			return err
+		}
+		timestamp := time.Now()
+		g.relistTime.Store(&timestamp)
+		g.watching.Store(true)
+
+		switch resp.Type {
+		case iri.WatchEventType_WATCH_EVENT_ADDED, iri.WatchEventType_WATCH_EVENT_MODIFIED:
+			id := resp.GetInstance().GetMetadata().GetId()
+			if seen != nil {
+				seen.Insert(id)
+			}
+			if err := g.upsertWatched(ctx, log, resp.Instance); err != nil {
+				return err
+			}
+		case iri.WatchEventType_WATCH_EVENT_DELETED:
+			if err := g.deleteWatched(ctx, log, resp.GetInstance().GetMetadata().GetId()); err != nil {
+				return err
+			}
+		case iri.WatchEventType_WATCH_EVENT_SYNCED:
+			if seen != nil {
+				for id := range g.watched {
+					if seen.Has(id) {
+						continue
+					}
+					if err := g.deleteWatched(ctx, log, id); err != nil {
+						return err
+					}
+				}
+				seen = nil
+			}
+		}
+
+		if resp.ResumeToken != "" {
+			*resumeToken = resp.ResumeToken
+		}
+	}
+}
+
+func (g *generator) upsertWatched(ctx context.Context, log logr.Logger, inst *iri.Instance) error {
+	id := inst.GetMetadata().GetId()
+	old, ok := g.watched[id]
+	g.watched[id] = inst
+	switch {
+	case !ok:
+		return g.emit(ctx, log, id, &event{Create: &CreateEvent{Object: inst}})
+	case !proto.Equal(old, inst):
+		return g.emit(ctx, log, id, &event{Update: &UpdateEvent{ObjectOld: old, ObjectNew: inst}})
+	default:
+		return nil
+	}
+}
+
+func (g *generator) deleteWatched(ctx context.Context, log logr.Logger, id string) error {
+	old, ok := g.watched[id]
+	if !ok {
+		return nil
+	}
+	delete(g.watched, id)
+	return g.emit(ctx, log, id, &event{Delete: &DeleteEvent{Object: old}})
+}
+
 type handlerRegistration struct {
 	generator *generator
 	handler   *handler
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package instanceevent_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/event/instanceevent"
+	"spheric.cloud/spheric/spherelet/instance"
+	"spheric.cloud/spheric/spherelet/iri/remote/fake"
+)
+
+// recorder records the events of a generator as "<type> <instance id>".
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) record(typ string, inst *iri.Instance) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, typ+" "+inst.GetMetadata().GetId())
+}
+
+func (r *recorder) Events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *recorder) handler() instanceevent.Handler {
+	return instanceevent.HandlerFuncs{
+		CreateFunc:  func(evt instanceevent.CreateEvent) { r.record("create", evt.Object) },
+		UpdateFunc:  func(evt instanceevent.UpdateEvent) { r.record("update", evt.ObjectNew) },
+		DeleteFunc:  func(evt instanceevent.DeleteEvent) { r.record("delete", evt.Object) },
+		GenericFunc: func(evt instanceevent.GenericEvent) { r.record("generic", evt.Object) },
+	}
+}
+
+// failingWatch is a watch failing with the given error, before or after the events of the wrapped watch.
+type failingWatch struct {
+	instance.InstanceWatch
+	err  error
+	left int
+}
+
+func (w *failingWatch) Recv() (*iri.WatchInstancesResponse, error) {
+	if w.left <= 0 {
+		return nil, w.err
+	}
+	w.left--
+	return w.InstanceWatch.Recv()
+}
+
+var _ = Describe("Generator", func() {
+	var (
+		srv      *fake.FakeRuntimeService
+		events   *recorder
+		listFunc func(ctx context.Context) ([]*iri.Instance, error)
+	)
+
+	BeforeEach(func() {
+		srv = fake.NewFakeRuntimeService()
+		events = &recorder{}
+		listFunc = func(ctx context.Context) ([]*iri.Instance, error) {
+			res, err := srv.ListInstances(ctx, &iri.ListInstancesRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return res.Instances, nil
+		}
+	})
+
+	start := func(watch instanceevent.WatchFunc) instanceevent.Generator {
+		GinkgoHelper()
+		gen := instanceevent.NewGenerator(listFunc, instanceevent.GeneratorOptions{
+			RelistPeriod: 20 * time.Millisecond,
+			Watch:        watch,
+		})
+		_, err := gen.AddHandler(events.handler())
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		Expect(gen.Start(ctx)).To(Succeed())
+		return gen
+	}
+
+	createInstance := func(ctx context.Context) string {
+		GinkgoHelper()
+		res, err := srv.CreateInstance(ctx, &iri.CreateInstanceRequest{
+			Instance: &iri.Instance{Metadata: &iri.ObjectMetadata{}, Spec: &iri.InstanceSpec{}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return res.Instance.Metadata.Id
+	}
+
+	It("should generate events from watching instances", func(ctx SpecContext) {
+		existingID := createInstance(ctx)
+		gen := start(srv.WatchInstances)
+
+		Eventually(events.Events).Should(Equal([]string{"create " + existingID}))
+		Expect(gen.Check(nil)).To(Succeed())
+
+		id := createInstance(ctx)
+		_, err := srv.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{InstanceId: id, Power: iri.Power_POWER_OFF})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = srv.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: existingID})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(events.Events).Should(Equal([]string{
+			"create " + existingID,
+			"create " + id,
+			"update " + id,
+			"delete " + existingID,
+		}))
+	})
+
+	It("should resume watches after errors", func(ctx SpecContext) {
+		var requests []*iri.WatchInstancesRequest
+		var mu sync.Mutex
+		start(func(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error) {
+			mu.Lock()
+			requests = append(requests, req)
+			first := len(requests) == 1
+			mu.Unlock()
+
+			w, err := srv.WatchInstances(ctx, req)
+			if err != nil || !first {
+				return w, err
+			}
+			// Fail the first watch after the synced and the first added event.
+			return &failingWatch{InstanceWatch: w, err: status.Error(codes.Unavailable, "connection lost"), left: 2}, nil
+		})
+
+		id := createInstance(ctx)
+		Eventually(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(requests)
+		}).Should(BeNumerically(">=", 2))
+
+		_, err := srv.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{InstanceId: id, Power: iri.Power_POWER_OFF})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(events.Events).Should(Equal([]string{"create " + id, "update " + id}))
+		mu.Lock()
+		defer mu.Unlock()
+		Expect(requests[0].ResumeToken).To(BeEmpty())
+		Expect(requests[1].ResumeToken).NotTo(BeEmpty())
+	})
+
+	It("should resync if the resume token expired", func(ctx SpecContext) {
+		srv.WatchHistorySize = 1
+		deletedID := createInstance(ctx)
+
+		var (
+			calls   atomic.Int32
+			proceed = make(chan struct{})
+		)
+		start(func(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error) {
+			switch calls.Add(1) {
+			case 1:
+				w, err := srv.WatchInstances(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				// Fail the first watch after the added and the synced event.
+				return &failingWatch{InstanceWatch: w, err: status.Error(codes.Unavailable, "connection lost"), left: 2}, nil
+			case 2:
+				<-proceed
+			}
+			return srv.WatchInstances(ctx, req)
+		})
+
+		Eventually(events.Events).Should(Equal([]string{"create " + deletedID}))
+		Eventually(calls.Load).Should(BeEquivalentTo(2))
+
+		By("changing instances while the watch is down")
+		_, err := srv.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: deletedID})
+		Expect(err).NotTo(HaveOccurred())
+		id := createInstance(ctx)
+		close(proceed)
+
+		Eventually(events.Events).Should(ConsistOf(
+			"create "+deletedID,
+			"create "+id,
+			"delete "+deletedID,
+		))
+		Expect(calls.Load()).To(BeEquivalentTo(3))
+	})
+
+	It("should fall back to relisting if the runtime does not support watching", func(ctx SpecContext) {
+		start(func(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error) {
+			return &failingWatch{err: status.Error(codes.Unimplemented, "unknown method WatchInstances")}, nil
+		})
+
+		id := createInstance(ctx)
+		Eventually(events.Events).Should(ConsistOf(Or(Equal("create "+id), Equal("generic "+id))))
+	})
+})
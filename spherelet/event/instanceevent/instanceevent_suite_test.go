@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package instanceevent_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestInstanceEvent(t *testing.T) {
+	SetDefaultEventuallyTimeout(3 * time.Second)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "InstanceEvent Suite")
+}
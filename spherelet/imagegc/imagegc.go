@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package imagegc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/instance"
+)
+
+const (
+	DefaultHighThresholdPercent = 85
+	DefaultLowThresholdPercent  = 80
+	DefaultMinAge               = 2 * time.Minute
+	DefaultPeriod               = 5 * time.Minute
+)
+
+// Policy configures when and how many images are garbage collected.
+type Policy struct {
+	// HighThresholdPercent is the image filesystem usage above which images are garbage collected.
+	HighThresholdPercent int
+	// LowThresholdPercent is the image filesystem usage garbage collection tries to free down to.
+	LowThresholdPercent int
+	// MinAge is the minimum time an image has to be known before it may be garbage collected.
+	MinAge time.Duration
+}
+
+type Options struct {
+	Policy Policy
+	// Period is the interval in which garbage collection is run.
+	Period time.Duration
+	// Clock is used to determine image ages. Defaults to the real clock.
+	Clock clock.PassiveClock
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.Policy.HighThresholdPercent == 0 {
+		o.Policy.HighThresholdPercent = DefaultHighThresholdPercent
+	}
+	if o.Policy.LowThresholdPercent == 0 {
+		o.Policy.LowThresholdPercent = DefaultLowThresholdPercent
+	}
+	if o.Policy.MinAge == 0 {
+		o.Policy.MinAge = DefaultMinAge
+	}
+	if o.Period <= 0 {
+		o.Period = DefaultPeriod
+	}
+	if o.Clock == nil {
+		o.Clock = clock.RealClock{}
+	}
+}
+
+// GarbageCollector removes unused images once the image filesystem usage exceeds the configured threshold.
+type GarbageCollector interface {
+	manager.Runnable
+	// GarbageCollect runs a single garbage collection.
+	GarbageCollect(ctx context.Context) error
+}
+
+type imageRecord struct {
+	// firstDetected is the time the image was first seen.
+	firstDetected time.Time
+	// lastUsed is the last time the image was seen in use.
+	lastUsed time.Time
+	// sizeBytes is the size of the image.
+	sizeBytes uint64
+}
+
+type garbageCollector struct {
+	images instance.ImageService
+
+	policy Policy
+	period time.Duration
+	clock  clock.PassiveClock
+
+	mu      sync.Mutex
+	records map[string]*imageRecord
+}
+
+func NewGarbageCollector(images instance.ImageService, opts Options) (GarbageCollector, error) {
+	setOptionsDefaults(&opts)
+
+	policy := opts.Policy
+	if policy.HighThresholdPercent < 0 || policy.HighThresholdPercent > 100 {
+		return nil, fmt.Errorf("invalid high threshold percent %d: must be between 0 and 100", policy.HighThresholdPercent)
+	}
+	if policy.LowThresholdPercent < 0 || policy.LowThresholdPercent > 100 {
+		return nil, fmt.Errorf("invalid low threshold percent %d: must be between 0 and 100", policy.LowThresholdPercent)
+	}
+	if policy.LowThresholdPercent > policy.HighThresholdPercent {
+		return nil, fmt.Errorf("low threshold percent %d must not exceed high threshold percent %d",
+			policy.LowThresholdPercent, policy.HighThresholdPercent)
+	}
+
+	return &garbageCollector{
+		images:  images,
+		policy:  policy,
+		period:  opts.Period,
+		clock:   opts.Clock,
+		records: make(map[string]*imageRecord),
+	}, nil
+}
+
+func (g *garbageCollector) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("image-gc")
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	t := time.NewTicker(g.period)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			if err := g.GarbageCollect(ctx); err != nil {
+				log.Error(err, "Error garbage collecting images")
+			}
+		}
+	}
+}
+
+// detectImages updates the image records from the currently present images.
+// It returns the ids of the images that are currently not in use.
+func (g *garbageCollector) detectImages(ctx context.Context, now time.Time) ([]string, error) {
+	res, err := g.images.ListImages(ctx, &iri.ListImagesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+
+	present := make(map[string]struct{}, len(res.Images))
+	var unused []string
+	for _, img := range res.Images {
+		present[img.Id] = struct{}{}
+
+		record, ok := g.records[img.Id]
+		if !ok {
+			record = &imageRecord{firstDetected: now}
+			g.records[img.Id] = record
+		}
+		record.sizeBytes = img.SizeBytes
+		if img.InUse {
+			record.lastUsed = now
+		} else {
+			unused = append(unused, img.Id)
+		}
+	}
+
+	for id := range g.records {
+		if _, ok := present[id]; !ok {
+			delete(g.records, id)
+		}
+	}
+	return unused, nil
+}
+
+func (g *garbageCollector) GarbageCollect(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.clock.Now()
+	unused, err := g.detectImages(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	fsInfo, err := g.images.ImageFsInfo(ctx, &iri.ImageFsInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("error getting image filesystem info: %w", err)
+	}
+
+	capacity := fsInfo.CapacityBytes
+	if capacity == 0 {
+		log.V(1).Info("Image filesystem reports no capacity, skipping garbage collection")
+		return nil
+	}
+	used := capacity - min(fsInfo.AvailableBytes, capacity)
+
+	usagePercent := int(used * 100 / capacity)
+	if usagePercent < g.policy.HighThresholdPercent {
+		log.V(2).Info("Image filesystem usage below high threshold", "UsagePercent", usagePercent)
+		return nil
+	}
+
+	amountToFree := used - capacity*uint64(g.policy.LowThresholdPercent)/100
+	log.V(1).Info("Image filesystem usage above high threshold, garbage collecting images",
+		"UsagePercent", usagePercent,
+		"HighThresholdPercent", g.policy.HighThresholdPercent,
+		"AmountToFree", amountToFree,
+	)
+
+	freed := g.freeSpace(ctx, log, now, unused, amountToFree)
+	if freed < amountToFree {
+		return fmt.Errorf("freed %d bytes but wanted to free %d bytes", freed, amountToFree)
+	}
+	return nil
+}
+
+// freeSpace removes the given unused images, least recently used first, until at least amountToFree bytes are freed.
+func (g *garbageCollector) freeSpace(ctx context.Context, log logr.Logger, now time.Time, unused []string, amountToFree uint64) uint64 {
+	slices.SortFunc(unused, func(a, b string) int {
+		ra, rb := g.records[a], g.records[b]
+		if c := ra.lastUsed.Compare(rb.lastUsed); c != 0 {
+			return c
+		}
+		return ra.firstDetected.Compare(rb.firstDetected)
+	})
+
+	var freed uint64
+	for _, id := range unused {
+		if freed >= amountToFree {
+			break
+		}
+
+		record := g.records[id]
+		if now.Sub(record.firstDetected) < g.policy.MinAge {
+			log.V(2).Info("Image is too young to be garbage collected", "ImageID", id)
+			continue
+		}
+
+		log.V(1).Info("Removing image", "ImageID", id, "SizeBytes", record.sizeBytes)
+		if _, err := g.images.RemoveImage(ctx, &iri.RemoveImageRequest{
+			Image: &iri.ImageSpec{Image: id},
+		}); err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+			case codes.FailedPrecondition:
+				log.V(1).Info("Image is in use, not removing", "ImageID", id)
+				continue
+			default:
+				log.Error(err, "Error removing image", "ImageID", id)
+				continue
+			}
+		}
+
+		delete(g.records, id)
+		freed += record.sizeBytes
+	}
+	return freed
+}
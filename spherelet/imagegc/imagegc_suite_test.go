@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package imagegc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestImageGC(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Image GC Suite")
+}
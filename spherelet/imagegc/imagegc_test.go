@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package imagegc_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testingclock "k8s.io/utils/clock/testing"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/imagegc"
+	"spheric.cloud/spheric/spherelet/iri/remote/fake"
+)
+
+var _ = Describe("GarbageCollector", func() {
+	var (
+		runtime *fake.FakeRuntimeService
+		images  *fake.FakeImageService
+		clock   *testingclock.FakePassiveClock
+		gc      imagegc.GarbageCollector
+	)
+
+	BeforeEach(func() {
+		runtime = fake.NewFakeRuntimeService()
+		images = fake.NewFakeImageService(runtime)
+		images.CapacityBytes = 100
+		images.ImageSizeBytes = 30
+		clock = testingclock.NewFakePassiveClock(time.Now())
+
+		var err error
+		gc, err = imagegc.NewGarbageCollector(images, imagegc.Options{
+			Policy: imagegc.Policy{
+				HighThresholdPercent: 85,
+				LowThresholdPercent:  50,
+				MinAge:               time.Minute,
+			},
+			Clock: clock,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	pull := func(ctx SpecContext, name string) {
+		GinkgoHelper()
+		_, err := images.PullImage(ctx, &iri.PullImageRequest{Image: &iri.ImageSpec{Image: name}})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	imageNames := func(ctx SpecContext) []string {
+		GinkgoHelper()
+		res, err := images.ListImages(ctx, &iri.ListImagesRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, img := range res.Images {
+			names = append(names, img.Names...)
+		}
+		return names
+	}
+
+	It("should not remove images below the high threshold", func(ctx SpecContext) {
+		pull(ctx, "foo")
+		pull(ctx, "bar")
+		clock.SetTime(clock.Now().Add(time.Hour))
+
+		Expect(gc.GarbageCollect(ctx)).To(Succeed())
+		Expect(imageNames(ctx)).To(ConsistOf("foo", "bar"))
+	})
+
+	It("should remove unused images down to the low threshold, least recently used first", func(ctx SpecContext) {
+		images.ImageSizeBytes = 40
+		pull(ctx, "foo")
+		pull(ctx, "bar")
+		runtime.SetInstances([]*fake.FakeInstance{{
+			Instance: iri.Instance{
+				Metadata: &iri.ObjectMetadata{Id: "inst"},
+				Spec:     &iri.InstanceSpec{Image: &iri.ImageSpec{Image: "bar"}},
+			},
+		}})
+
+		By("recording bar as in use")
+		Expect(gc.GarbageCollect(ctx)).To(Succeed())
+
+		By("releasing bar and making the images old enough")
+		runtime.SetInstances(nil)
+		clock.SetTime(clock.Now().Add(time.Hour))
+
+		By("pulling another image exceeding the high threshold")
+		images.ImageSizeBytes = 10
+		pull(ctx, "baz")
+
+		Expect(gc.GarbageCollect(ctx)).To(Succeed())
+		Expect(imageNames(ctx)).To(ConsistOf("bar", "baz"))
+	})
+
+	It("should not remove images in use or younger than the minimum age", func(ctx SpecContext) {
+		pull(ctx, "foo")
+		pull(ctx, "bar")
+		pull(ctx, "baz")
+		runtime.SetInstances([]*fake.FakeInstance{{
+			Instance: iri.Instance{
+				Metadata: &iri.ObjectMetadata{Id: "inst"},
+				Spec:     &iri.InstanceSpec{Image: &iri.ImageSpec{Image: "foo"}},
+			},
+		}})
+
+		Expect(gc.GarbageCollect(ctx)).To(MatchError(ContainSubstring("wanted to free")))
+		Expect(imageNames(ctx)).To(ConsistOf("foo", "bar", "baz"))
+
+		clock.SetTime(clock.Now().Add(time.Hour))
+		Expect(gc.GarbageCollect(ctx)).To(Succeed())
+		Expect(imageNames(ctx)).To(ConsistOf("foo"))
+	})
+
+	It("should reject invalid thresholds", func() {
+		_, err := imagegc.NewGarbageCollector(images, imagegc.Options{
+			Policy: imagegc.Policy{HighThresholdPercent: 50, LowThresholdPercent: 80},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -7,21 +7,76 @@ package instance
 
 import (
 	"context"
+	"fmt"
 
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
 )
 
+// InstanceWatch is a stream of instance events, see RuntimeService.WatchInstances.
+type InstanceWatch interface {
+	Recv() (*iri.WatchInstancesResponse, error)
+}
+
+// ConsoleLogStream is a stream of serial console output, see RuntimeService.GetConsoleLog.
+type ConsoleLogStream interface {
+	Recv() (*iri.GetConsoleLogResponse, error)
+}
+
 type RuntimeService interface {
 	Version(context.Context, *iri.VersionRequest) (*iri.VersionResponse, error)
 	ListInstances(context.Context, *iri.ListInstancesRequest) (*iri.ListInstancesResponse, error)
+	WatchInstances(context.Context, *iri.WatchInstancesRequest) (InstanceWatch, error)
 	CreateInstance(context.Context, *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error)
 	DeleteInstance(context.Context, *iri.DeleteInstanceRequest) (*iri.DeleteInstanceResponse, error)
 	UpdateInstanceAnnotations(context.Context, *iri.UpdateInstanceAnnotationsRequest) (*iri.UpdateInstanceAnnotationsResponse, error)
 	UpdateInstancePower(context.Context, *iri.UpdateInstancePowerRequest) (*iri.UpdateInstancePowerResponse, error)
+	RebootInstance(context.Context, *iri.RebootInstanceRequest) (*iri.RebootInstanceResponse, error)
+	UpdateInstanceResources(context.Context, *iri.UpdateInstanceResourcesRequest) (*iri.UpdateInstanceResourcesResponse, error)
 	AttachDisk(context.Context, *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error)
 	DetachDisk(context.Context, *iri.DetachDiskRequest) (*iri.DetachDiskResponse, error)
 	AttachNetworkInterface(context.Context, *iri.AttachNetworkInterfaceRequest) (*iri.AttachNetworkInterfaceResponse, error)
 	DetachNetworkInterface(context.Context, *iri.DetachNetworkInterfaceRequest) (*iri.DetachNetworkInterfaceResponse, error)
 	Status(context.Context, *iri.StatusRequest) (*iri.StatusResponse, error)
+	InstanceStats(context.Context, *iri.InstanceStatsRequest) (*iri.InstanceStatsResponse, error)
+	ListInstanceStats(context.Context, *iri.ListInstanceStatsRequest) (*iri.ListInstanceStatsResponse, error)
 	Exec(context.Context, *iri.ExecRequest) (*iri.ExecResponse, error)
+	PortForward(context.Context, *iri.PortForwardRequest) (*iri.PortForwardResponse, error)
+	GetConsoleLog(context.Context, *iri.GetConsoleLogRequest) (ConsoleLogStream, error)
+}
+
+type ImageService interface {
+	ListImages(context.Context, *iri.ListImagesRequest) (*iri.ListImagesResponse, error)
+	PullImage(context.Context, *iri.PullImageRequest) (*iri.PullImageResponse, error)
+	RemoveImage(context.Context, *iri.RemoveImageRequest) (*iri.RemoveImageResponse, error)
+	ImageFsInfo(context.Context, *iri.ImageFsInfoRequest) (*iri.ImageFsInfoResponse, error)
+}
+
+// ListPageSize is the number of instances ListAllInstances requests per page.
+const ListPageSize = 500
+
+// ListAllInstances lists all instances matching the filter, paging through the results of the runtime.
+func ListAllInstances(ctx context.Context, runtime RuntimeService, filter *iri.InstanceFilter) ([]*iri.Instance, error) {
+	var (
+		res       []*iri.Instance
+		pageToken string
+	)
+	for {
+		list, err := runtime.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter:    filter,
+			PageSize:  ListPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, list.Instances...)
+		if list.NextPageToken == "" {
+			return res, nil
+		}
+		if list.NextPageToken == pageToken {
+			return nil, fmt.Errorf("runtime returned the same page token %q again", pageToken)
+		}
+		pageToken = list.NextPageToken
+	}
 }
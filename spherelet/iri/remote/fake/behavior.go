@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+// Behavior configures how the fake runtime simulates a real one.
+// The zero value leaves instance statuses untouched, which allows tests to control them explicitly.
+type Behavior struct {
+	// SimulateTransitions enables simulating instance, disk and network interface state transitions.
+	SimulateTransitions bool
+	// StateTransitionDelay is the delay after which instances reach the state matching their power.
+	StateTransitionDelay time.Duration
+	// AttachDelay is the delay after which disks and network interfaces are reported as attached.
+	AttachDelay time.Duration
+	// StuckInstanceRatio is the ratio (between 0 and 1) of created instances that are stuck:
+	// Stuck instances never leave the pending state and never get their disks and network interfaces attached.
+	StuckInstanceRatio float64
+	// StuckInstanceSelector, if set, makes all created instances whose labels match it stuck.
+	StuckInstanceSelector map[string]string
+}
+
+func (r *FakeRuntimeService) SetBehavior(behavior Behavior) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Behavior = behavior
+}
+
+// IsInstanceStuck reports whether the instance with the given id is stuck.
+func (r *FakeRuntimeService) IsInstanceStuck(id string) bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	_, ok := r.stuck[id]
+	return ok
+}
+
+var powerInstanceStates = map[iri.Power]iri.InstanceState{
+	iri.Power_POWER_ON:        iri.InstanceState_INSTANCE_RUNNING,
+	iri.Power_POWER_OFF:       iri.InstanceState_INSTANCE_TERMINATED,
+	iri.Power_POWER_SUSPENDED: iri.InstanceState_INSTANCE_SUSPENDED,
+}
+
+// simulateCreate sets up the simulated transitions of a newly created instance. The caller has to hold the lock.
+func (r *FakeRuntimeService) simulateCreate(inst *FakeInstance) {
+	if !r.Behavior.SimulateTransitions {
+		return
+	}
+
+	id := inst.Metadata.Id
+	if (r.Behavior.StuckInstanceSelector != nil && filterInLabels(r.Behavior.StuckInstanceSelector, inst.Metadata.Labels)) ||
+		(r.Behavior.StuckInstanceRatio > 0 && rand.Float64() < r.Behavior.StuckInstanceRatio) {
+		if r.stuck == nil {
+			r.stuck = make(map[string]struct{})
+		}
+		r.stuck[id] = struct{}{}
+	}
+
+	for _, disk := range inst.Spec.GetDisks() {
+		r.simulateDiskAttach(inst, disk.Name)
+	}
+	for _, nic := range inst.Spec.GetNetworkInterfaces() {
+		r.simulateNetworkInterfaceAttach(inst, nic.Name)
+	}
+	r.simulatePowerChange(inst)
+}
+
+// simulatePowerChange moves the instance into the state matching its power after the configured delay.
+// The caller has to hold the lock.
+func (r *FakeRuntimeService) simulatePowerChange(inst *FakeInstance) {
+	id := inst.Metadata.Id
+	if _, stuck := r.stuck[id]; !r.Behavior.SimulateTransitions || stuck {
+		return
+	}
+
+	power := inst.Spec.GetPower()
+	time.AfterFunc(r.Behavior.StateTransitionDelay, func() {
+		r.Lock()
+		defer r.Unlock()
+
+		inst, ok := r.Instances[id]
+		if !ok || inst.Spec.GetPower() != power {
+			// The instance is gone or its power changed in the meantime, which scheduled another transition.
+			return
+		}
+
+		if inst.Status == nil {
+			inst.Status = &iri.InstanceStatus{}
+		}
+		inst.Status.State = powerInstanceStates[power]
+		r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &inst.Instance)
+	})
+}
+
+// simulateDiskAttach reports the disk as pending and as attached after the configured delay.
+// The caller has to hold the lock.
+func (r *FakeRuntimeService) simulateDiskAttach(inst *FakeInstance, name string) {
+	if !r.Behavior.SimulateTransitions {
+		return
+	}
+
+	if inst.Status == nil {
+		inst.Status = &iri.InstanceStatus{}
+	}
+	inst.Status.Disks = append(inst.Status.Disks, &iri.DiskStatus{
+		Name:  name,
+		State: iri.DiskState_DISK_PENDING,
+	})
+
+	id := inst.Metadata.Id
+	if _, stuck := r.stuck[id]; stuck {
+		return
+	}
+
+	time.AfterFunc(r.Behavior.AttachDelay, func() {
+		r.Lock()
+		defer r.Unlock()
+
+		inst, ok := r.Instances[id]
+		if !ok {
+			return
+		}
+
+		idx := slices.IndexFunc(inst.Status.GetDisks(), func(status *iri.DiskStatus) bool { return status.Name == name })
+		if idx < 0 {
+			// The disk was detached in the meantime.
+			return
+		}
+
+		inst.Status.Disks[idx].State = iri.DiskState_DISK_ATTACHED
+		r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &inst.Instance)
+	})
+}
+
+// simulateDiskDetach removes the status of the disk. The caller has to hold the lock.
+func (r *FakeRuntimeService) simulateDiskDetach(inst *FakeInstance, name string) {
+	if !r.Behavior.SimulateTransitions || inst.Status == nil {
+		return
+	}
+
+	inst.Status.Disks = slices.DeleteFunc(inst.Status.Disks, func(status *iri.DiskStatus) bool { return status.Name == name })
+}
+
+// simulateNetworkInterfaceAttach reports the network interface as pending and as attached after the configured delay.
+// The caller has to hold the lock.
+func (r *FakeRuntimeService) simulateNetworkInterfaceAttach(inst *FakeInstance, name string) {
+	if !r.Behavior.SimulateTransitions {
+		return
+	}
+
+	if inst.Status == nil {
+		inst.Status = &iri.InstanceStatus{}
+	}
+	inst.Status.NetworkInterfaces = append(inst.Status.NetworkInterfaces, &iri.NetworkInterfaceStatus{
+		Name:  name,
+		State: iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING,
+	})
+
+	id := inst.Metadata.Id
+	if _, stuck := r.stuck[id]; stuck {
+		return
+	}
+
+	time.AfterFunc(r.Behavior.AttachDelay, func() {
+		r.Lock()
+		defer r.Unlock()
+
+		inst, ok := r.Instances[id]
+		if !ok {
+			return
+		}
+
+		idx := slices.IndexFunc(inst.Status.GetNetworkInterfaces(), func(status *iri.NetworkInterfaceStatus) bool { return status.Name == name })
+		if idx < 0 {
+			// The network interface was detached in the meantime.
+			return
+		}
+
+		nic := inst.Status.NetworkInterfaces[idx]
+		nic.State = iri.NetworkInterfaceState_NETWORK_INTERFACE_ATTACHED
+		nic.Handle = id + "/" + name
+		r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &inst.Instance)
+	})
+}
+
+// simulateNetworkInterfaceDetach removes the status of the network interface. The caller has to hold the lock.
+func (r *FakeRuntimeService) simulateNetworkInterfaceDetach(inst *FakeInstance, name string) {
+	if !r.Behavior.SimulateTransitions || inst.Status == nil {
+		return
+	}
+
+	inst.Status.NetworkInterfaces = slices.DeleteFunc(inst.Status.NetworkInterfaces, func(status *iri.NetworkInterfaceStatus) bool { return status.Name == name })
+}
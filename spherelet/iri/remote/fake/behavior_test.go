@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	. "spheric.cloud/spheric/spherelet/iri/remote/fake"
+)
+
+var _ = Describe("Behavior", func() {
+	var runtime *FakeRuntimeService
+	BeforeEach(func() {
+		runtime = NewFakeRuntimeService()
+	})
+
+	createInstance := func(ctx context.Context, labels map[string]string) string {
+		GinkgoHelper()
+
+		res, err := runtime.CreateInstance(ctx, &iri.CreateInstanceRequest{
+			Instance: &iri.Instance{
+				Metadata: &iri.ObjectMetadata{Labels: labels},
+				Spec: &iri.InstanceSpec{
+					Power:             iri.Power_POWER_ON,
+					Disks:             []*iri.Disk{{Name: "root"}},
+					NetworkInterfaces: []*iri.NetworkInterface{{Name: "primary"}},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return res.Instance.Metadata.Id
+	}
+
+	// instanceStatus returns a function getting a copy of the status of the instance with the given id.
+	instanceStatus := func(ctx context.Context, id string) func() *iri.InstanceStatus {
+		return func() *iri.InstanceStatus {
+			res, err := runtime.ListInstances(ctx, &iri.ListInstancesRequest{Filter: &iri.InstanceFilter{Id: id}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Instances).To(HaveLen(1))
+			return res.Instances[0].Status
+		}
+	}
+
+	It("should leave instance statuses untouched by default", func(ctx SpecContext) {
+		id := createInstance(ctx, nil)
+
+		Consistently(instanceStatus(ctx, id), 50*time.Millisecond).Should(SatisfyAll(
+			HaveField("State", Equal(iri.InstanceState_INSTANCE_PENDING)),
+			HaveField("Disks", BeEmpty()),
+			HaveField("NetworkInterfaces", BeEmpty()),
+		))
+		Expect(runtime.IsInstanceStuck(id)).To(BeFalse())
+	})
+
+	It("should simulate transitions after the configured delays", func(ctx SpecContext) {
+		runtime.SetBehavior(Behavior{
+			SimulateTransitions:  true,
+			StateTransitionDelay: 100 * time.Millisecond,
+			AttachDelay:          200 * time.Millisecond,
+		})
+		id := createInstance(ctx, nil)
+
+		By("reporting the instance, its disk and its network interface as pending right after creation")
+		Expect(instanceStatus(ctx, id)()).To(SatisfyAll(
+			HaveField("State", Equal(iri.InstanceState_INSTANCE_PENDING)),
+			HaveField("Disks", ConsistOf(HaveField("State", Equal(iri.DiskState_DISK_PENDING)))),
+			HaveField("NetworkInterfaces", ConsistOf(HaveField("State", Equal(iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING)))),
+		))
+
+		By("reporting the instance as running after the state transition delay")
+		Eventually(instanceStatus(ctx, id)).Should(SatisfyAll(
+			HaveField("State", Equal(iri.InstanceState_INSTANCE_RUNNING)),
+			HaveField("Disks", ConsistOf(HaveField("State", Equal(iri.DiskState_DISK_PENDING)))),
+		))
+
+		By("reporting the disk and network interface as attached after the attach delay")
+		Eventually(instanceStatus(ctx, id)).Should(SatisfyAll(
+			HaveField("Disks", ConsistOf(HaveField("State", Equal(iri.DiskState_DISK_ATTACHED)))),
+			HaveField("NetworkInterfaces", ConsistOf(SatisfyAll(
+				HaveField("State", Equal(iri.NetworkInterfaceState_NETWORK_INTERFACE_ATTACHED)),
+				HaveField("Handle", Equal(id+"/primary")),
+			))),
+		))
+
+		By("powering off the instance")
+		_, err := runtime.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{InstanceId: id, Power: iri.Power_POWER_OFF})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instanceStatus(ctx, id)()).To(HaveField("State", Equal(iri.InstanceState_INSTANCE_RUNNING)))
+		Eventually(instanceStatus(ctx, id)).Should(HaveField("State", Equal(iri.InstanceState_INSTANCE_TERMINATED)))
+
+		By("detaching the disk")
+		_, err = runtime.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: id, Name: "root"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instanceStatus(ctx, id)()).To(HaveField("Disks", BeEmpty()))
+	})
+
+	It("should make instances matching the stuck instance selector stuck", func(ctx SpecContext) {
+		runtime.SetBehavior(Behavior{
+			SimulateTransitions:   true,
+			StuckInstanceSelector: map[string]string{"stuck": "true"},
+		})
+		stuckID := createInstance(ctx, map[string]string{"stuck": "true"})
+		id := createInstance(ctx, map[string]string{"stuck": "false"})
+
+		Expect(runtime.IsInstanceStuck(stuckID)).To(BeTrue())
+		Expect(runtime.IsInstanceStuck(id)).To(BeFalse())
+
+		Eventually(instanceStatus(ctx, id)).Should(SatisfyAll(
+			HaveField("State", Equal(iri.InstanceState_INSTANCE_RUNNING)),
+			HaveField("Disks", ConsistOf(HaveField("State", Equal(iri.DiskState_DISK_ATTACHED)))),
+		))
+		Consistently(instanceStatus(ctx, stuckID), 50*time.Millisecond).Should(SatisfyAll(
+			HaveField("State", Equal(iri.InstanceState_INSTANCE_PENDING)),
+			HaveField("Disks", ConsistOf(HaveField("State", Equal(iri.DiskState_DISK_PENDING)))),
+			HaveField("NetworkInterfaces", ConsistOf(HaveField("State", Equal(iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING)))),
+		))
+
+		By("deleting the stuck instance")
+		_, err := runtime.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: stuckID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.IsInstanceStuck(stuckID)).To(BeFalse())
+	})
+
+	DescribeTable("should make the configured ratio of instances stuck",
+		func(ctx SpecContext, ratio float64, expectStuck bool) {
+			runtime.SetBehavior(Behavior{
+				SimulateTransitions: true,
+				StuckInstanceRatio:  ratio,
+			})
+
+			for range 20 {
+				Expect(runtime.IsInstanceStuck(createInstance(ctx, nil))).To(Equal(expectStuck))
+			}
+		},
+		Entry("none", 0.0, false),
+		Entry("all", 1.0, true),
+	)
+})
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/instance"
+)
+
+type fakeConsoleLogStream struct {
+	ctx    context.Context
+	data   string
+	follow bool
+}
+
+func (s *fakeConsoleLogStream) Recv() (*iri.GetConsoleLogResponse, error) {
+	if s.data != "" {
+		data := s.data
+		s.data = ""
+		return &iri.GetConsoleLogResponse{Data: []byte(data)}, nil
+	}
+	if !s.follow {
+		return nil, io.EOF
+	}
+
+	// The fake log does not change, so following it only waits for the client to cancel.
+	<-s.ctx.Done()
+	return nil, status.FromContextError(s.ctx.Err()).Err()
+}
+
+func (r *FakeRuntimeService) SetConsoleLog(id, log string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.ConsoleLogs[id] = log
+}
+
+// tailLines returns the last n lines of the log. Trailing output without a newline counts as a line.
+func tailLines(log string, n int64) string {
+	if n <= 0 {
+		return ""
+	}
+
+	end := len(log)
+	if strings.HasSuffix(log, "\n") {
+		end--
+	}
+	for i := end - 1; i >= 0; i-- {
+		if log[i] != '\n' {
+			continue
+		}
+		n--
+		if n == 0 {
+			return log[i+1:]
+		}
+	}
+	return log
+}
+
+// GetConsoleLog streams the serial console log of the instance. As the fake log has no timestamps,
+// SinceTime is ignored.
+func (r *FakeRuntimeService) GetConsoleLog(ctx context.Context, req *iri.GetConsoleLogRequest) (instance.ConsoleLogStream, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	if _, ok := r.Instances[req.InstanceId]; !ok {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", req.InstanceId)
+	}
+
+	log := r.ConsoleLogs[req.InstanceId]
+	if req.TailLines != nil {
+		log = tailLines(log, *req.TailLines)
+	}
+	return &fakeConsoleLogStream{
+		ctx:    ctx,
+		data:   log,
+		follow: req.Follow,
+	}, nil
+}
@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/labels"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/iri-api/listing"
 )
 
 var (
@@ -43,7 +44,7 @@ func generateID(length int) string {
 		id := hex.EncodeToString(data)
 
 		// Truncated versions of the id should not be numerical.
-		if _, err := strconv.ParseInt(id[:12], 10, 64); err != nil {
+		if _, err := strconv.ParseInt(id[:12], 10, 64); err == nil {
 			continue
 		}
 
@@ -53,6 +54,8 @@ func generateID(length int) string {
 
 type FakeInstance struct {
 	iri.Instance
+	// Reboots records the types of all reboots of the instance.
+	Reboots []iri.RebootType
 }
 
 type FakeStatus struct {
@@ -67,11 +70,35 @@ type FakeRuntimeService struct {
 	Capacity    *iri.RuntimeResources
 	Allocatable *iri.RuntimeResources
 	GetExecURL  func(req *iri.ExecRequest) string
+	// GetPortForwardURL returns the url handed out for port forward requests.
+	GetPortForwardURL func(req *iri.PortForwardRequest) string
+	// Capabilities are the capabilities reported by Version. Defaults to all capabilities.
+	Capabilities *iri.RuntimeCapabilities
+	// OnlineResizeLimit, if set, is the maximum cpu count and memory powered-on instances can be resized to.
+	// Larger resizes of powered-on instances fail with FailedPrecondition.
+	OnlineResizeLimit *iri.RuntimeResources
+	// Stats are the statistics reported for instances, keyed by instance id.
+	// Instances without statistics are considered not running.
+	Stats map[string]*iri.InstanceStats
+	// ConsoleLogs are the serial console logs reported for instances, keyed by instance id.
+	ConsoleLogs map[string]string
+	// WatchHistorySize is the number of events watches can be resumed from.
+	// Defaults to DefaultWatchHistorySize.
+	WatchHistorySize int
+	// Behavior configures how the fake simulates a real runtime.
+	Behavior Behavior
+
+	revision int64
+	history  []*iri.WatchInstancesResponse
+	watches  map[*fakeInstanceWatch]struct{}
+	stuck    map[string]struct{}
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
 	return &FakeRuntimeService{
-		Instances: make(map[string]*FakeInstance),
+		Instances:   make(map[string]*FakeInstance),
+		Stats:       make(map[string]*iri.InstanceStats),
+		ConsoleLogs: make(map[string]string),
 	}
 }
 
@@ -96,6 +123,7 @@ func (r *FakeRuntimeService) UpdateInstance(inst *FakeInstance) error {
 	for i, found := range r.Instances {
 		if found.GetMetadata().GetId() == id {
 			r.Instances[i] = inst
+			r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &inst.Instance)
 			return nil
 		}
 	}
@@ -118,10 +146,26 @@ func (r *FakeRuntimeService) SetInstances(instances []*FakeInstance) {
 	r.Lock()
 	defer r.Unlock()
 
+	old := r.Instances
 	r.Instances = make(map[string]*FakeInstance)
 	for _, instance := range instances {
 		r.Instances[instance.Metadata.Id] = instance
 	}
+
+	for id, instance := range old {
+		if _, ok := r.Instances[id]; !ok {
+			r.emit(iri.WatchEventType_WATCH_EVENT_DELETED, &instance.Instance)
+		}
+	}
+	for id, instance := range r.Instances {
+		oldInstance, ok := old[id]
+		switch {
+		case !ok:
+			r.emit(iri.WatchEventType_WATCH_EVENT_ADDED, &instance.Instance)
+		case !proto.Equal(&oldInstance.Instance, &instance.Instance):
+			r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
+		}
+	}
 }
 
 func (r *FakeRuntimeService) SetStatus(capacity, allocatable *iri.RuntimeResources) {
@@ -132,6 +176,13 @@ func (r *FakeRuntimeService) SetStatus(capacity, allocatable *iri.RuntimeResourc
 	r.Allocatable = allocatable
 }
 
+func (r *FakeRuntimeService) SetOnlineResizeLimit(limit *iri.RuntimeResources) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.OnlineResizeLimit = limit
+}
+
 func (r *FakeRuntimeService) SetGetExecURL(f func(req *iri.ExecRequest) string) {
 	r.Lock()
 	defer r.Unlock()
@@ -139,34 +190,74 @@ func (r *FakeRuntimeService) SetGetExecURL(f func(req *iri.ExecRequest) string)
 	r.GetExecURL = f
 }
 
+func (r *FakeRuntimeService) SetGetPortForwardURL(f func(req *iri.PortForwardRequest) string) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.GetPortForwardURL = f
+}
+
+func (r *FakeRuntimeService) SetInstanceStats(id string, stats *iri.InstanceStats) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Stats[id] = stats
+}
+
 func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	capabilities := r.Capabilities
+	if capabilities == nil {
+		capabilities = &iri.RuntimeCapabilities{
+			HotplugDisks:             true,
+			HotplugNetworkInterfaces: true,
+			WatchInstances:           true,
+			InstanceStats:            true,
+			Exec:                     true,
+			Reboot:                   true,
+			Suspend:                  true,
+			Images:                   true,
+			PortForward:              true,
+			ConsoleLog:               true,
+			Resize:                   true,
+		}
+	}
+
 	return &iri.VersionResponse{
 		RuntimeName:    RuntimeName,
 		RuntimeVersion: Version,
+		Capabilities:   proto.Clone(capabilities).(*iri.RuntimeCapabilities),
 	}, nil
 }
 
 func (r *FakeRuntimeService) ListInstances(ctx context.Context, req *iri.ListInstancesRequest) (*iri.ListInstancesResponse, error) {
+	filter, err := listing.NewInstanceMatcher(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
-	filter := req.Filter
-
 	var res []*iri.Instance
 	for _, m := range r.Instances {
-		if filter != nil {
-			if filter.Id != "" && filter.Id != m.Metadata.Id {
-				continue
-			}
-			if filter.LabelSelector != nil && !filterInLabels(filter.LabelSelector, m.Metadata.Labels) {
-				continue
-			}
+		if !filter.MatchesInstance(&m.Instance) {
+			continue
 		}
 
 		instance := proto.Clone(&m.Instance).(*iri.Instance) //nolint
 		res = append(res, instance)
 	}
-	return &iri.ListInstancesResponse{Instances: res}, nil
+
+	res, nextPageToken, err := listing.Paginate(res, func(inst *iri.Instance) string {
+		return inst.Metadata.Id
+	}, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &iri.ListInstancesResponse{Instances: res, NextPageToken: nextPageToken}, nil
 }
 
 func (r *FakeRuntimeService) CreateInstance(ctx context.Context, req *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error) {
@@ -183,9 +274,11 @@ func (r *FakeRuntimeService) CreateInstance(ctx context.Context, req *iri.Create
 	}
 
 	r.Instances[fakeInst.Metadata.Id] = fakeInst
+	r.simulateCreate(fakeInst)
+	r.emit(iri.WatchEventType_WATCH_EVENT_ADDED, &fakeInst.Instance)
 
 	return &iri.CreateInstanceResponse{
-		Instance: &fakeInst.Instance,
+		Instance: proto.Clone(&fakeInst.Instance).(*iri.Instance),
 	}, nil
 }
 
@@ -194,11 +287,15 @@ func (r *FakeRuntimeService) DeleteInstance(ctx context.Context, req *iri.Delete
 	defer r.Unlock()
 
 	instanceID := req.InstanceId
-	if _, ok := r.Instances[instanceID]; !ok {
+	instance, ok := r.Instances[instanceID]
+	if !ok {
 		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
 	}
 
 	delete(r.Instances, instanceID)
+	delete(r.Stats, instanceID)
+	delete(r.stuck, instanceID)
+	r.emit(iri.WatchEventType_WATCH_EVENT_DELETED, &instance.Instance)
 	return &iri.DeleteInstanceResponse{}, nil
 }
 
@@ -213,6 +310,7 @@ func (r *FakeRuntimeService) UpdateInstanceAnnotations(ctx context.Context, req
 	}
 
 	instance.Metadata.Annotations = req.Annotations
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.UpdateInstanceAnnotationsResponse{}, nil
 }
 
@@ -227,9 +325,56 @@ func (r *FakeRuntimeService) UpdateInstancePower(ctx context.Context, req *iri.U
 	}
 
 	instance.Spec.Power = req.Power
+	r.simulatePowerChange(instance)
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.UpdateInstancePowerResponse{}, nil
 }
 
+func (r *FakeRuntimeService) RebootInstance(ctx context.Context, req *iri.RebootInstanceRequest) (*iri.RebootInstanceResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	instanceID := req.InstanceId
+	instance, ok := r.Instances[instanceID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if instance.Spec.Power != iri.Power_POWER_ON {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance %q is not powered on", instanceID)
+	}
+
+	instance.Reboots = append(instance.Reboots, req.Type)
+	return &iri.RebootInstanceResponse{}, nil
+}
+
+func (r *FakeRuntimeService) UpdateInstanceResources(ctx context.Context, req *iri.UpdateInstanceResourcesRequest) (*iri.UpdateInstanceResourcesResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if req.CpuCount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify cpu count")
+	}
+	if req.MemoryBytes == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify memory bytes")
+	}
+
+	instanceID := req.InstanceId
+	instance, ok := r.Instances[instanceID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if limit := r.OnlineResizeLimit; limit != nil && instance.Spec.Power != iri.Power_POWER_OFF &&
+		(req.CpuCount > limit.CpuCount || req.MemoryBytes > limit.MemoryBytes) {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance %q has to be powered off to be resized", instanceID)
+	}
+
+	instance.Spec.Type = req.Type
+	instance.Spec.CpuCount = req.CpuCount
+	instance.Spec.MemoryBytes = req.MemoryBytes
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
+	return &iri.UpdateInstanceResourcesResponse{}, nil
+}
+
 func (r *FakeRuntimeService) AttachDisk(ctx context.Context, req *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error) {
 	r.Lock()
 	defer r.Unlock()
@@ -241,6 +386,8 @@ func (r *FakeRuntimeService) AttachDisk(ctx context.Context, req *iri.AttachDisk
 	}
 
 	instance.Spec.Disks = append(instance.Spec.Disks, req.Disk)
+	r.simulateDiskAttach(instance, req.Disk.GetName())
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.AttachDiskResponse{}, nil
 }
 
@@ -271,6 +418,8 @@ func (r *FakeRuntimeService) DetachDisk(ctx context.Context, req *iri.DetachDisk
 	}
 
 	instance.Spec.Disks = filtered
+	r.simulateDiskDetach(instance, req.Name)
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.DetachDiskResponse{}, nil
 }
 
@@ -285,6 +434,8 @@ func (r *FakeRuntimeService) AttachNetworkInterface(ctx context.Context, req *ir
 	}
 
 	instance.Spec.NetworkInterfaces = append(instance.Spec.NetworkInterfaces, req.NetworkInterface)
+	r.simulateNetworkInterfaceAttach(instance, req.NetworkInterface.GetName())
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.AttachNetworkInterfaceResponse{}, nil
 }
 
@@ -315,6 +466,8 @@ func (r *FakeRuntimeService) DetachNetworkInterface(ctx context.Context, req *ir
 	}
 
 	instance.Spec.NetworkInterfaces = filtered
+	r.simulateNetworkInterfaceDetach(instance, req.Name)
+	r.emit(iri.WatchEventType_WATCH_EVENT_MODIFIED, &instance.Instance)
 	return &iri.DetachNetworkInterfaceResponse{}, nil
 }
 
@@ -328,6 +481,40 @@ func (r *FakeRuntimeService) Status(ctx context.Context, req *iri.StatusRequest)
 	}, nil
 }
 
+func (r *FakeRuntimeService) InstanceStats(ctx context.Context, req *iri.InstanceStatsRequest) (*iri.InstanceStatsResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.Instances[req.InstanceId]; !ok {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", req.InstanceId)
+	}
+	stats, ok := r.Stats[req.InstanceId]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance %q is not running", req.InstanceId)
+	}
+	return &iri.InstanceStatsResponse{Stats: proto.Clone(stats).(*iri.InstanceStats)}, nil
+}
+
+func (r *FakeRuntimeService) ListInstanceStats(ctx context.Context, req *iri.ListInstanceStatsRequest) (*iri.ListInstanceStatsResponse, error) {
+	filter, err := listing.NewInstanceMatcher(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	var res []*iri.InstanceStats
+	for id, inst := range r.Instances {
+		stats, ok := r.Stats[id]
+		if !ok || !filter.MatchesInstance(&inst.Instance) {
+			continue
+		}
+		res = append(res, proto.Clone(stats).(*iri.InstanceStats))
+	}
+	return &iri.ListInstanceStatsResponse{Stats: res}, nil
+}
+
 func (r *FakeRuntimeService) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
 	r.Lock()
 	defer r.Unlock()
@@ -338,3 +525,14 @@ func (r *FakeRuntimeService) Exec(ctx context.Context, req *iri.ExecRequest) (*i
 	}
 	return &iri.ExecResponse{Url: url}, nil
 }
+
+func (r *FakeRuntimeService) PortForward(ctx context.Context, req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	var url string
+	if r.GetPortForwardURL != nil {
+		url = r.GetPortForwardURL(req)
+	}
+	return &iri.PortForwardResponse{Url: url}, nil
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+// DefaultImageSizeBytes is the size of images pulled by the fake image service if not specified otherwise.
+const DefaultImageSizeBytes = 1024 * 1024 * 1024
+
+type FakeImageService struct {
+	sync.RWMutex
+
+	// Images are the pulled images, keyed by image id.
+	Images map[string]*iri.Image
+	// ImageSizeBytes is the size of newly pulled images. Defaults to DefaultImageSizeBytes.
+	ImageSizeBytes uint64
+	// CapacityBytes is the capacity of the image filesystem.
+	CapacityBytes uint64
+	// Runtime is used to determine whether images are in use, if set.
+	Runtime *FakeRuntimeService
+}
+
+func NewFakeImageService(runtime *FakeRuntimeService) *FakeImageService {
+	return &FakeImageService{
+		Images:  make(map[string]*iri.Image),
+		Runtime: runtime,
+	}
+}
+
+func imageMatches(img *iri.Image, name string) bool {
+	return img.Id == name || img.Ref == name || slices.Contains(img.Names, name)
+}
+
+func (s *FakeImageService) inUse(img *iri.Image) bool {
+	if s.Runtime == nil {
+		return img.InUse
+	}
+
+	s.Runtime.RLock()
+	defer s.Runtime.RUnlock()
+
+	for _, inst := range s.Runtime.Instances {
+		if name := inst.GetSpec().GetImage().GetImage(); name != "" && imageMatches(img, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *FakeImageService) ListImages(ctx context.Context, req *iri.ListImagesRequest) (*iri.ListImagesResponse, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	filter := req.GetFilter().GetImage().GetImage()
+	var res []*iri.Image
+	for _, img := range s.Images {
+		if filter != "" && !imageMatches(img, filter) {
+			continue
+		}
+
+		img = proto.Clone(img).(*iri.Image)
+		img.InUse = s.inUse(img)
+		res = append(res, img)
+	}
+	return &iri.ListImagesResponse{Images: res}, nil
+}
+
+func (s *FakeImageService) PullImage(ctx context.Context, req *iri.PullImageRequest) (*iri.PullImageResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	name := req.GetImage().GetImage()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "must specify image")
+	}
+
+	for _, img := range s.Images {
+		if imageMatches(img, name) {
+			return &iri.PullImageResponse{ImageRef: img.Ref}, nil
+		}
+	}
+
+	size := s.ImageSizeBytes
+	if size == 0 {
+		size = DefaultImageSizeBytes
+	}
+	id := generateID(64)
+	img := &iri.Image{
+		Id:        id,
+		Ref:       name + "@sha256:" + id,
+		Names:     []string{name},
+		SizeBytes: size,
+	}
+	s.Images[id] = img
+	return &iri.PullImageResponse{ImageRef: img.Ref}, nil
+}
+
+func (s *FakeImageService) RemoveImage(ctx context.Context, req *iri.RemoveImageRequest) (*iri.RemoveImageResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	name := req.GetImage().GetImage()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "must specify image")
+	}
+
+	var removed []string
+	for id, img := range s.Images {
+		if !imageMatches(img, name) {
+			continue
+		}
+		if s.inUse(img) {
+			return nil, status.Errorf(codes.FailedPrecondition, "image %q is in use", name)
+		}
+		removed = append(removed, id)
+	}
+	if len(removed) == 0 {
+		return nil, status.Errorf(codes.NotFound, "image %q not found", name)
+	}
+
+	for _, id := range removed {
+		delete(s.Images, id)
+	}
+	return &iri.RemoveImageResponse{}, nil
+}
+
+func (s *FakeImageService) ImageFsInfo(ctx context.Context, req *iri.ImageFsInfoRequest) (*iri.ImageFsInfoResponse, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	var used uint64
+	for _, img := range s.Images {
+		used += img.SizeBytes
+	}
+
+	var available uint64
+	if s.CapacityBytes > used {
+		available = s.CapacityBytes - used
+	}
+	return &iri.ImageFsInfoResponse{
+		UsedBytes:      used,
+		CapacityBytes:  s.CapacityBytes,
+		AvailableBytes: available,
+	}, nil
+}
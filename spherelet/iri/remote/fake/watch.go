@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/iri-api/listing"
+	"spheric.cloud/spheric/spherelet/instance"
+)
+
+const (
+	// DefaultWatchHistorySize is the default number of events watches can be resumed from.
+	DefaultWatchHistorySize = 1000
+
+	watchBufferSize = 100
+)
+
+type fakeInstanceWatch struct {
+	ctx    context.Context
+	filter *listing.InstanceMatcher
+	events chan *iri.WatchInstancesResponse
+	// err is the error the watch was closed with. Only written before events is closed.
+	err error
+}
+
+func (w *fakeInstanceWatch) Recv() (*iri.WatchInstancesResponse, error) {
+	select {
+	case evt, ok := <-w.events:
+		if !ok {
+			return nil, w.err
+		}
+		return evt, nil
+	case <-w.ctx.Done():
+		return nil, status.FromContextError(w.ctx.Err()).Err()
+	}
+}
+
+func (r *FakeRuntimeService) resumeToken() string {
+	return strconv.FormatInt(r.revision, 10)
+}
+
+// emit records an event for the given instance and sends it to all matching watches.
+// Watches that don't keep up are closed. The caller has to hold the lock.
+func (r *FakeRuntimeService) emit(typ iri.WatchEventType, inst *iri.Instance) {
+	r.revision++
+	evt := &iri.WatchInstancesResponse{
+		Type:        typ,
+		Instance:    proto.Clone(inst).(*iri.Instance),
+		ResumeToken: r.resumeToken(),
+	}
+
+	historySize := r.WatchHistorySize
+	if historySize <= 0 {
+		historySize = DefaultWatchHistorySize
+	}
+	r.history = append(r.history, evt)
+	if len(r.history) > historySize {
+		r.history = r.history[len(r.history)-historySize:]
+	}
+
+	for w := range r.watches {
+		if !w.filter.MatchesInstance(inst) {
+			continue
+		}
+
+		select {
+		case w.events <- evt:
+		default:
+			w.err = status.Error(codes.ResourceExhausted, "watch did not keep up with events")
+			close(w.events)
+			delete(r.watches, w)
+		}
+	}
+}
+
+func (r *FakeRuntimeService) WatchInstances(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error) {
+	filter, err := listing.NewInstanceMatcher(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	var initial []*iri.WatchInstancesResponse
+	if req.ResumeToken == "" {
+		for _, inst := range r.Instances {
+			if !filter.MatchesInstance(&inst.Instance) {
+				continue
+			}
+			initial = append(initial, &iri.WatchInstancesResponse{
+				Type:        iri.WatchEventType_WATCH_EVENT_ADDED,
+				Instance:    proto.Clone(&inst.Instance).(*iri.Instance),
+				ResumeToken: r.resumeToken(),
+			})
+		}
+		initial = append(initial, &iri.WatchInstancesResponse{
+			Type:        iri.WatchEventType_WATCH_EVENT_SYNCED,
+			ResumeToken: r.resumeToken(),
+		})
+	} else {
+		revision, err := strconv.ParseInt(req.ResumeToken, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resume token %q", req.ResumeToken)
+		}
+		// All events after the resume token have to be retained to resume from it.
+		if revision > r.revision || revision < r.revision-int64(len(r.history)) {
+			return nil, status.Errorf(codes.OutOfRange, "resume token %q is no longer valid", req.ResumeToken)
+		}
+
+		for _, evt := range r.history[len(r.history)-int(r.revision-revision):] {
+			if filter.MatchesInstance(evt.Instance) {
+				initial = append(initial, evt)
+			}
+		}
+	}
+
+	w := &fakeInstanceWatch{
+		ctx:    ctx,
+		filter: filter,
+		events: make(chan *iri.WatchInstancesResponse, len(initial)+watchBufferSize),
+	}
+	for _, evt := range initial {
+		w.events <- evt
+	}
+
+	if r.watches == nil {
+		r.watches = make(map[*fakeInstanceWatch]struct{})
+	}
+	r.watches[w] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		r.Lock()
+		defer r.Unlock()
+		delete(r.watches, w)
+	}()
+	return w, nil
+}
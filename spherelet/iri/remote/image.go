@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package remote
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/instance"
+)
+
+type remoteImageService struct {
+	client iri.ImageServiceClient
+}
+
+// NewRemoteImageService connects to the runtime at the given endpoint. Unless overridden by the given options,
+// the connection is insecure.
+func NewRemoteImageService(endpoint string, opts ...grpc.DialOption) (instance.ImageService, error) {
+	conn, err := Dial(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewRemoteImageServiceFromConn(conn), nil
+}
+
+// NewRemoteImageServiceFromConn creates an image service using the given connection.
+func NewRemoteImageServiceFromConn(conn grpc.ClientConnInterface) instance.ImageService {
+	return &remoteImageService{
+		client: iri.NewImageServiceClient(conn),
+	}
+}
+
+func (r *remoteImageService) ListImages(ctx context.Context, req *iri.ListImagesRequest) (*iri.ListImagesResponse, error) {
+	return r.client.ListImages(ctx, req)
+}
+
+func (r *remoteImageService) PullImage(ctx context.Context, req *iri.PullImageRequest) (*iri.PullImageResponse, error) {
+	return r.client.PullImage(ctx, req)
+}
+
+func (r *remoteImageService) RemoveImage(ctx context.Context, req *iri.RemoveImageRequest) (*iri.RemoveImageResponse, error) {
+	return r.client.RemoveImage(ctx, req)
+}
+
+func (r *remoteImageService) ImageFsInfo(ctx context.Context, req *iri.ImageFsInfoRequest) (*iri.ImageFsInfoResponse, error) {
+	return r.client.ImageFsInfo(ctx, req)
+}
@@ -20,17 +20,32 @@ type remoteRuntime struct {
 	client iri.RuntimeServiceClient
 }
 
-func NewRemoteRuntime(endpoint string) (instance.RuntimeService, error) {
-	conn, err := grpc.NewClient(endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+// Dial creates a connection to the IRI endpoint that can be shared by the runtime and image service.
+// Unless overridden by the given options, the connection is insecure.
+func Dial(endpoint string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(endpoint, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error dialing: %w", err)
 	}
+	return conn, nil
+}
+
+// NewRemoteRuntime connects to the runtime at the given endpoint. Unless overridden by the given options,
+// the connection is insecure.
+func NewRemoteRuntime(endpoint string, opts ...grpc.DialOption) (instance.RuntimeService, error) {
+	conn, err := Dial(endpoint, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewRemoteRuntimeFromConn(conn), nil
+}
 
+// NewRemoteRuntimeFromConn creates a runtime using the given connection.
+func NewRemoteRuntimeFromConn(conn grpc.ClientConnInterface) instance.RuntimeService {
 	return &remoteRuntime{
 		client: iri.NewRuntimeServiceClient(conn),
-	}, nil
+	}
 }
 
 func (r *remoteRuntime) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
@@ -41,6 +56,10 @@ func (r *remoteRuntime) ListInstances(ctx context.Context, req *iri.ListInstance
 	return r.client.ListInstances(ctx, req)
 }
 
+func (r *remoteRuntime) WatchInstances(ctx context.Context, req *iri.WatchInstancesRequest) (instance.InstanceWatch, error) {
+	return r.client.WatchInstances(ctx, req)
+}
+
 func (r *remoteRuntime) CreateInstance(ctx context.Context, req *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error) {
 	return r.client.CreateInstance(ctx, req)
 }
@@ -57,6 +76,14 @@ func (r *remoteRuntime) UpdateInstancePower(ctx context.Context, req *iri.Update
 	return r.client.UpdateInstancePower(ctx, req)
 }
 
+func (r *remoteRuntime) RebootInstance(ctx context.Context, req *iri.RebootInstanceRequest) (*iri.RebootInstanceResponse, error) {
+	return r.client.RebootInstance(ctx, req)
+}
+
+func (r *remoteRuntime) UpdateInstanceResources(ctx context.Context, req *iri.UpdateInstanceResourcesRequest) (*iri.UpdateInstanceResourcesResponse, error) {
+	return r.client.UpdateInstanceResources(ctx, req)
+}
+
 func (r *remoteRuntime) AttachDisk(ctx context.Context, req *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error) {
 	return r.client.AttachDisk(ctx, req)
 }
@@ -77,6 +104,22 @@ func (r *remoteRuntime) Status(ctx context.Context, req *iri.StatusRequest) (*ir
 	return r.client.Status(ctx, req)
 }
 
+func (r *remoteRuntime) InstanceStats(ctx context.Context, req *iri.InstanceStatsRequest) (*iri.InstanceStatsResponse, error) {
+	return r.client.InstanceStats(ctx, req)
+}
+
+func (r *remoteRuntime) ListInstanceStats(ctx context.Context, req *iri.ListInstanceStatsRequest) (*iri.ListInstanceStatsResponse, error) {
+	return r.client.ListInstanceStats(ctx, req)
+}
+
 func (r *remoteRuntime) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
 	return r.client.Exec(ctx, req)
 }
+
+func (r *remoteRuntime) PortForward(ctx context.Context, req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	return r.client.PortForward(ctx, req)
+}
+
+func (r *remoteRuntime) GetConsoleLog(ctx context.Context, req *iri.GetConsoleLogRequest) (instance.ConsoleLogStream, error) {
+	return r.client.GetConsoleLog(ctx, req)
+}
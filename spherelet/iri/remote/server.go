@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package remote
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/instance"
+)
+
+type runtimeServer struct {
+	iri.UnimplementedRuntimeServiceServer
+
+	runtime instance.RuntimeService
+}
+
+// NewRuntimeServer returns an iri.RuntimeServiceServer that serves the given runtime.
+// It is the counterpart of NewRemoteRuntime and allows serving in-process runtimes over gRPC.
+func NewRuntimeServer(runtime instance.RuntimeService) iri.RuntimeServiceServer {
+	return &runtimeServer{
+		runtime: runtime,
+	}
+}
+
+func (s *runtimeServer) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	return s.runtime.Version(ctx, req)
+}
+
+func (s *runtimeServer) ListInstances(ctx context.Context, req *iri.ListInstancesRequest) (*iri.ListInstancesResponse, error) {
+	return s.runtime.ListInstances(ctx, req)
+}
+
+func (s *runtimeServer) WatchInstances(req *iri.WatchInstancesRequest, stream grpc.ServerStreamingServer[iri.WatchInstancesResponse]) error {
+	w, err := s.runtime.WatchInstances(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		evt, err := w.Recv()
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(evt); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *runtimeServer) CreateInstance(ctx context.Context, req *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error) {
+	return s.runtime.CreateInstance(ctx, req)
+}
+
+func (s *runtimeServer) DeleteInstance(ctx context.Context, req *iri.DeleteInstanceRequest) (*iri.DeleteInstanceResponse, error) {
+	return s.runtime.DeleteInstance(ctx, req)
+}
+
+func (s *runtimeServer) UpdateInstanceAnnotations(ctx context.Context, req *iri.UpdateInstanceAnnotationsRequest) (*iri.UpdateInstanceAnnotationsResponse, error) {
+	return s.runtime.UpdateInstanceAnnotations(ctx, req)
+}
+
+func (s *runtimeServer) UpdateInstancePower(ctx context.Context, req *iri.UpdateInstancePowerRequest) (*iri.UpdateInstancePowerResponse, error) {
+	return s.runtime.UpdateInstancePower(ctx, req)
+}
+
+func (s *runtimeServer) RebootInstance(ctx context.Context, req *iri.RebootInstanceRequest) (*iri.RebootInstanceResponse, error) {
+	return s.runtime.RebootInstance(ctx, req)
+}
+
+func (s *runtimeServer) UpdateInstanceResources(ctx context.Context, req *iri.UpdateInstanceResourcesRequest) (*iri.UpdateInstanceResourcesResponse, error) {
+	return s.runtime.UpdateInstanceResources(ctx, req)
+}
+
+func (s *runtimeServer) AttachDisk(ctx context.Context, req *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error) {
+	return s.runtime.AttachDisk(ctx, req)
+}
+
+func (s *runtimeServer) DetachDisk(ctx context.Context, req *iri.DetachDiskRequest) (*iri.DetachDiskResponse, error) {
+	return s.runtime.DetachDisk(ctx, req)
+}
+
+func (s *runtimeServer) AttachNetworkInterface(ctx context.Context, req *iri.AttachNetworkInterfaceRequest) (*iri.AttachNetworkInterfaceResponse, error) {
+	return s.runtime.AttachNetworkInterface(ctx, req)
+}
+
+func (s *runtimeServer) DetachNetworkInterface(ctx context.Context, req *iri.DetachNetworkInterfaceRequest) (*iri.DetachNetworkInterfaceResponse, error) {
+	return s.runtime.DetachNetworkInterface(ctx, req)
+}
+
+func (s *runtimeServer) Status(ctx context.Context, req *iri.StatusRequest) (*iri.StatusResponse, error) {
+	return s.runtime.Status(ctx, req)
+}
+
+func (s *runtimeServer) InstanceStats(ctx context.Context, req *iri.InstanceStatsRequest) (*iri.InstanceStatsResponse, error) {
+	return s.runtime.InstanceStats(ctx, req)
+}
+
+func (s *runtimeServer) ListInstanceStats(ctx context.Context, req *iri.ListInstanceStatsRequest) (*iri.ListInstanceStatsResponse, error) {
+	return s.runtime.ListInstanceStats(ctx, req)
+}
+
+func (s *runtimeServer) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
+	return s.runtime.Exec(ctx, req)
+}
+
+func (s *runtimeServer) PortForward(ctx context.Context, req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	return s.runtime.PortForward(ctx, req)
+}
+
+func (s *runtimeServer) GetConsoleLog(req *iri.GetConsoleLogRequest, stream grpc.ServerStreamingServer[iri.GetConsoleLogResponse]) error {
+	logs, err := s.runtime.GetConsoleLog(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := logs.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
+
+type imageServer struct {
+	iri.UnimplementedImageServiceServer
+
+	images instance.ImageService
+}
+
+// NewImageServer returns an iri.ImageServiceServer that serves the given image service.
+// It is the counterpart of NewRemoteImageService.
+func NewImageServer(images instance.ImageService) iri.ImageServiceServer {
+	return &imageServer{
+		images: images,
+	}
+}
+
+func (s *imageServer) ListImages(ctx context.Context, req *iri.ListImagesRequest) (*iri.ListImagesResponse, error) {
+	return s.images.ListImages(ctx, req)
+}
+
+func (s *imageServer) PullImage(ctx context.Context, req *iri.PullImageRequest) (*iri.PullImageResponse, error) {
+	return s.images.PullImage(ctx, req)
+}
+
+func (s *imageServer) RemoveImage(ctx context.Context, req *iri.RemoveImageRequest) (*iri.RemoveImageResponse, error) {
+	return s.images.RemoveImage(ctx, req)
+}
+
+func (s *imageServer) ImageFsInfo(ctx context.Context, req *iri.ImageFsInfoRequest) (*iri.ImageFsInfoResponse, error) {
+	return s.images.ImageFsInfo(ctx, req)
+}
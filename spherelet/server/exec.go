@@ -6,6 +6,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -22,25 +23,17 @@ func (s *Server) serveExec(w http.ResponseWriter, req *http.Request, namespace,
 	ctx := req.Context()
 	log := ctrl.LoggerFrom(ctx)
 
-	listInstancesRes, err := s.runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
-		Filter: &iri.InstanceFilter{
-			LabelSelector: map[string]string{
-				v1alpha1.InstanceNamespaceLabel: namespace,
-				v1alpha1.InstanceNameLabel:      name,
-			},
-		},
-	})
+	instance, err := s.getInstance(ctx, namespace, name)
 	if err != nil {
-		log.Error(err, "Error listing instances")
+		log.Error(err, "Error getting instance")
 		s.writeError(w, err)
 		return
 	}
-	if len(listInstancesRes.Instances) == 0 {
+	if instance == nil {
 		http.Error(w, "instance not found", http.StatusNotFound)
 		return
 	}
 
-	instance := listInstancesRes.Instances[0]
 	execRes, err := s.runtimeService.Exec(ctx, &iri.ExecRequest{
 		InstanceId: instance.Metadata.Id,
 	})
@@ -60,13 +53,37 @@ func (s *Server) serveExec(w http.ResponseWriter, req *http.Request, namespace,
 	proxyStream(w, req, execURL)
 }
 
+// getInstance returns the iri instance of the instance with the given namespace and name, or nil if there is none.
+func (s *Server) getInstance(ctx context.Context, namespace, name string) (*iri.Instance, error) {
+	res, err := s.runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{
+			LabelSelector: map[string]string{
+				v1alpha1.InstanceNamespaceLabel: namespace,
+				v1alpha1.InstanceNameLabel:      name,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Instances) == 0 {
+		return nil, nil
+	}
+	return res.Instances[0], nil
+}
+
 func (s *Server) writeError(w http.ResponseWriter, err error) {
 	status, _ := grpcstatus.FromError(err)
 	var code int
 	switch status.Code() {
 	case codes.NotFound:
 		code = http.StatusNotFound
+	case codes.FailedPrecondition:
+		code = http.StatusConflict
+	case codes.Unimplemented:
+		code = http.StatusNotImplemented
 	case codes.ResourceExhausted:
+		code = http.StatusTooManyRequests
 		w.Header().Set("Retry-After", strconv.Itoa(int(s.cacheTTL.Seconds())))
 	default:
 		code = http.StatusInternalServerError
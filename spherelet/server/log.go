@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+func (s *Server) serveLog(w http.ResponseWriter, req *http.Request, namespace, name string) {
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx)
+
+	instance, err := s.getInstance(ctx, namespace, name)
+	if err != nil {
+		log.Error(err, "Error getting instance")
+		s.writeError(w, err)
+		return
+	}
+	if instance == nil {
+		http.Error(w, "instance not found", http.StatusNotFound)
+		return
+	}
+
+	logReq, err := parseLogRequest(req.URL.Query(), time.Now())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	logReq.InstanceId = instance.Metadata.Id
+
+	stream, err := s.runtimeService.GetConsoleLog(ctx, logReq)
+	if err != nil {
+		log.Error(err, "Error getting console log")
+		s.writeError(w, err)
+		return
+	}
+
+	// Errors of the stream are only known after the first response, so they can still be reported properly.
+	res, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Error(err, "Error reading console log")
+		s.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	for err == nil {
+		if _, err := w.Write(res.Data); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		res, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+		log.Error(err, "Error streaming console log")
+	}
+}
+
+// parseLogRequest parses the 'tailLines', 'sinceSeconds' and 'follow' query parameters.
+func parseLogRequest(query url.Values, now time.Time) (*iri.GetConsoleLogRequest, error) {
+	req := &iri.GetConsoleLogRequest{}
+	if tailLinesString := query.Get("tailLines"); tailLinesString != "" {
+		tailLines, err := strconv.ParseInt(tailLinesString, 10, 64)
+		if err != nil || tailLines < 0 {
+			return nil, fmt.Errorf("invalid tailLines %q", tailLinesString)
+		}
+		req.TailLines = &tailLines
+	}
+	if sinceSecondsString := query.Get("sinceSeconds"); sinceSecondsString != "" {
+		sinceSeconds, err := strconv.ParseInt(sinceSecondsString, 10, 64)
+		if err != nil || sinceSeconds < 1 {
+			return nil, fmt.Errorf("invalid sinceSeconds %q", sinceSecondsString)
+		}
+		req.SinceTime = now.Add(-time.Duration(sinceSeconds) * time.Second).UnixNano()
+	}
+	if followString := query.Get("follow"); followString != "" {
+		follow, err := strconv.ParseBool(followString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid follow %q", followString)
+		}
+		req.Follow = follow
+	}
+	return req, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+func (s *Server) servePortForward(w http.ResponseWriter, req *http.Request, namespace, name string) {
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx)
+
+	ports, err := parsePorts(req.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	instance, err := s.getInstance(ctx, namespace, name)
+	if err != nil {
+		log.Error(err, "Error getting instance")
+		s.writeError(w, err)
+		return
+	}
+	if instance == nil {
+		http.Error(w, "instance not found", http.StatusNotFound)
+		return
+	}
+
+	portForwardRes, err := s.runtimeService.PortForward(ctx, &iri.PortForwardRequest{
+		InstanceId: instance.Metadata.Id,
+		Port:       ports,
+	})
+	if err != nil {
+		log.Error(err, "Error getting port forward url")
+		s.writeError(w, err)
+		return
+	}
+
+	portForwardURL, err := url.Parse(portForwardRes.Url)
+	if err != nil {
+		log.Error(err, "Error parsing port forward url")
+		s.writeError(w, err)
+		return
+	}
+
+	proxyStream(w, req, portForwardURL)
+}
+
+// parsePorts parses the ports to forward from the 'port' query parameters.
+func parsePorts(query url.Values) ([]int32, error) {
+	var ports []int32
+	for _, portString := range query["port"] {
+		port, err := strconv.ParseUint(portString, 10, 16)
+		if err != nil || port == 0 {
+			return nil, fmt.Errorf("invalid port %q", portString)
+		}
+		ports = append(ports, int32(port))
+	}
+	return ports, nil
+}
@@ -400,7 +400,22 @@ func (s *Server) registerCoreRoutes(r chi.Router) {
 			name := chi.URLParam(req, "name")
 			s.serveExec(w, req, namespace, name)
 		})
+		r.MethodFunc(method, "/namespaces/{namespace}/instances/{name}/portforward", func(w http.ResponseWriter, req *http.Request) {
+			namespace := chi.URLParam(req, "namespace")
+			name := chi.URLParam(req, "name")
+			s.servePortForward(w, req, namespace, name)
+		})
 	}
+	r.Get("/namespaces/{namespace}/instances/{name}/stats", func(w http.ResponseWriter, req *http.Request) {
+		namespace := chi.URLParam(req, "namespace")
+		name := chi.URLParam(req, "name")
+		s.serveStats(w, req, namespace, name)
+	})
+	r.Get("/namespaces/{namespace}/instances/{name}/log", func(w http.ResponseWriter, req *http.Request) {
+		namespace := chi.URLParam(req, "namespace")
+		name := chi.URLParam(req, "name")
+		s.serveLog(w, req, namespace, name)
+	})
 }
 
 func (s *Server) tlsConfig() (*tls.Config, error) {
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/spherelet/api/v1alpha1"
+)
+
+func (s *Server) serveStats(w http.ResponseWriter, req *http.Request, namespace, name string) {
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx)
+
+	instance, err := s.getInstance(ctx, namespace, name)
+	if err != nil {
+		log.Error(err, "Error getting instance")
+		s.writeError(w, err)
+		return
+	}
+	if instance == nil {
+		http.Error(w, "instance not found", http.StatusNotFound)
+		return
+	}
+
+	res, err := s.runtimeService.InstanceStats(ctx, &iri.InstanceStatsRequest{
+		InstanceId: instance.Metadata.Id,
+	})
+	if err != nil {
+		log.Error(err, "Error getting instance stats")
+		s.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(convertInstanceStats(res.Stats))
+}
+
+func convertInstanceStats(stats *iri.InstanceStats) *v1alpha1.InstanceStats {
+	res := &v1alpha1.InstanceStats{
+		Time: metav1.NewTime(time.Unix(0, stats.Timestamp)),
+	}
+	if cpu := stats.Cpu; cpu != nil {
+		res.CPU = &v1alpha1.CPUStats{
+			UsageCoreNanoSeconds: cpu.UsageCoreNanoseconds,
+		}
+	}
+	if memory := stats.Memory; memory != nil {
+		res.Memory = &v1alpha1.MemoryStats{
+			UsageBytes:     memory.UsageBytes,
+			AvailableBytes: memory.AvailableBytes,
+		}
+	}
+	for _, disk := range stats.Disks {
+		res.Disks = append(res.Disks, v1alpha1.DiskStats{
+			Name:       disk.Name,
+			ReadBytes:  disk.ReadBytes,
+			WriteBytes: disk.WriteBytes,
+			ReadOps:    disk.ReadOps,
+			WriteOps:   disk.WriteOps,
+		})
+	}
+	for _, nic := range stats.NetworkInterfaces {
+		res.NetworkInterfaces = append(res.NetworkInterfaces, v1alpha1.NetworkInterfaceStats{
+			Name:      nic.Name,
+			RxBytes:   nic.RxBytes,
+			RxPackets: nic.RxPackets,
+			TxBytes:   nic.TxBytes,
+			TxPackets: nic.TxPackets,
+		})
+	}
+	return res
+}
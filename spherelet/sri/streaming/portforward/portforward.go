@@ -0,0 +1,98 @@
+/*
+Copyright 2015 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// ProtocolV1Name is the subprotocol used for port forwarding.
+const ProtocolV1Name = "portforward.k8s.io"
+
+// SupportedProtocols are the supported port forwarding protocols.
+var SupportedProtocols = []string{ProtocolV1Name}
+
+// PortForwarder knows how to forward content from a data stream to / from a port of an instance.
+type PortForwarder interface {
+	// PortForward copies data between a data stream and a port of an instance.
+	PortForward(ctx context.Context, port int32, stream io.ReadWriteCloser) error
+}
+
+type PortForwarderFunc func(ctx context.Context, port int32, stream io.ReadWriteCloser) error
+
+func (f PortForwarderFunc) PortForward(ctx context.Context, port int32, stream io.ReadWriteCloser) error {
+	return f(ctx, port, stream)
+}
+
+type PortForwardHandlerOptions struct {
+	SupportedProtocols []string
+
+	StreamIdleTimeout     time.Duration
+	StreamCreationTimeout time.Duration
+}
+
+type PortForwardHandler struct {
+	forwarder PortForwarder
+
+	supportedProtocols    []string
+	streamIdleTimeout     time.Duration
+	streamCreationTimeout time.Duration
+}
+
+func setPortForwardHandlerOptionsDefaults(o *PortForwardHandlerOptions) {
+	if o.SupportedProtocols == nil {
+		o.SupportedProtocols = SupportedProtocols
+	}
+	if o.StreamIdleTimeout == 0 {
+		o.StreamIdleTimeout = 4 * time.Hour
+	}
+	if o.StreamCreationTimeout == 0 {
+		o.StreamCreationTimeout = 30 * time.Second
+	}
+}
+
+func NewPortForwardHandler(forwarder PortForwarder, opts PortForwardHandlerOptions) (*PortForwardHandler, error) {
+	if forwarder == nil {
+		return nil, fmt.Errorf("must specify forwarder")
+	}
+
+	setPortForwardHandlerOptionsDefaults(&opts)
+
+	return &PortForwardHandler{
+		forwarder:             forwarder,
+		supportedProtocols:    opts.SupportedProtocols,
+		streamIdleTimeout:     opts.StreamIdleTimeout,
+		streamCreationTimeout: opts.StreamCreationTimeout,
+	}, nil
+}
+
+// Handle handles a port forwarding request. A single request is kept alive as long as the client is
+// still alive and the connection has not been timed out due to idleness. This function handles
+// multiple forwarded connections; i.e., multiple `curl http://localhost:8888/` requests will be
+// handled by a single invocation of Handle.
+func (h *PortForwardHandler) Handle(w http.ResponseWriter, req *http.Request) {
+	log := ctrl.LoggerFrom(req.Context())
+	if err := handleHTTPStreams(req, w, h.forwarder, h.supportedProtocols, h.streamIdleTimeout, h.streamCreationTimeout); err != nil {
+		log.Error(err, "Error handling port forward")
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
+)
+
+// ReadTokenFile reads the tokens of the given file, one per line. Empty lines are ignored.
+func ReadTokenFile(filename string) ([]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error reading token file: %w", err)
+	}
+
+	var tokens []string
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		if token := strings.TrimSpace(sc.Text()); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("error reading token file: %w", err)
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no tokens found in token file %s", filename)
+	}
+	return tokens, nil
+}
+
+type tokenCredentials struct {
+	token string
+}
+
+// TokenCredentials returns credentials.PerRPCCredentials sending the given token as bearer token.
+// The credentials require a secure transport, i.e. TLS or local credentials.
+func TokenCredentials(token string) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationMetadataKey: bearerPrefix + c.token}, nil
+}
+
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// TokenAuthenticator authenticates requests by the bearer token sent by TokenCredentials.
+type TokenAuthenticator struct {
+	tokens [][]byte
+}
+
+// NewTokenAuthenticator creates a new TokenAuthenticator accepting any of the given tokens.
+func NewTokenAuthenticator(tokens ...string) *TokenAuthenticator {
+	a := &TokenAuthenticator{}
+	for _, token := range tokens {
+		a.tokens = append(a.tokens, []byte(token))
+	}
+	return a
+}
+
+func (a *TokenAuthenticator) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadataKey)
+	if len(values) != 1 {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	token, ok := strings.CutPrefix(values[0], bearerPrefix)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	var valid int
+	for _, expected := range a.tokens {
+		valid |= subtle.ConstantTimeCompare([]byte(token), expected)
+	}
+	if valid != 1 {
+		return status.Error(codes.Unauthenticated, "invalid bearer token")
+	}
+	return nil
+}
+
+// UnaryServerInterceptor rejects unary requests without a valid bearer token.
+func (a *TokenAuthenticator) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rejects streams without a valid bearer token.
+func (a *TokenAuthenticator) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	. "spheric.cloud/spheric/utils/grpc"
+)
+
+var _ = Describe("Auth", func() {
+	Describe("ReadTokenFile", func() {
+		It("should read one token per line, skipping empty lines", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "tokens")
+			Expect(os.WriteFile(filename, []byte("foo\n\n  bar  \n"), 0600)).To(Succeed())
+
+			Expect(ReadTokenFile(filename)).To(Equal([]string{"foo", "bar"}))
+		})
+
+		It("should error if the file contains no tokens", func() {
+			filename := filepath.Join(GinkgoT().TempDir(), "tokens")
+			Expect(os.WriteFile(filename, []byte("\n"), 0600)).To(Succeed())
+
+			_, err := ReadTokenFile(filename)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("TokenAuthenticator", func() {
+		var (
+			auth    *TokenAuthenticator
+			handler grpc.UnaryHandler
+		)
+		BeforeEach(func() {
+			auth = NewTokenAuthenticator("foo", "bar")
+			handler = func(ctx context.Context, req any) (any, error) {
+				return "ok", nil
+			}
+		})
+
+		call := func(ctx context.Context) (any, error) {
+			return auth.UnaryServerInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+		}
+
+		contextWithToken := func(token string) context.Context {
+			md, err := TokenCredentials(token).GetRequestMetadata(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+			return metadata.NewIncomingContext(context.TODO(), metadata.New(md))
+		}
+
+		It("should accept any of the configured tokens", func() {
+			Expect(call(contextWithToken("foo"))).To(Equal("ok"))
+			Expect(call(contextWithToken("bar"))).To(Equal("ok"))
+		})
+
+		It("should reject an invalid token", func() {
+			_, err := call(contextWithToken("baz"))
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject requests without a token", func() {
+			_, err := call(context.TODO())
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+
+		It("should reject non-bearer authorization", func() {
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("authorization", "Basic foo"))
+			_, err := call(ctx)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+})
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"net"
+
+	utilos "spheric.cloud/spheric/utils/os"
+)
+
+// Listen listens on the TCP address if set, otherwise on the unix socket, replacing a stale socket file.
+// Authentication of TCP clients is configured via ServerSecurityOptions.
+func Listen(address, socket string) (net.Listener, error) {
+	if address != "" {
+		return net.Listen("tcp", address)
+	}
+
+	if err := utilos.EnsureSocketGone(socket); err != nil {
+		return nil, err
+	}
+	return net.Listen("unix", socket)
+}
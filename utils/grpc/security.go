@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials/local"
+)
+
+// ClientSecurityOptions configure transport security and authentication of gRPC clients.
+type ClientSecurityOptions struct {
+	// TLSCAFile is the CA bundle to verify the server certificate with. Enables TLS.
+	TLSCAFile string
+	// TLSCertFile is the client certificate for mutual TLS. Enables TLS.
+	TLSCertFile string
+	// TLSKeyFile is the key of the client certificate.
+	TLSKeyFile string
+	// TLSServerName overrides the server name to verify the server certificate for.
+	TLSServerName string
+	// TokenFile is a file containing a bearer token to authenticate with.
+	TokenFile string
+}
+
+// BindFlags binds the options to flags prefixed with the given prefix.
+func (o *ClientSecurityOptions) BindFlags(fs *pflag.FlagSet, prefix string) {
+	fs.StringVar(&o.TLSCAFile, prefix+"tls-ca-file", o.TLSCAFile, "CA bundle to verify the server certificate with. Enables TLS.")
+	fs.StringVar(&o.TLSCertFile, prefix+"tls-cert-file", o.TLSCertFile, "Client certificate to present to the server. Enables TLS.")
+	fs.StringVar(&o.TLSKeyFile, prefix+"tls-key-file", o.TLSKeyFile, "Key of the client certificate.")
+	fs.StringVar(&o.TLSServerName, prefix+"tls-server-name", o.TLSServerName, "Server name to verify the server certificate for.")
+	fs.StringVar(&o.TokenFile, prefix+"token-file", o.TokenFile,
+		"File containing a bearer token to authenticate with. Requires TLS unless connecting to a local endpoint.")
+}
+
+func (o *ClientSecurityOptions) tlsEnabled() bool {
+	return o.TLSCAFile != "" || o.TLSCertFile != "" || o.TLSServerName != ""
+}
+
+// DialOptions returns the grpc.DialOption to dial with.
+// Without TLS, connections are insecure unless a token is used, in which case only local endpoints are allowed.
+func (o *ClientSecurityOptions) DialOptions() ([]grpc.DialOption, error) {
+	var (
+		opts  []grpc.DialOption
+		creds credentials.TransportCredentials
+	)
+	switch {
+	case o.tlsEnabled():
+		cfg, err := ClientTLSConfig(o.TLSCAFile, o.TLSCertFile, o.TLSKeyFile, o.TLSServerName)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(cfg)
+	case o.TokenFile != "":
+		creds = local.NewCredentials()
+	default:
+		creds = insecure.NewCredentials()
+	}
+	opts = append(opts, grpc.WithTransportCredentials(creds))
+
+	if o.TokenFile != "" {
+		tokens, err := ReadTokenFile(o.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(TokenCredentials(tokens[0])))
+	}
+	return opts, nil
+}
+
+// ServerSecurityOptions configure transport security and authentication of gRPC servers.
+type ServerSecurityOptions struct {
+	// TLSCertFile is the server certificate. Enables TLS.
+	TLSCertFile string
+	// TLSKeyFile is the key of the server certificate.
+	TLSKeyFile string
+	// TLSClientCAFile is the CA bundle to verify client certificates with. Enables mutual TLS, requires TLS.
+	TLSClientCAFile string
+	// TokenFile is a file containing the bearer tokens to accept, one per line. Enables token authentication, requires TLS.
+	TokenFile string
+}
+
+// BindFlags binds the options to flags.
+func (o *ServerSecurityOptions) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", o.TLSCertFile, "Server certificate to serve with. Enables TLS.")
+	fs.StringVar(&o.TLSKeyFile, "tls-key-file", o.TLSKeyFile, "Key of the server certificate.")
+	fs.StringVar(&o.TLSClientCAFile, "tls-client-ca-file", o.TLSClientCAFile,
+		"CA bundle to verify client certificates with. If set, clients have to present a valid certificate. Requires TLS.")
+	fs.StringVar(&o.TokenFile, "token-file", o.TokenFile,
+		"File containing the bearer tokens to accept, one per line. Enables token authentication. Requires TLS.")
+}
+
+// TLSEnabled reports whether the server serves TLS.
+func (o *ServerSecurityOptions) TLSEnabled() bool {
+	return o.TLSCertFile != ""
+}
+
+// ServerOptions returns the grpc.ServerOption to serve with.
+// Client certificate and token authentication require TLS, so the server doesn't silently accept
+// unauthenticated connections if the server certificate is missing.
+func (o *ServerSecurityOptions) ServerOptions() ([]grpc.ServerOption, error) {
+	if !o.TLSEnabled() && (o.TLSKeyFile != "" || o.TLSClientCAFile != "" || o.TokenFile != "") {
+		return nil, fmt.Errorf("must specify tls certificate and key file to use a key file, client CA file or token file")
+	}
+
+	var opts []grpc.ServerOption
+	if o.TLSEnabled() {
+		cfg, err := ServerTLSConfig(o.TLSCertFile, o.TLSKeyFile, o.TLSClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(cfg)))
+	}
+
+	if o.TokenFile != "" {
+		tokens, err := ReadTokenFile(o.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		auth := NewTokenAuthenticator(tokens...)
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor),
+		)
+	}
+	return opts, nil
+}
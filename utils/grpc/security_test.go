@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	. "spheric.cloud/spheric/utils/grpc"
+)
+
+// testCA issues certificates for testing.
+type testCA struct {
+	key  crypto.Signer
+	cert *x509.Certificate
+}
+
+func newTestCA() *testCA {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	data, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(data)
+	Expect(err).NotTo(HaveOccurred())
+	return &testCA{key: key, cert: cert}
+}
+
+// writeCA writes the certificate of the CA to dir and returns its path.
+func (c *testCA) writeCA(dir, name string) string {
+	GinkgoHelper()
+
+	filename := filepath.Join(dir, name+".crt")
+	Expect(os.WriteFile(filename, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0600)).To(Succeed())
+	return filename
+}
+
+// writeCert issues a certificate for the given usage, writes it and its key to dir and returns their paths.
+func (c *testCA) writeCert(dir, name string, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	Expect(err).NotTo(HaveOccurred())
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	data, err := x509.CreateCertificate(rand.Reader, tmpl, c.cert, key.Public(), c.key)
+	Expect(err).NotTo(HaveOccurred())
+	keyData, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: data}), 0600)).To(Succeed())
+	Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyData}), 0600)).To(Succeed())
+	return certFile, keyFile
+}
+
+var _ = Describe("Security", func() {
+	var (
+		dir                  string
+		ca                   *testCA
+		caFile               string
+		serverCert           string
+		serverKey            string
+		clientCert           string
+		clientKey            string
+		otherClientCert      string
+		otherClientKey       string
+		tokenFile            string
+		clientTokenFile      string
+		wrongClientTokenFile string
+	)
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		ca = newTestCA()
+		caFile = ca.writeCA(dir, "ca")
+		serverCert, serverKey = ca.writeCert(dir, "server", x509.ExtKeyUsageServerAuth)
+		clientCert, clientKey = ca.writeCert(dir, "client", x509.ExtKeyUsageClientAuth)
+		otherClientCert, otherClientKey = newTestCA().writeCert(dir, "other-client", x509.ExtKeyUsageClientAuth)
+
+		tokenFile = filepath.Join(dir, "tokens")
+		Expect(os.WriteFile(tokenFile, []byte("foo\nbar\n"), 0600)).To(Succeed())
+		clientTokenFile = filepath.Join(dir, "token")
+		Expect(os.WriteFile(clientTokenFile, []byte("bar\n"), 0600)).To(Succeed())
+		wrongClientTokenFile = filepath.Join(dir, "wrong-token")
+		Expect(os.WriteFile(wrongClientTokenFile, []byte("baz\n"), 0600)).To(Succeed())
+	})
+
+	// serve serves the health service with the given options on a local TCP address and returns the address.
+	serve := func(opts ServerSecurityOptions) string {
+		GinkgoHelper()
+
+		srvOpts, err := opts.ServerOptions()
+		Expect(err).NotTo(HaveOccurred())
+
+		srv := grpc.NewServer(srvOpts...)
+		healthv1.RegisterHealthServer(srv, health.NewServer())
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		go func() { _ = srv.Serve(l) }()
+		DeferCleanup(srv.Stop)
+		return l.Addr().String()
+	}
+
+	// check calls the health service at address with the given options.
+	check := func(ctx context.Context, address string, opts ClientSecurityOptions) error {
+		GinkgoHelper()
+
+		dialOpts, err := opts.DialOptions()
+		Expect(err).NotTo(HaveOccurred())
+
+		conn, err := grpc.NewClient(address, dialOpts...)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(conn.Close)
+
+		_, err = healthv1.NewHealthClient(conn).Check(ctx, &healthv1.HealthCheckRequest{})
+		return err
+	}
+
+	Describe("ServerSecurityOptions", func() {
+		DescribeTable("should error if authentication is configured without a server certificate",
+			func(opts func() ServerSecurityOptions) {
+				o := opts()
+				_, err := o.ServerOptions()
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("client CA file", func() ServerSecurityOptions {
+				return ServerSecurityOptions{TLSClientCAFile: caFile}
+			}),
+			Entry("token file", func() ServerSecurityOptions {
+				return ServerSecurityOptions{TokenFile: tokenFile}
+			}),
+			Entry("key file", func() ServerSecurityOptions {
+				return ServerSecurityOptions{TLSKeyFile: serverKey}
+			}),
+			Entry("certificate file without key file", func() ServerSecurityOptions {
+				return ServerSecurityOptions{TLSCertFile: serverCert, TokenFile: tokenFile}
+			}),
+		)
+
+		It("should serve TLS", func(ctx SpecContext) {
+			address := serve(ServerSecurityOptions{TLSCertFile: serverCert, TLSKeyFile: serverKey})
+
+			By("calling with a client trusting the CA")
+			Expect(check(ctx, address, ClientSecurityOptions{TLSCAFile: caFile})).To(Succeed())
+
+			By("calling with a client not trusting the CA")
+			otherCAFile := newTestCA().writeCA(dir, "other-ca")
+			Expect(check(ctx, address, ClientSecurityOptions{TLSCAFile: otherCAFile})).
+				To(HaveOccurred())
+		})
+
+		It("should require a valid client certificate with mutual TLS", func(ctx SpecContext) {
+			address := serve(ServerSecurityOptions{
+				TLSCertFile:     serverCert,
+				TLSKeyFile:      serverKey,
+				TLSClientCAFile: caFile,
+			})
+
+			By("calling with a valid client certificate")
+			Expect(check(ctx, address, ClientSecurityOptions{
+				TLSCAFile:   caFile,
+				TLSCertFile: clientCert,
+				TLSKeyFile:  clientKey,
+			})).To(Succeed())
+
+			By("calling without a client certificate")
+			Expect(check(ctx, address, ClientSecurityOptions{TLSCAFile: caFile})).
+				To(HaveOccurred())
+
+			By("calling with a client certificate of another CA")
+			Expect(check(ctx, address, ClientSecurityOptions{
+				TLSCAFile:   caFile,
+				TLSCertFile: otherClientCert,
+				TLSKeyFile:  otherClientKey,
+			})).To(HaveOccurred())
+		})
+
+		It("should require a valid token with token authentication", func(ctx SpecContext) {
+			address := serve(ServerSecurityOptions{
+				TLSCertFile: serverCert,
+				TLSKeyFile:  serverKey,
+				TokenFile:   tokenFile,
+			})
+
+			By("calling with a valid token")
+			Expect(check(ctx, address, ClientSecurityOptions{TLSCAFile: caFile, TokenFile: clientTokenFile})).
+				To(Succeed())
+
+			By("calling with an invalid token")
+			err := check(ctx, address, ClientSecurityOptions{TLSCAFile: caFile, TokenFile: wrongClientTokenFile})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+			By("calling without a token")
+			err = check(ctx, address, ClientSecurityOptions{TLSCAFile: caFile})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+})
@@ -4,6 +4,8 @@
 package testing
 
 import (
+	"slices"
+
 	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/types"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
@@ -39,6 +41,7 @@ func GetInstanceByUID(srv *fake.FakeRuntimeService, inst *fake.FakeInstance) fun
 
 		proto.Reset(inst)
 		proto.Merge(inst, found)
+		inst.Reboots = slices.Clone(found.Reboots)
 		return nil
 	}
 }
@@ -54,6 +57,7 @@ func GetInstance(srv *fake.FakeRuntimeService, inst *fake.FakeInstance) func() e
 
 		proto.Reset(inst)
 		proto.Merge(inst, found)
+		inst.Reboots = slices.Clone(found.Reboots)
 		return nil
 	}
 }
@@ -69,6 +73,7 @@ func Instance(srv *fake.FakeRuntimeService, inst *fake.FakeInstance) func() (*fa
 
 		proto.Reset(inst)
 		proto.Merge(inst, found)
+		inst.Reboots = slices.Clone(found.Reboots)
 		return inst, nil
 	}
 }
@@ -83,6 +88,7 @@ func UpdateInstance(srv *fake.FakeRuntimeService, inst *fake.FakeInstance, updat
 
 		proto.Reset(inst)
 		proto.Merge(inst, found)
+		inst.Reboots = slices.Clone(found.Reboots)
 
 		update()
 
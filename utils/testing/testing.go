@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"maps"
 	"math/rand"
+	"os"
 	"slices"
 	"strings"
 	"sync"
@@ -566,3 +567,16 @@ func DeleteIgnoreNotFound(c client.Client, obj client.Object) func(ctx context.C
 		return client.IgnoreNotFound(err)
 	}
 }
+
+// ShortSocketDir creates a temporary directory for unix sockets and removes it on cleanup.
+// Unix socket paths are limited in length, so it avoids the long default temporary directory.
+func ShortSocketDir(t ginkgo.GinkgoTInterface) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("/tmp", "spheric")
+	if err != nil {
+		t.Fatalf("error creating socket directory: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return dir
+}
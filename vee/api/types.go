@@ -3,15 +3,168 @@
 
 package api
 
-import "spheric.cloud/spheric/actuo/meta"
+import (
+	"path"
+
+	"spheric.cloud/spheric/actuo/meta"
+)
+
+// InstancesKey is the store key all instances are stored under.
+const InstancesKey = "/instances"
+
+// InstanceKey returns the store key of the instance with the given id.
+func InstanceKey(id string) string {
+	return path.Join(InstancesKey, id)
+}
+
+const (
+	// DiskDeviceIDPrefix prefixes the vm device ids of the disks of an instance.
+	DiskDeviceIDPrefix = "disk-"
+	// NetworkInterfaceDeviceIDPrefix prefixes the vm device ids of the network interfaces of an instance.
+	NetworkInterfaceDeviceIDPrefix = "nic-"
+)
+
+// DiskDeviceID returns the vm device id of the disk with the given name.
+func DiskDeviceID(name string) string {
+	return DiskDeviceIDPrefix + name
+}
+
+// NetworkInterfaceDeviceID returns the vm device id of the network interface with the given name.
+func NetworkInterfaceDeviceID(name string) string {
+	return NetworkInterfaceDeviceIDPrefix + name
+}
+
+// ConfigDriveFormatAnnotation selects the format of the config drive delivering the ignition data
+// of an instance, overriding the default format of vee.
+const ConfigDriveFormatAnnotation = "vee.spheric.cloud/config-drive-format"
 
 type Instance struct {
 	meta.ObjectMeta `json:"metadata,omitempty"`
-	ID              string       `json:"id"`
-	Spec            InstanceSpec `json:"spec"`
+	ID              string         `json:"id"`
+	Spec            InstanceSpec   `json:"spec"`
+	Status          InstanceStatus `json:"status,omitempty"`
 }
 
+type Power string
+
+const (
+	PowerOn        Power = "On"
+	PowerOff       Power = "Off"
+	PowerSuspended Power = "Suspended"
+)
+
+type RebootType string
+
+const (
+	// RebootGraceful asks the guest to shut down and boots the vm again afterwards.
+	RebootGraceful RebootType = "Graceful"
+	// RebootHard resets the vm without involving the guest.
+	RebootHard RebootType = "Hard"
+)
+
 type InstanceSpec struct {
-	CPUCount    int32 `json:"cpuCount"`
-	MemoryBytes int64 `json:"memoryBytes"`
+	Power             Power              `json:"power,omitempty"`
+	Image             string             `json:"image,omitempty"`
+	Type              string             `json:"type,omitempty"`
+	CPUCount          int32              `json:"cpuCount"`
+	MemoryBytes       int64              `json:"memoryBytes"`
+	IgnitionData      []byte             `json:"ignitionData,omitempty"`
+	Disks             []Disk             `json:"disks,omitempty"`
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
+	// RebootGeneration is increased for every requested reboot of the vm.
+	RebootGeneration int64      `json:"rebootGeneration,omitempty"`
+	RebootType       RebootType `json:"rebootType,omitempty"`
+}
+
+type Disk struct {
+	Name       string          `json:"name"`
+	Device     string          `json:"device"`
+	EmptyDisk  *EmptyDisk      `json:"emptyDisk,omitempty"`
+	Connection *DiskConnection `json:"connection,omitempty"`
+}
+
+type EmptyDisk struct {
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+}
+
+type DiskConnection struct {
+	Driver     string            `json:"driver"`
+	Handle     string            `json:"handle"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	SecretData map[string][]byte `json:"secretData,omitempty"`
+}
+
+type NetworkInterface struct {
+	Name           string                          `json:"name"`
+	SubnetMetadata *NetworkInterfaceSubnetMetadata `json:"subnetMetadata,omitempty"`
+	IPs            []string                        `json:"ips,omitempty"`
+	SubnetCIDRs    []string                        `json:"subnetCIDRs,omitempty"`
+}
+
+type NetworkInterfaceSubnetMetadata struct {
+	NetworkName string `json:"networkName,omitempty"`
+	NetworkUID  string `json:"networkUID,omitempty"`
+	SubnetName  string `json:"subnetName,omitempty"`
+	SubnetUID   string `json:"subnetUID,omitempty"`
+}
+
+type InstanceState string
+
+const (
+	InstanceStatePending    InstanceState = "Pending"
+	InstanceStateRunning    InstanceState = "Running"
+	InstanceStateSuspended  InstanceState = "Suspended"
+	InstanceStateTerminated InstanceState = "Terminated"
+)
+
+type InstanceStatus struct {
+	ObservedGeneration       int64                    `json:"observedGeneration,omitempty"`
+	ObservedRebootGeneration int64                    `json:"observedRebootGeneration,omitempty"`
+	State                    InstanceState            `json:"state,omitempty"`
+	ImageRef                 string                   `json:"imageRef,omitempty"`
+	Disks                    []DiskStatus             `json:"disks,omitempty"`
+	NetworkInterfaces        []NetworkInterfaceStatus `json:"networkInterfaces,omitempty"`
+	// ResizeLimits are the bounds the resources of the booted vm can be changed within.
+	// It is unset while the vm is not booted, in which case any resources can be applied by re-creating it.
+	ResizeLimits *ResizeLimits `json:"resizeLimits,omitempty"`
+	// RebootPending reports whether the power button of the vm was pressed for a graceful reboot.
+	// The vmm exits once the guest has shut down, which is then expected and followed by starting the vmm again.
+	RebootPending bool `json:"rebootPending,omitempty"`
+}
+
+// ResizeLimits are the bounds the resources of a running vm can be changed within.
+type ResizeLimits struct {
+	MaxCPUCount    int32 `json:"maxCPUCount"`
+	MinMemoryBytes int64 `json:"minMemoryBytes"`
+	MaxMemoryBytes int64 `json:"maxMemoryBytes"`
+}
+
+// Allows reports whether the vm can be resized to the given resources.
+func (l *ResizeLimits) Allows(cpuCount int32, memoryBytes int64) bool {
+	return cpuCount <= l.MaxCPUCount && memoryBytes >= l.MinMemoryBytes && memoryBytes <= l.MaxMemoryBytes
+}
+
+type DiskState string
+
+const (
+	DiskStatePending  DiskState = "Pending"
+	DiskStateAttached DiskState = "Attached"
+)
+
+type DiskStatus struct {
+	Name  string    `json:"name"`
+	State DiskState `json:"state"`
+}
+
+type NetworkInterfaceState string
+
+const (
+	NetworkInterfaceStatePending  NetworkInterfaceState = "Pending"
+	NetworkInterfaceStateAttached NetworkInterfaceState = "Attached"
+)
+
+type NetworkInterfaceStatus struct {
+	Name   string                `json:"name"`
+	Handle string                `json:"handle,omitempty"`
+	State  NetworkInterfaceState `json:"state"`
 }
@@ -6,27 +6,44 @@ package vee
 import (
 	"context"
 	"fmt"
-	"net"
+	"os"
 	"os/user"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/api/resource"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"spheric.cloud/spheric/actuo/cache"
 	"spheric.cloud/spheric/actuo/codec"
+	"spheric.cloud/spheric/actuo/controller"
 	"spheric.cloud/spheric/actuo/etcd/embed"
+	"spheric.cloud/spheric/actuo/handler"
 	"spheric.cloud/spheric/actuo/run"
+	"spheric.cloud/spheric/actuo/source"
 	"spheric.cloud/spheric/actuo/storage/etcd"
 	storagestore "spheric.cloud/spheric/actuo/storage/store"
+	"spheric.cloud/spheric/actuo/watch"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
 	utilgrpc "spheric.cloud/spheric/utils/grpc"
-	utilos "spheric.cloud/spheric/utils/os"
 	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/configdrive"
+	"spheric.cloud/spheric/vee/console"
+	"spheric.cloud/spheric/vee/controllers"
+	"spheric.cloud/spheric/vee/disk"
+	"spheric.cloud/spheric/vee/image"
 	"spheric.cloud/spheric/vee/iriserver"
+	"spheric.cloud/spheric/vee/network"
 	"spheric.cloud/spheric/vee/server"
+	"spheric.cloud/spheric/vee/streaming"
+	"spheric.cloud/spheric/vee/vmm"
 )
 
 var (
@@ -40,20 +57,84 @@ func init() {
 }
 
 type Options struct {
-	APISocket string
-	Dir       string
+	APISocket      string
+	APIAddress     string
+	APISecurity    utilgrpc.ServerSecurityOptions
+	Dir            string
+	Firmware       string
+	ReservedCPU    int64
+	ReservedMemory string
+	InstanceTypes  []string
+
+	MaxCPUCount int32
+	MaxMemory   string
+
+	ConfigDriveFormat  string
+	InsecureRegistries []string
+
+	StreamingAddress string
 }
 
 func NewOptions() *Options {
 	return &Options{
 		APISocket: filepath.Join("/var", "run", "vee", "vee.sock"),
 		Dir:       defaultDir,
+		Firmware:  filepath.Join("/usr", "share", "cloud-hypervisor", "CLOUDHV.fd"),
+
+		ConfigDriveFormat: string(configdrive.FormatIgnition),
+
+		StreamingAddress: streaming.DefaultAddress,
 	}
 }
 
 func (o *Options) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.APISocket, "api-socket", o.APISocket, "Where to create the API socket.")
+	cmd.Flags().StringVar(&o.APIAddress, "api-address", o.APIAddress,
+		"TCP address to serve the API on instead of the API socket, e.g. ':9443'. Should be secured via TLS.")
+	o.APISecurity.BindFlags(cmd.Flags())
 	cmd.Flags().StringVar(&o.Dir, "dir", o.Dir, "Directory to store data in.")
+	cmd.Flags().StringVar(&o.Firmware, "firmware", o.Firmware, "Path to the firmware to boot instances with.")
+	cmd.Flags().Int64Var(&o.ReservedCPU, "reserved-cpu", o.ReservedCPU, "Number of host CPUs to reserve for non-instance usage.")
+	cmd.Flags().StringVar(&o.ReservedMemory, "reserved-memory", o.ReservedMemory, "Amount of host memory to reserve for non-instance usage, e.g. '2Gi'.")
+	cmd.Flags().StringSliceVar(&o.InstanceTypes, "instance-type", o.InstanceTypes,
+		"Instance types to report quantities for, in the format <name>:<cpu>:<memory>, e.g. 'small:2:4Gi'.")
+	cmd.Flags().Int32Var(&o.MaxCPUCount, "max-cpu-count", o.MaxCPUCount,
+		"Number of CPUs running instances can be resized to. Instances with less CPUs have to be restarted to be resized beyond them.")
+	cmd.Flags().StringVar(&o.MaxMemory, "max-memory", o.MaxMemory,
+		"Amount of memory running instances can be resized to, e.g. '16Gi'. The headroom is hot-pluggable via virtio-mem.")
+	cmd.Flags().StringVar(&o.ConfigDriveFormat, "config-drive-format", o.ConfigDriveFormat,
+		fmt.Sprintf("Default format of the config drive delivering the ignition data to instances (one of %v). "+
+			"Can be overridden per instance via the %s annotation.", configdrive.Formats, api.ConfigDriveFormatAnnotation))
+	cmd.Flags().StringSliceVar(&o.InsecureRegistries, "insecure-registry", o.InsecureRegistries,
+		"Registries to pull images from via plain http instead of https.")
+
+	cmd.Flags().StringVar(&o.StreamingAddress, "streaming-address", o.StreamingAddress, "Address to serve streaming requests (e.g. exec) on.")
+}
+
+func parseInstanceTypes(specs []string) (map[string]iriserver.InstanceType, error) {
+	res := make(map[string]iriserver.InstanceType, len(specs))
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid instance type %q: expected <name>:<cpu>:<memory>", spec)
+		}
+
+		name := parts[0]
+		cpu, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid instance type %q cpu: %w", spec, err)
+		}
+		memory, err := resource.ParseQuantity(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid instance type %q memory: %w", spec, err)
+		}
+
+		res[name] = iriserver.InstanceType{
+			CPUCount:    cpu,
+			MemoryBytes: uint64(memory.Value()),
+		}
+	}
+	return res, nil
 }
 
 func Command() *cobra.Command {
@@ -79,29 +160,37 @@ func Command() *cobra.Command {
 	return cmd
 }
 
-func startGRPCServer(ctx context.Context, setupLog logr.Logger, dir, apiSocket string) error {
-	srv, err := iriserver.New(dir)
+func startGRPCServer(
+	ctx context.Context,
+	setupLog logr.Logger,
+	opts Options,
+	store storagestore.Store[string, *api.Instance],
+	srvOpts iriserver.Options,
+) error {
+	srv, err := iriserver.New(opts.Dir, store, srvOpts)
 	if err != nil {
 		return fmt.Errorf("error creating server: %w", err)
 	}
 
-	grpcSrv := grpc.NewServer(
+	securityOpts, err := opts.APISecurity.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("error configuring api security: %w", err)
+	}
+	grpcSrv := grpc.NewServer(append(securityOpts,
 		grpc.ChainUnaryInterceptor(
 			utilgrpc.InjectLogger(ctrl.Log.WithName("server")),
 			utilgrpc.LogRequest,
 		),
-	)
+	)...)
 
-	if err := utilos.EnsureSocketGone(apiSocket); err != nil {
-		return err
-	}
-	l, err := net.Listen("unix", apiSocket)
+	l, err := utilgrpc.Listen(opts.APIAddress, opts.APISocket)
 	if err != nil {
 		return fmt.Errorf("unable to create listener: %w", err)
 	}
 	defer func() { _ = l.Close() }()
 
 	iri.RegisterRuntimeServiceServer(grpcSrv, srv)
+	iri.RegisterImageServiceServer(grpcSrv, srv)
 
 	done := make(chan struct{})
 	ctx, cancel := context.WithCancel(ctx)
@@ -120,10 +209,71 @@ func startGRPCServer(ctx context.Context, setupLog logr.Logger, dir, apiSocket s
 	return nil
 }
 
+// recoverVMMs adopts the vmms that survived a previous run of vee and stops the ones
+// whose instances are gone.
+func recoverVMMs(ctx context.Context, setupLog logr.Logger, store storagestore.Store[string, *api.Instance], vmms *vmm.Manager) error {
+	list, err := store.List(ctx, api.InstancesKey)
+	if err != nil {
+		return fmt.Errorf("error listing instances: %w", err)
+	}
+
+	ids := sets.New[string]()
+	for instance := range list.All() {
+		ids.Insert(instance.ID)
+	}
+
+	if err := vmms.Recover(ctx, setupLog.WithName("vmm-recovery"), ids.Has); err != nil {
+		return fmt.Errorf("error recovering vmms: %w", err)
+	}
+	return nil
+}
+
+// guestAddress returns a function returning the first network interface ip of an instance.
+func guestAddress(store storagestore.Store[string, *api.Instance]) func(ctx context.Context, instanceID string) (string, error) {
+	return func(ctx context.Context, instanceID string) (string, error) {
+		instance, err := store.Get(ctx, api.InstanceKey(instanceID))
+		if err != nil {
+			return "", fmt.Errorf("error getting instance: %w", err)
+		}
+
+		for _, nic := range instance.Spec.NetworkInterfaces {
+			if len(nic.IPs) > 0 {
+				return nic.IPs[0], nil
+			}
+		}
+		return "", fmt.Errorf("instance %s has no network interface ips", instanceID)
+	}
+}
+
 func Run(ctx context.Context, opts Options) error {
 	setupLog := ctrl.Log.WithName("setup")
 
-	e, err := embed.New(embed.WithLogger(ctrl.Log.WithName("etcd")))
+	instanceTypes, err := parseInstanceTypes(opts.InstanceTypes)
+	if err != nil {
+		return err
+	}
+
+	var reservedMemory resource.Quantity
+	if opts.ReservedMemory != "" {
+		reservedMemory, err = resource.ParseQuantity(opts.ReservedMemory)
+		if err != nil {
+			return fmt.Errorf("invalid reserved memory: %w", err)
+		}
+	}
+
+	var maxMemory resource.Quantity
+	if opts.MaxMemory != "" {
+		maxMemory, err = resource.ParseQuantity(opts.MaxMemory)
+		if err != nil {
+			return fmt.Errorf("invalid max memory: %w", err)
+		}
+	}
+
+	// Keep the etcd data next to the vmms, so instances can be matched with their vmms across restarts.
+	e, err := embed.New(
+		embed.WithLogger(ctrl.Log.WithName("etcd")),
+		embed.WithDir(filepath.Join(opts.Dir, "etcd")),
+	)
 	if err != nil {
 		return err
 	}
@@ -149,11 +299,109 @@ func Run(ctx context.Context, opts Options) error {
 		return err
 	}
 
+	configDriveFormat, err := configdrive.ParseFormat(opts.ConfigDriveFormat)
+	if err != nil {
+		return err
+	}
+
+	vmmDir := filepath.Join(opts.Dir, "instances")
+	if err := os.MkdirAll(vmmDir, 0755); err != nil {
+		return fmt.Errorf("error ensuring vmm directory at %q: %w", vmmDir, err)
+	}
+	vmms := vmm.NewManager(vmmDir, vmm.Options{})
+
+	images := image.NewStore(filepath.Join(opts.Dir, "images"), image.Options{
+		InsecureRegistries: opts.InsecureRegistries,
+	})
+
+	consoles := console.NewManager(filepath.Join(opts.Dir, "consoles"), vmms.SerialSocket, console.Options{})
+
+	streamingSrv, err := streaming.New(consoles, streaming.Options{
+		Address:      opts.StreamingAddress,
+		GuestAddress: guestAddress(store),
+	})
+	if err != nil {
+		return fmt.Errorf("error creating streaming server: %w", err)
+	}
+
+	if err := recoverVMMs(ctx, setupLog, store, vmms); err != nil {
+		return err
+	}
+
+	instanceInformer := cache.NewSharedInformer[string, *api.Instance](
+		func(instance *api.Instance) (string, error) {
+			return instance.ID, nil
+		},
+		&cache.ListWatch[*api.Instance]{
+			ListFunc: func(ctx context.Context) ([]*api.Instance, error) {
+				list, err := store.List(ctx, api.InstancesKey)
+				if err != nil {
+					return nil, err
+				}
+				return slices.Collect(list.All()), nil
+			},
+			WatchFunc: func(ctx context.Context) (watch.Watch[*api.Instance], error) {
+				return store.Watch(ctx, api.InstancesKey)
+			},
+		},
+		cache.SharedInformerOptions{
+			Logger: ctrl.Log.WithName("instance-informer"),
+		},
+	)
+
+	instanceController, err := controller.New[string](
+		"instance",
+		&controllers.InstanceReconciler{
+			Store:             store,
+			Hypervisor:        vmms,
+			Firmware:          opts.Firmware,
+			DiskRegistry:      disk.NewDefaultRegistry(),
+			EmptyDisks:        disk.NewEmptyDisks(filepath.Join(opts.Dir, "disks")),
+			NetworkPlugin:     network.NewTAP(network.TAPOptions{}),
+			ConfigDrives:      configdrive.NewConfigDrives(filepath.Join(opts.Dir, "config-drives")),
+			Images:            images,
+			Consoles:          consoles,
+			ConfigDriveFormat: configDriveFormat,
+			MaxCPUCount:       opts.MaxCPUCount,
+			MaxMemoryBytes:    maxMemory.Value(),
+		},
+		controller.Options{},
+	)
+	if err != nil {
+		return fmt.Errorf("error creating instance controller: %w", err)
+	}
+	if err := instanceController.Watch(source.NewInformer(
+		instanceInformer,
+		handler.EnqueueRequestForObject(func(instance *api.Instance) string {
+			return instance.ID
+		}),
+	)); err != nil {
+		return fmt.Errorf("error watching instances: %w", err)
+	}
+	if err := instanceController.Watch(source.NewChannel(vmms.Exits())); err != nil {
+		return fmt.Errorf("error watching vmm exits: %w", err)
+	}
+
 	g := run.NewGroup(ctx)
 
 	g.Start(veeSrv.ListenAndServe, run.OnErrorStop)
 	g.Start(func(ctx context.Context) error {
-		return startGRPCServer(ctx, setupLog, opts.Dir, opts.APISocket)
+		return streamingSrv.ListenAndServe(ctrl.LoggerInto(ctx, ctrl.Log.WithName("streaming")))
+	}, run.OnErrorStop)
+	g.Start(instanceInformer.Run, run.OnErrorStop)
+	g.Start(func(ctx context.Context) error {
+		return instanceController.Start(ctrl.LoggerInto(ctx, ctrl.Log.WithName("instance-controller")))
+	}, run.OnErrorStop)
+	g.Start(func(ctx context.Context) error {
+		return startGRPCServer(ctx, setupLog, opts, store, iriserver.Options{
+			ReservedCPUCount:    opts.ReservedCPU,
+			ReservedMemoryBytes: uint64(reservedMemory.Value()),
+			InstanceTypes:       instanceTypes,
+			Streamer:            streamingSrv,
+			Consoles:            consoles,
+			Hypervisor:          vmms,
+			Images:              images,
+		})
 	}, run.OnErrorStop)
 
 	return g.Wait()
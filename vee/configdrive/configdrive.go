@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package configdrive generates the read-only config drives delivering the user data
+// (e.g. ignition configs) of instances to their guests.
+package configdrive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Format is the layout of a config drive, determining which guest agent picks it up.
+type Format string
+
+const (
+	// FormatIgnition lays out the config drive as an OpenStack config drive (label config-2),
+	// as read by Ignition, e.g. on Flatcar.
+	FormatIgnition Format = "Ignition"
+	// FormatCloudInit lays out the config drive as a cloud-init NoCloud data source (label cidata),
+	// e.g. for Ubuntu.
+	FormatCloudInit Format = "CloudInit"
+)
+
+// Formats are all supported formats.
+var Formats = []Format{FormatIgnition, FormatCloudInit}
+
+// ParseFormat parses the given format.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatIgnition, FormatCloudInit:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown config drive format %q", s)
+	}
+}
+
+const (
+	ignitionLabel  = "config-2"
+	cloudInitLabel = "cidata"
+)
+
+func layout(format Format, instanceID string, userData []byte) (string, []file, error) {
+	switch format {
+	case FormatIgnition:
+		return ignitionLabel, []file{
+			{path: "openstack/latest/user_data", data: userData},
+			{path: "openstack/latest/meta_data.json", data: fmt.Appendf(nil, `{"uuid":%q}`, instanceID)},
+		}, nil
+	case FormatCloudInit:
+		return cloudInitLabel, []file{
+			{path: "user-data", data: userData},
+			{path: "meta-data", data: fmt.Appendf(nil, "instance-id: %s\n", instanceID)},
+		}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown config drive format %q", format)
+	}
+}
+
+// ConfigDrives manages the config drive images of instances.
+type ConfigDrives struct {
+	dir string
+}
+
+func NewConfigDrives(dir string) *ConfigDrives {
+	return &ConfigDrives{dir: dir}
+}
+
+// Path returns the path of the config drive image of the given instance.
+func (c *ConfigDrives) Path(instanceID string) string {
+	return filepath.Join(c.dir, instanceID+".iso")
+}
+
+// Ensure (re-)generates the config drive image of the given instance and returns its path.
+func (c *ConfigDrives) Ensure(instanceID string, format Format, userData []byte) (string, error) {
+	label, files, err := layout(format, instanceID, userData)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := writeISO9660(&buf, label, files, time.Now()); err != nil {
+		return "", fmt.Errorf("error generating config drive image: %w", err)
+	}
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return "", fmt.Errorf("error creating config drive directory: %w", err)
+	}
+
+	// Write to a temporary file first so a vm is never handed a partially written image.
+	path := c.Path(instanceID)
+	tmpFile := path + ".tmp"
+	if err := os.WriteFile(tmpFile, buf.Bytes(), 0600); err != nil {
+		return "", fmt.Errorf("error writing config drive image: %w", err)
+	}
+	if err := os.Rename(tmpFile, path); err != nil {
+		return "", fmt.Errorf("error writing config drive image: %w", err)
+	}
+	return path, nil
+}
+
+// Remove removes the config drive image of the given instance, if any.
+func (c *ConfigDrives) Remove(instanceID string) error {
+	if err := os.Remove(c.Path(instanceID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package configdrive_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"spheric.cloud/spheric/vee/configdrive"
+)
+
+const sectorSize = 2048
+
+// isoLabel returns the volume label of the given ISO9660 image.
+func isoLabel(img []byte) string {
+	return strings.TrimRight(string(img[16*sectorSize+40:16*sectorSize+72]), " ")
+}
+
+// isoReadFile reads the file at the given path from the given ISO9660 image, resolving
+// names the way Linux does for plain ISO9660 volumes.
+func isoReadFile(img []byte, path string) ([]byte, error) {
+	record := img[16*sectorSize+156:]
+	for _, part := range strings.Split(path, "/") {
+		sector := binary.LittleEndian.Uint32(record[2:6])
+		size := binary.LittleEndian.Uint32(record[10:14])
+		dir := img[sector*sectorSize : sector*sectorSize+size]
+
+		var found bool
+		for off := 0; off < len(dir); {
+			l := int(dir[off])
+			if l == 0 {
+				off += sectorSize - off%sectorSize
+				continue
+			}
+			name := string(dir[off+33 : off+33+int(dir[off+32])])
+			name = strings.ToLower(strings.TrimSuffix(name, ";1"))
+			if name == part {
+				record = dir[off:]
+				found = true
+				break
+			}
+			off += l
+		}
+		if !found {
+			return nil, fmt.Errorf("%s not found", path)
+		}
+	}
+
+	sector := binary.LittleEndian.Uint32(record[2:6])
+	size := binary.LittleEndian.Uint32(record[10:14])
+	return img[sector*sectorSize : sector*sectorSize+size], nil
+}
+
+var _ = Describe("ConfigDrives", func() {
+	var configDrives *configdrive.ConfigDrives
+
+	BeforeEach(func() {
+		configDrives = configdrive.NewConfigDrives(GinkgoT().TempDir())
+	})
+
+	It("should generate an ignition config drive", func() {
+		userData := []byte(`{"ignition":{"version":"3.4.0"}}`)
+		path, err := configDrives.Ensure("foo", configdrive.FormatIgnition, userData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(configDrives.Path("foo")))
+
+		img, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(img).To(HaveLen(len(img) / sectorSize * sectorSize))
+		Expect(isoLabel(img)).To(Equal("config-2"))
+		Expect(isoReadFile(img, "openstack/latest/user_data")).To(Equal(userData))
+		Expect(isoReadFile(img, "openstack/latest/meta_data.json")).To(MatchJSON(`{"uuid":"foo"}`))
+	})
+
+	It("should generate a cloud-init config drive", func() {
+		userData := []byte("#cloud-config\nhostname: foo\n")
+		path, err := configDrives.Ensure("foo", configdrive.FormatCloudInit, userData)
+		Expect(err).NotTo(HaveOccurred())
+
+		img, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isoLabel(img)).To(Equal("cidata"))
+		Expect(isoReadFile(img, "user-data")).To(Equal(userData))
+		Expect(isoReadFile(img, "meta-data")).To(Equal([]byte("instance-id: foo\n")))
+	})
+
+	It("should span user data across multiple sectors", func() {
+		userData := []byte(strings.Repeat("a", 3*sectorSize+1))
+		path, err := configDrives.Ensure("foo", configdrive.FormatCloudInit, userData)
+		Expect(err).NotTo(HaveOccurred())
+
+		img, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isoReadFile(img, "user-data")).To(Equal(userData))
+	})
+
+	It("should remove config drives", func() {
+		path, err := configDrives.Ensure("foo", configdrive.FormatIgnition, []byte("{}"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(configDrives.Remove("foo")).To(Succeed())
+		Expect(path).NotTo(BeAnExistingFile())
+		Expect(configDrives.Remove("foo")).To(Succeed())
+	})
+
+	It("should reject unknown formats", func() {
+		_, err := configdrive.ParseFormat("Kickstart")
+		Expect(err).To(HaveOccurred())
+		_, err = configDrives.Ensure("foo", "Kickstart", nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,312 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package configdrive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// This file implements a minimal ISO9660 writer, just enough to produce small read-only
+// volumes of a few files. It does not write any extensions (Rock Ridge, Joliet), file and
+// directory names are written upper-cased which Linux maps back to lower case on mount.
+
+const (
+	isoSectorSize        = 2048
+	isoSystemAreaSectors = 16
+	isoMaxLabelLength    = 32
+)
+
+type file struct {
+	path string
+	data []byte
+}
+
+type isoDir struct {
+	name     string
+	parent   *isoDir
+	number   int
+	dirs     []*isoDir
+	files    []*isoFile
+	sector   uint32
+	size     uint32
+	children []isoRecord
+}
+
+type isoFile struct {
+	name   string
+	data   []byte
+	sector uint32
+}
+
+type isoRecord struct {
+	name   string
+	isDir  bool
+	dir    *isoDir
+	file   *isoFile
+	length int
+}
+
+func isoName(name string) string {
+	return strings.ToUpper(name)
+}
+
+func bothEndian32(b []byte, v uint32) {
+	binary.LittleEndian.PutUint32(b[0:4], v)
+	binary.BigEndian.PutUint32(b[4:8], v)
+}
+
+func bothEndian16(b []byte, v uint16) {
+	binary.LittleEndian.PutUint16(b[0:2], v)
+	binary.BigEndian.PutUint16(b[2:4], v)
+}
+
+func sectorsFor(size int) uint32 {
+	return uint32((size + isoSectorSize - 1) / isoSectorSize)
+}
+
+func recordLength(nameLen int) int {
+	l := 33 + nameLen
+	if l%2 != 0 {
+		l++
+	}
+	return l
+}
+
+// buildTree creates the directory tree of the given files.
+func buildTree(files []file) (*isoDir, error) {
+	root := &isoDir{}
+	for _, f := range files {
+		p := path.Clean(strings.TrimPrefix(f.path, "/"))
+		if p == "." || p == "" {
+			return nil, fmt.Errorf("invalid file path %q", f.path)
+		}
+
+		dir := root
+		parts := strings.Split(p, "/")
+		for _, part := range parts[:len(parts)-1] {
+			name := isoName(part)
+			idx := slices.IndexFunc(dir.dirs, func(d *isoDir) bool { return d.name == name })
+			if idx < 0 {
+				dir.dirs = append(dir.dirs, &isoDir{name: name, parent: dir})
+				idx = len(dir.dirs) - 1
+			}
+			dir = dir.dirs[idx]
+		}
+
+		name := isoName(parts[len(parts)-1]) + ";1"
+		if slices.ContainsFunc(dir.files, func(f *isoFile) bool { return f.name == name }) {
+			return nil, fmt.Errorf("duplicate file path %q", f.path)
+		}
+		dir.files = append(dir.files, &isoFile{name: name, data: f.data})
+	}
+	return root, nil
+}
+
+// orderDirs returns all directories in path table order: breadth-first, ordered by parent and name.
+func orderDirs(root *isoDir) []*isoDir {
+	res := []*isoDir{root}
+	for i := 0; i < len(res); i++ {
+		dir := res[i]
+		dir.number = i + 1
+		slices.SortFunc(dir.dirs, func(a, b *isoDir) int { return strings.Compare(a.name, b.name) })
+		res = append(res, dir.dirs...)
+	}
+	return res
+}
+
+// layoutDir determines the records of the directory and the size of its extent.
+// Records never cross sector boundaries.
+func layoutDir(dir *isoDir) {
+	records := []isoRecord{
+		{name: "\x00", isDir: true, dir: dir, length: recordLength(1)},
+		{name: "\x01", isDir: true, dir: dir.parentOrSelf(), length: recordLength(1)},
+	}
+
+	var named []isoRecord
+	for _, d := range dir.dirs {
+		named = append(named, isoRecord{name: d.name, isDir: true, dir: d, length: recordLength(len(d.name))})
+	}
+	for _, f := range dir.files {
+		named = append(named, isoRecord{name: f.name, file: f, length: recordLength(len(f.name))})
+	}
+	slices.SortFunc(named, func(a, b isoRecord) int { return strings.Compare(a.name, b.name) })
+	dir.children = append(records, named...)
+
+	var size int
+	for _, r := range dir.children {
+		if size%isoSectorSize+r.length > isoSectorSize {
+			size += isoSectorSize - size%isoSectorSize
+		}
+		size += r.length
+	}
+	dir.size = sectorsFor(size) * isoSectorSize
+}
+
+func (d *isoDir) parentOrSelf() *isoDir {
+	if d.parent == nil {
+		return d
+	}
+	return d.parent
+}
+
+func putRecord(b []byte, name string, sector, size uint32, isDir bool, recorded time.Time) {
+	b[0] = byte(recordLength(len(name)))
+	bothEndian32(b[2:10], sector)
+	bothEndian32(b[10:18], size)
+	putRecordingTime(b[18:25], recorded)
+	if isDir {
+		b[25] = 2
+	}
+	bothEndian16(b[28:32], 1)
+	b[32] = byte(len(name))
+	copy(b[33:], name)
+}
+
+func putRecordingTime(b []byte, t time.Time) {
+	t = t.UTC()
+	b[0] = byte(t.Year() - 1900)
+	b[1] = byte(t.Month())
+	b[2] = byte(t.Day())
+	b[3] = byte(t.Hour())
+	b[4] = byte(t.Minute())
+	b[5] = byte(t.Second())
+}
+
+func putVolumeTime(b []byte, t time.Time) {
+	copy(b, t.UTC().Format("20060102150405")+"00")
+}
+
+func putPadded(b []byte, s string) {
+	for i := range b {
+		b[i] = ' '
+	}
+	copy(b, s)
+}
+
+func (r isoRecord) extent() (uint32, uint32) {
+	if r.isDir {
+		return r.dir.sector, r.dir.size
+	}
+	return r.file.sector, uint32(len(r.file.data))
+}
+
+// writeISO9660 writes an ISO9660 image with the given volume label and files to w.
+func writeISO9660(w io.Writer, label string, files []file, now time.Time) error {
+	if len(label) > isoMaxLabelLength {
+		return fmt.Errorf("label %q exceeds %d characters", label, isoMaxLabelLength)
+	}
+
+	root, err := buildTree(files)
+	if err != nil {
+		return err
+	}
+	dirs := orderDirs(root)
+
+	// Path table
+	var pathTableSize int
+	for _, dir := range dirs {
+		nameLen := max(len(dir.name), 1)
+		pathTableSize += 8 + nameLen + nameLen%2
+	}
+	pathTableSectors := sectorsFor(pathTableSize)
+
+	// Layout: system area, primary volume descriptor, terminator, L and M path tables, directories, files.
+	next := uint32(isoSystemAreaSectors+2) + 2*pathTableSectors
+	lPathTableSector := uint32(isoSystemAreaSectors + 2)
+	mPathTableSector := lPathTableSector + pathTableSectors
+	for _, dir := range dirs {
+		layoutDir(dir)
+		dir.sector = next
+		next += dir.size / isoSectorSize
+	}
+	for _, dir := range dirs {
+		for _, f := range dir.files {
+			f.sector = next
+			next += sectorsFor(len(f.data))
+		}
+	}
+	totalSectors := next
+
+	img := make([]byte, int(totalSectors)*isoSectorSize)
+
+	// Primary volume descriptor
+	pvd := img[isoSystemAreaSectors*isoSectorSize:]
+	pvd[0] = 1
+	copy(pvd[1:6], "CD001")
+	pvd[6] = 1
+	putPadded(pvd[8:40], "LINUX")
+	putPadded(pvd[40:72], label)
+	bothEndian32(pvd[80:88], totalSectors)
+	bothEndian16(pvd[120:124], 1)
+	bothEndian16(pvd[124:128], 1)
+	bothEndian16(pvd[128:132], isoSectorSize)
+	bothEndian32(pvd[132:140], uint32(pathTableSize))
+	binary.LittleEndian.PutUint32(pvd[140:144], lPathTableSector)
+	binary.BigEndian.PutUint32(pvd[148:152], mPathTableSector)
+	putRecord(pvd[156:190], "\x00", root.sector, root.size, true, now)
+	putPadded(pvd[190:813], "")
+	putVolumeTime(pvd[813:830], now)
+	putVolumeTime(pvd[830:847], now)
+	copy(pvd[847:863], "0000000000000000")
+	copy(pvd[864:880], "0000000000000000")
+	pvd[881] = 1
+
+	// Volume descriptor set terminator
+	term := img[(isoSystemAreaSectors+1)*isoSectorSize:]
+	term[0] = 255
+	copy(term[1:6], "CD001")
+	term[6] = 1
+
+	// Path tables
+	lOff := int(lPathTableSector) * isoSectorSize
+	mOff := int(mPathTableSector) * isoSectorSize
+	for _, dir := range dirs {
+		name := dir.name
+		if dir.parent == nil {
+			name = "\x00"
+		}
+		parent := dir.parentOrSelf().number
+
+		for _, t := range []struct {
+			off   *int
+			order binary.ByteOrder
+		}{{&lOff, binary.LittleEndian}, {&mOff, binary.BigEndian}} {
+			e := img[*t.off:]
+			e[0] = byte(len(name))
+			t.order.PutUint32(e[2:6], dir.sector)
+			t.order.PutUint16(e[6:8], uint16(parent))
+			copy(e[8:], name)
+			*t.off += 8 + len(name) + len(name)%2
+		}
+	}
+
+	// Directories
+	for _, dir := range dirs {
+		off := int(dir.sector) * isoSectorSize
+		for _, r := range dir.children {
+			if off%isoSectorSize+r.length > isoSectorSize {
+				off += isoSectorSize - off%isoSectorSize
+			}
+			sector, size := r.extent()
+			putRecord(img[off:], r.name, sector, size, r.isDir, now)
+			off += r.length
+		}
+	}
+
+	// Files
+	for _, dir := range dirs {
+		for _, f := range dir.files {
+			copy(img[int(f.sector)*isoSectorSize:], f.data)
+		}
+	}
+
+	_, err = w.Write(img)
+	return err
+}
@@ -0,0 +1,498 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package console records the serial consoles of vee instances.
+//
+// The serial console of a vm only serves a single connection. The Manager holds that connection,
+// appends everything the guest writes to a log file and multiplexes interactive sessions, e.g. exec,
+// onto it.
+//
+// Each line of the log file is an entry of the form '<RFC3339Nano timestamp> <tag> <content>'. As the
+// guest output is not necessarily line-based, the tag is 'P' for partial output and 'F' for output
+// terminated by a newline.
+package console
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+const (
+	DefaultMaxLogBytes    = 1024 * 1024
+	DefaultFollowInterval = 250 * time.Millisecond
+
+	logFileName = "console.log"
+	// backupLogSuffix is the suffix of the rotated log file.
+	backupLogSuffix = ".1"
+
+	partialTag = 'P'
+	fullTag    = 'F'
+
+	readBufferSize = 4096
+)
+
+// ErrClosed is returned if the serial console of an instance was closed while attached to it.
+var ErrClosed = errors.New("serial console closed")
+
+type Options struct {
+	// MaxLogBytes is the size after which the log of an instance is rotated. A single rotated
+	// log is kept, so up to twice the size is stored per instance. Defaults to DefaultMaxLogBytes.
+	MaxLogBytes int64
+	// FollowInterval is the interval a followed log is checked for new output in.
+	// Defaults to DefaultFollowInterval.
+	FollowInterval time.Duration
+	// Clock is used to timestamp the log and to poll followed logs. Defaults to clock.RealClock.
+	Clock clock.WithTicker
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.MaxLogBytes == 0 {
+		o.MaxLogBytes = DefaultMaxLogBytes
+	}
+	if o.FollowInterval == 0 {
+		o.FollowInterval = DefaultFollowInterval
+	}
+	if o.Clock == nil {
+		o.Clock = clock.RealClock{}
+	}
+}
+
+// Manager records the serial consoles of instances and lets sessions attach to them.
+type Manager struct {
+	dir            string
+	serialSocket   func(id string) string
+	maxLogBytes    int64
+	followInterval time.Duration
+	clock          clock.WithTicker
+
+	mu       sync.Mutex
+	consoles map[string]*console
+}
+
+// NewManager creates a new Manager storing logs below dir. serialSocket returns the socket the
+// serial console of an instance is served at.
+func NewManager(dir string, serialSocket func(id string) string, opts Options) *Manager {
+	setOptionsDefaults(&opts)
+
+	return &Manager{
+		dir:            dir,
+		serialSocket:   serialSocket,
+		maxLogBytes:    opts.MaxLogBytes,
+		followInterval: opts.FollowInterval,
+		clock:          opts.Clock,
+		consoles:       make(map[string]*console),
+	}
+}
+
+func (m *Manager) instanceDir(id string) string {
+	return filepath.Join(m.dir, id)
+}
+
+// LogFile returns the path of the console log of the instance with the given id.
+func (m *Manager) LogFile(id string) string {
+	return filepath.Join(m.instanceDir(id), logFileName)
+}
+
+// console is a recorded serial console.
+type console struct {
+	conn net.Conn
+	done chan struct{}
+
+	mu       sync.Mutex
+	logFile  string
+	log      *os.File
+	logBytes int64
+	sessions map[*session]struct{}
+}
+
+// session is an output attached to a console.
+type session struct {
+	out io.Writer
+}
+
+// Ensure starts recording the serial console of the instance with the given id, if not done yet.
+// Recording stops once the serial console is closed, e.g. as the vm was stopped.
+func (m *Manager) Ensure(ctx context.Context, id string) error {
+	_, err := m.ensure(ctx, id)
+	return err
+}
+
+func (m *Manager) ensure(ctx context.Context, id string) (*console, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if c, ok := m.consoles[id]; ok {
+		return c, nil
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "unix", m.serialSocket(id))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to serial console: %w", err)
+	}
+
+	if err := os.MkdirAll(m.instanceDir(id), 0755); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("error creating log directory: %w", err)
+	}
+
+	c := &console{
+		conn:     conn,
+		done:     make(chan struct{}),
+		logFile:  m.LogFile(id),
+		sessions: make(map[*session]struct{}),
+	}
+	if err := c.openLog(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	m.consoles[id] = c
+	go m.record(id, c)
+	return c, nil
+}
+
+// record copies the output of the console to its log and sessions until the console is closed.
+func (m *Manager) record(id string, c *console) {
+	defer func() {
+		m.mu.Lock()
+		if m.consoles[id] == c {
+			delete(m.consoles, id)
+		}
+		m.mu.Unlock()
+		c.close()
+	}()
+
+	buf := make([]byte, readBufferSize)
+	for {
+		n, err := c.conn.Read(buf)
+		if n > 0 {
+			c.write(m.clock.Now(), buf[:n], m.maxLogBytes)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (c *console) openLog() error {
+	f, err := os.OpenFile(c.logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening log: %w", err)
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error inspecting log: %w", err)
+	}
+
+	c.log = f
+	c.logBytes = stat.Size()
+	return nil
+}
+
+// rotateLog replaces the rotated log with the current one and starts a new one.
+func (c *console) rotateLog() error {
+	_ = c.log.Close()
+	c.log = nil
+	if err := os.Rename(c.logFile, c.logFile+backupLogSuffix); err != nil {
+		return fmt.Errorf("error rotating log: %w", err)
+	}
+	return c.openLog()
+}
+
+func (c *console) write(now time.Time, data []byte, maxLogBytes int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for s := range c.sessions {
+		if _, err := s.out.Write(data); err != nil {
+			delete(c.sessions, s)
+		}
+	}
+
+	if c.log == nil {
+		// A previous rotation failed, retry opening the log.
+		if err := c.openLog(); err != nil {
+			return
+		}
+	}
+	if c.logBytes >= maxLogBytes {
+		if err := c.rotateLog(); err != nil {
+			return
+		}
+	}
+
+	entries := appendEntries(nil, now, data)
+	n, _ := c.log.Write(entries)
+	c.logBytes += int64(n)
+}
+
+func (c *console) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
+	close(c.done)
+	_ = c.conn.Close()
+	if c.log != nil {
+		_ = c.log.Close()
+	}
+}
+
+func (c *console) addSession(s *session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[s] = struct{}{}
+}
+
+func (c *console) removeSession(s *session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sessions, s)
+}
+
+// Attach connects the given streams to the serial console of the instance with the given id until the
+// context is done, the input is exhausted or the serial console is closed. Recording is started if needed.
+func (m *Manager) Attach(ctx context.Context, id string, in io.Reader, out io.Writer) error {
+	c, err := m.ensure(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s := &session{out: out}
+	c.addSession(s)
+	defer c.removeSession(s)
+
+	inDone := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(c.conn, in)
+		inDone <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-c.done:
+		return ErrClosed
+	case err := <-inDone:
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// Remove stops recording the serial console of the instance with the given id and removes its log.
+func (m *Manager) Remove(id string) error {
+	m.mu.Lock()
+	c, ok := m.consoles[id]
+	delete(m.consoles, id)
+	m.mu.Unlock()
+	if ok {
+		c.close()
+	}
+
+	if err := os.RemoveAll(m.instanceDir(id)); err != nil {
+		return fmt.Errorf("error removing console log: %w", err)
+	}
+	return nil
+}
+
+// appendEntries appends the log entries of the given output to dst.
+func appendEntries(dst []byte, now time.Time, data []byte) []byte {
+	timestamp := now.UTC().Format(time.RFC3339Nano)
+	for len(data) > 0 {
+		line, rest, found := bytes.Cut(data, []byte{'\n'})
+		tag := byte(partialTag)
+		if found {
+			tag = fullTag
+		}
+
+		dst = append(dst, timestamp...)
+		dst = append(dst, ' ', tag, ' ')
+		dst = append(dst, line...)
+		dst = append(dst, '\n')
+		data = rest
+	}
+	return dst
+}
+
+// entry is a parsed log entry.
+type entry struct {
+	time    time.Time
+	full    bool
+	content []byte
+}
+
+// parseEntry parses a log entry without its trailing newline.
+func parseEntry(line []byte) (entry, bool) {
+	timestamp, rest, ok := bytes.Cut(line, []byte{' '})
+	if !ok || len(rest) < 2 || rest[1] != ' ' {
+		return entry{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, string(timestamp))
+	if err != nil {
+		return entry{}, false
+	}
+
+	tag := rest[0]
+	if tag != partialTag && tag != fullTag {
+		return entry{}, false
+	}
+
+	return entry{
+		time:    t,
+		full:    tag == fullTag,
+		content: rest[2:],
+	}, true
+}
+
+// output returns the guest output of the entry.
+func (e entry) output() []byte {
+	if e.full {
+		return append(e.content, '\n')
+	}
+	return e.content
+}
+
+// LogOptions configure reading the console log of an instance.
+type LogOptions struct {
+	// TailLines is the number of lines from the end of the log to read. If nil, the whole log is read.
+	TailLines *int64
+	// Since is the time from which on output is read. If zero, the whole log is read.
+	Since time.Time
+	// Follow makes ReadLog keep writing new output until the context is done.
+	Follow bool
+}
+
+// ReadLog writes the recorded serial console output of the instance with the given id to w.
+// Instances without recorded output have an empty log.
+func (m *Manager) ReadLog(ctx context.Context, id string, opts LogOptions, w io.Writer) error {
+	logFile := m.LogFile(id)
+
+	var (
+		entries []entry
+		offset  int64
+	)
+	for _, filename := range []string{logFile + backupLogSuffix, logFile} {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("error reading log: %w", err)
+		}
+
+		// A partially written trailing entry is picked up when following.
+		data = completeEntries(data)
+		entries = appendParsedEntries(entries, data, opts.Since)
+		offset = int64(len(data))
+	}
+
+	for _, e := range tailLines(entries, opts.TailLines) {
+		if _, err := w.Write(e.output()); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Follow {
+		return nil
+	}
+	return m.followLog(ctx, logFile, offset, opts.Since, w)
+}
+
+// completeEntries returns the data without a trailing partially written entry.
+func completeEntries(data []byte) []byte {
+	return data[:bytes.LastIndexByte(data, '\n')+1]
+}
+
+func appendParsedEntries(entries []entry, data []byte, since time.Time) []entry {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, readBufferSize), 2*DefaultMaxLogBytes)
+	for sc.Scan() {
+		e, ok := parseEntry(bytes.Clone(sc.Bytes()))
+		if !ok || e.time.Before(since) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// tailLines returns the entries making up the last n lines of output.
+// Trailing partial output counts as a line.
+func tailLines(entries []entry, n *int64) []entry {
+	switch {
+	case n == nil:
+		return entries
+	case *n <= 0:
+		return nil
+	}
+
+	// lines is the number of lines after the current entry.
+	var lines int64
+	for i := len(entries) - 2; i >= 0; i-- {
+		if entries[i].full {
+			lines++
+			if lines == *n {
+				return entries[i+1:]
+			}
+		}
+	}
+	return entries
+}
+
+// followLog writes the output appended to the log after the given offset until the context is done.
+func (m *Manager) followLog(ctx context.Context, logFile string, offset int64, since time.Time, w io.Writer) error {
+	ticker := m.clock.NewTicker(m.followInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+		}
+
+		data, err := os.ReadFile(logFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("error reading log: %w", err)
+		}
+		if int64(len(data)) < offset {
+			// The log was rotated, continue from the start of the new log.
+			offset = 0
+		}
+
+		data = completeEntries(data[offset:])
+		if len(data) == 0 {
+			continue
+		}
+		offset += int64(len(data))
+
+		for _, e := range appendParsedEntries(nil, data, since) {
+			if _, err := w.Write(e.output()); err != nil {
+				return err
+			}
+		}
+	}
+}
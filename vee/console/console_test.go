@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package console_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	. "spheric.cloud/spheric/utils/testing"
+	. "spheric.cloud/spheric/vee/console"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		mgr  *Manager
+		opts Options
+		dir  string
+	)
+
+	BeforeEach(func() {
+		opts = Options{
+			FollowInterval: 10 * time.Millisecond,
+		}
+	})
+
+	JustBeforeEach(func() {
+		dir = ShortSocketDir(GinkgoT())
+
+		serialSocket := func(id string) string {
+			return filepath.Join(dir, id+".sock")
+		}
+
+		By("serving an echoing serial console for instance foo")
+		l, err := net.Listen("unix", serialSocket("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(l.Close)
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer func() { _ = conn.Close() }()
+					_, _ = io.Copy(conn, conn)
+				}()
+			}
+		}()
+
+		mgr = NewManager(filepath.Join(dir, "logs"), serialSocket, opts)
+		DeferCleanup(mgr.Remove, "foo")
+	})
+
+	// write writes the given data to the serial console of instance foo and waits for its echo.
+	write := func(ctx context.Context, data string) {
+		GinkgoHelper()
+		out := gbytes.NewBuffer()
+		attachCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Keep the input open until the echo was received, as exhausting it ends the session.
+		in, inWriter := io.Pipe()
+		defer func() { _ = inWriter.Close() }()
+
+		done := make(chan error, 1)
+		go func() {
+			done <- mgr.Attach(attachCtx, "foo", in, out)
+		}()
+		_, err := inWriter.Write([]byte(data))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(out.Contents).Should(Equal([]byte(data)))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	}
+
+	readLog := func(ctx context.Context, opts LogOptions) string {
+		GinkgoHelper()
+		var buf bytes.Buffer
+		Expect(mgr.ReadLog(ctx, "foo", opts, &buf)).To(Succeed())
+		return buf.String()
+	}
+
+	It("should record the serial console output", func(ctx SpecContext) {
+		write(ctx, "hello\nworld")
+
+		Eventually(ctx, func() string {
+			return readLog(ctx, LogOptions{})
+		}).Should(Equal("hello\nworld"))
+	})
+
+	It("should report an empty log for instances without output", func(ctx SpecContext) {
+		Expect(readLog(ctx, LogOptions{})).To(BeEmpty())
+	})
+
+	It("should only return the requested number of lines", func(ctx SpecContext) {
+		write(ctx, "a\nb\n")
+		write(ctx, "c\nd")
+		Eventually(ctx, func() string {
+			return readLog(ctx, LogOptions{})
+		}).Should(Equal("a\nb\nc\nd"))
+
+		Expect(readLog(ctx, LogOptions{TailLines: ptr.To[int64](2)})).To(Equal("c\nd"))
+		Expect(readLog(ctx, LogOptions{TailLines: ptr.To[int64](3)})).To(Equal("b\nc\nd"))
+		Expect(readLog(ctx, LogOptions{TailLines: ptr.To[int64](10)})).To(Equal("a\nb\nc\nd"))
+		Expect(readLog(ctx, LogOptions{TailLines: ptr.To[int64](0)})).To(BeEmpty())
+	})
+
+	It("should follow the log", func(ctx SpecContext) {
+		write(ctx, "a\n")
+		Eventually(ctx, func() string {
+			return readLog(ctx, LogOptions{})
+		}).Should(Equal("a\n"))
+
+		out := gbytes.NewBuffer()
+		followCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		done := make(chan error, 1)
+		go func() {
+			done <- mgr.ReadLog(followCtx, "foo", LogOptions{Follow: true}, out)
+		}()
+		Eventually(out).Should(gbytes.Say("a\n"))
+
+		write(ctx, "b\n")
+		Eventually(out).Should(gbytes.Say("b\n"))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should remove the log", func(ctx SpecContext) {
+		write(ctx, "a\n")
+		Eventually(mgr.LogFile("foo")).Should(BeAnExistingFile())
+
+		Expect(mgr.Remove("foo")).To(Succeed())
+		Expect(mgr.LogFile("foo")).NotTo(BeAnExistingFile())
+	})
+
+	Context("with a fake clock", func() {
+		var clock *testingclock.FakeClock
+
+		BeforeEach(func() {
+			clock = testingclock.NewFakeClock(time.Unix(1000, 0))
+			opts.Clock = clock
+		})
+
+		It("should only return output since the requested time", func(ctx SpecContext) {
+			write(ctx, "a\n")
+			Eventually(ctx, func() string {
+				return readLog(ctx, LogOptions{})
+			}).Should(Equal("a\n"))
+
+			clock.Step(time.Minute)
+			write(ctx, "b\n")
+			Eventually(ctx, func() string {
+				return readLog(ctx, LogOptions{})
+			}).Should(Equal("a\nb\n"))
+
+			Expect(readLog(ctx, LogOptions{Since: clock.Now()})).To(Equal("b\n"))
+		})
+	})
+
+	Context("with a small maximum log size", func() {
+		BeforeEach(func() {
+			opts.MaxLogBytes = 32
+		})
+
+		It("should rotate the log and keep the previous one", func(ctx SpecContext) {
+			for _, line := range []string{"first line\n", "second line\n", "third line\n"} {
+				write(ctx, line)
+			}
+
+			Eventually(mgr.LogFile("foo") + ".1").Should(BeAnExistingFile())
+			Eventually(ctx, func() string {
+				return readLog(ctx, LogOptions{})
+			}).Should(Equal("second line\nthird line\n"))
+		})
+	})
+})
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/storage/etcd3/testserver"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"spheric.cloud/spheric/actuo/cache"
+	"spheric.cloud/spheric/actuo/codec"
+	"spheric.cloud/spheric/actuo/controller"
+	"spheric.cloud/spheric/actuo/handler"
+	"spheric.cloud/spheric/actuo/source"
+	"spheric.cloud/spheric/actuo/storage/etcd"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
+	"spheric.cloud/spheric/actuo/watch"
+	. "spheric.cloud/spheric/utils/testing"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/configdrive"
+	"spheric.cloud/spheric/vee/console"
+	"spheric.cloud/spheric/vee/controllers"
+	"spheric.cloud/spheric/vee/disk"
+	"spheric.cloud/spheric/vee/image"
+	vmmfake "spheric.cloud/spheric/vee/vmm/fake"
+)
+
+const (
+	pollingInterval   = 50 * time.Millisecond
+	eventuallyTimeout = 3 * time.Second
+)
+
+func TestControllers(t *testing.T) {
+	SetDefaultEventuallyPollingInterval(pollingInterval)
+	SetDefaultEventuallyTimeout(eventuallyTimeout)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Controllers Suite")
+}
+
+// fakeNetworkPlugin is a network.Plugin that only records the applied network interfaces.
+type fakeNetworkPlugin struct {
+	mu      sync.Mutex
+	applied sets.Set[string]
+}
+
+func (p *fakeNetworkPlugin) Apply(_ context.Context, instanceID string, nic *api.NetworkInterface) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.applied.Insert(instanceID + "/" + nic.Name)
+	return "tap-" + nic.Name, nil
+}
+
+func (p *fakeNetworkPlugin) Delete(_ context.Context, instanceID, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.applied.Delete(instanceID + "/" + name)
+	return nil
+}
+
+func (p *fakeNetworkPlugin) Applied() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return sets.List(p.applied)
+}
+
+var (
+	store         storagestore.Store[string, *api.Instance]
+	hypervisor    *vmmfake.Hypervisor
+	networkPlugin *fakeNetworkPlugin
+	emptyDiskDir  string
+	configDrives  *configdrive.ConfigDrives
+	images        *image.Store
+	consoles      *console.Manager
+)
+
+var _ = BeforeEach(func() {
+	cl := testserver.RunEtcd(GinkgoTB(), testserver.NewTestConfig(GinkgoTB()))
+	store = etcd.NewSimple[*api.Instance](
+		cl,
+		codec.JSON[*api.Instance](),
+		storagestore.DefaultFactory[*api.Instance](),
+		storagestore.DefaultMetaVersioner[*api.Instance](),
+	)
+
+	dir := ShortSocketDir(GinkgoT())
+
+	hypervisor = vmmfake.NewHypervisor(dir)
+	DeferCleanup(hypervisor.Close)
+
+	networkPlugin = &fakeNetworkPlugin{applied: sets.New[string]()}
+	emptyDiskDir = GinkgoT().TempDir()
+	configDrives = configdrive.NewConfigDrives(GinkgoT().TempDir())
+	images = image.NewStore(GinkgoT().TempDir(), image.Options{})
+	consoles = console.NewManager(GinkgoT().TempDir(), hypervisor.SerialSocket, console.Options{})
+
+	informer := cache.NewSharedInformer[string, *api.Instance](
+		func(instance *api.Instance) (string, error) {
+			return instance.ID, nil
+		},
+		&cache.ListWatch[*api.Instance]{
+			ListFunc: func(ctx context.Context) ([]*api.Instance, error) {
+				list, err := store.List(ctx, api.InstancesKey)
+				if err != nil {
+					return nil, err
+				}
+				return slices.Collect(list.All()), nil
+			},
+			WatchFunc: func(ctx context.Context) (watch.Watch[*api.Instance], error) {
+				return store.Watch(ctx, api.InstancesKey)
+			},
+		},
+		cache.SharedInformerOptions{},
+	)
+
+	instanceController, err := controller.New[string](
+		"instance",
+		&controllers.InstanceReconciler{
+			Store:         store,
+			Hypervisor:    hypervisor,
+			DiskRegistry:  disk.NewDefaultRegistry(),
+			EmptyDisks:    disk.NewEmptyDisks(emptyDiskDir),
+			NetworkPlugin: networkPlugin,
+			ConfigDrives:  configDrives,
+			Images:        images,
+			Consoles:      consoles,
+
+			ConfigDriveFormat: configdrive.FormatIgnition,
+			MaxCPUCount:       4,
+			MaxMemoryBytes:    2 * 1024 * 1024 * 1024,
+		},
+		controller.Options{},
+	)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(instanceController.Watch(source.NewInformer(
+		informer,
+		handler.EnqueueRequestForObject(func(instance *api.Instance) string {
+			return instance.ID
+		}),
+	))).To(Succeed())
+	Expect(instanceController.Watch(source.NewChannel(hypervisor.Exits()))).To(Succeed())
+
+	ctx, cancel := context.WithCancel(ctrl.LoggerInto(context.Background(), GinkgoLogr))
+	var wg sync.WaitGroup
+	DeferCleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+
+	wg.Add(2)
+	go func() {
+		defer GinkgoRecover()
+		defer wg.Done()
+		Expect(informer.Run(ctx)).To(Or(Succeed(), MatchError(context.Canceled)))
+	}()
+	go func() {
+		defer GinkgoRecover()
+		defer wg.Done()
+		Expect(instanceController.Start(ctx)).To(Or(Succeed(), MatchError(context.Canceled)))
+	}()
+})
@@ -6,78 +6,597 @@ package controllers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
+	"reflect"
+	"time"
 
-	"spheric.cloud/spheric/actuo/client"
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"spheric.cloud/spheric/actuo/reconcile"
-	cloudhypervisor "spheric.cloud/spheric/cloud-hypervisor"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
 	chypclient "spheric.cloud/spheric/cloud-hypervisor/client"
 	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/utils/generic"
 	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/configdrive"
+	"spheric.cloud/spheric/vee/console"
+	"spheric.cloud/spheric/vee/disk"
+	"spheric.cloud/spheric/vee/image"
+	"spheric.cloud/spheric/vee/network"
+	"spheric.cloud/spheric/vee/vmm"
 )
 
+const vmResyncInterval = 10 * time.Second
+
+// virtioMemHotplugMethod is the cloud-hypervisor memory hotplug method that allows resizing memory in both directions.
+const virtioMemHotplugMethod = "VirtioMem"
+
 type InstanceReconciler struct {
-	client.Client[string, *api.Instance]
-	CloudHypervisor cloudhypervisor.CloudHypervisor
-	SocketDir       string
+	Store         storagestore.Store[string, *api.Instance]
+	Hypervisor    vmm.Hypervisor
+	Firmware      string
+	DiskRegistry  *disk.Registry
+	EmptyDisks    *disk.EmptyDisks
+	NetworkPlugin network.Plugin
+	ConfigDrives  *configdrive.ConfigDrives
+	Images        *image.Store
+	// Consoles records the serial consoles of running instances. If unset, serial consoles are not recorded.
+	Consoles *console.Manager
+	// ConfigDriveFormat is the format of config drives if not overridden via api.ConfigDriveFormatAnnotation.
+	ConfigDriveFormat configdrive.Format
+	// MaxCPUCount is the number of vcpus running vms can be resized to.
+	// Vms of instances with more cpus can't be resized beyond their cpu count without being re-created.
+	MaxCPUCount int32
+	// MaxMemoryBytes is the amount of memory running vms can be resized to. The memory beyond the
+	// memory of the instance is hot-pluggable via virtio-mem.
+	MaxMemoryBytes int64
 }
 
 func (r *InstanceReconciler) Reconcile(ctx context.Context, id string) (reconcile.Result, error) {
-	instance, err := r.Get(ctx, id)
+	log := ctrl.LoggerFrom(ctx).WithValues("InstanceID", id)
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	instance, err := r.Store.Get(ctx, api.InstanceKey(id))
 	if err != nil {
+		if errors.Is(err, storagestore.ErrNotFound) {
+			return reconcile.Result{}, nil
+		}
 		return reconcile.Result{}, err
 	}
 
-	apiSocket := filepath.Join(r.SocketDir, instance.ID)
-	cHyp, err := chypclient.Connect(apiSocket)
-	if err != nil {
-		if !errors.Is(err, os.ErrNotExist) {
-			return reconcile.Result{}, err
+	if instance.DeletionTimestamp != nil {
+		return r.delete(ctx, log, instance)
+	}
+	return r.reconcile(ctx, log, instance)
+}
+
+func (r *InstanceReconciler) delete(ctx context.Context, log logr.Logger, instance *api.Instance) (reconcile.Result, error) {
+	cHyp, err := r.Hypervisor.Connect(instance.ID)
+	switch {
+	case err == nil:
+		log.V(1).Info("Deleting vm")
+		if err := cHyp.DeleteVM(ctx); err != nil && !chypclient.IsStatusError(err, http.StatusNotFound) {
+			return reconcile.Result{}, fmt.Errorf("error deleting vm: %w", err)
+		}
+	case errors.Is(err, vmm.ErrNotFound), errors.Is(err, vmm.ErrExited):
+	default:
+		return reconcile.Result{}, fmt.Errorf("error connecting to vmm: %w", err)
+	}
+
+	log.V(1).Info("Stopping vmm")
+	if err := r.Hypervisor.Stop(ctx, instance.ID); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error stopping vmm: %w", err)
+	}
+
+	log.V(1).Info("Deleting network interfaces")
+	if err := r.deleteNetworkInterfaces(ctx, instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.V(1).Info("Removing empty disks")
+	if err := r.EmptyDisks.RemoveAll(instance.ID); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error removing empty disks: %w", err)
+	}
+
+	log.V(1).Info("Removing config drive")
+	if err := r.ConfigDrives.Remove(instance.ID); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error removing config drive: %w", err)
+	}
+
+	log.V(1).Info("Removing root disk")
+	if err := r.Images.RemoveRootDisk(instance.ID); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error removing root disk: %w", err)
+	}
+
+	if r.Consoles != nil {
+		log.V(1).Info("Removing console log")
+		if err := r.Consoles.Remove(instance.ID); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error removing console log: %w", err)
+		}
+	}
+
+	log.V(1).Info("Removing instance from store")
+	if _, err := r.Store.Delete(ctx, api.InstanceKey(instance.ID), func(ctx context.Context, obj *api.Instance) error {
+		return nil
+	}); err != nil && !errors.Is(err, storagestore.ErrNotFound) {
+		return reconcile.Result{}, fmt.Errorf("error deleting instance: %w", err)
+	}
+
+	log.V(1).Info("Deleted instance")
+	return reconcile.Result{}, nil
+}
+
+// terminatedUnexpectedly reports whether the vmm of the instance exited unexpectedly for
+// the current generation of the instance.
+func (r *InstanceReconciler) terminatedUnexpectedly(instance *api.Instance) bool {
+	return instance.Status.State == api.InstanceStateTerminated &&
+		instance.Status.ObservedGeneration == instance.Generation
+}
+
+// connect connects to the vmm of the instance, starting it if necessary.
+// If the vmm exited unexpectedly, the instance is marked as terminated and no client is returned.
+// A new vmm is only started once the spec of the instance changes, or right away if the vmm exited
+// for a pending graceful reboot.
+func (r *InstanceReconciler) connect(ctx context.Context, log logr.Logger, instance *api.Instance) (chypclient.Client, error) {
+	cHyp, err := r.Hypervisor.Connect(instance.ID)
+	switch {
+	case err == nil:
+		return cHyp, nil
+	case errors.Is(err, vmm.ErrExited) && instance.Status.RebootPending:
+		log.V(1).Info("Vmm exited for graceful reboot")
+		// The marker is cleared first, so a failing start is retried as a regular start
+		// and an exit of the new vmm is not mistaken for the reboot.
+		status := instance.Status
+		status.RebootPending = false
+		if err := r.updateStatus(ctx, instance, status); err != nil {
+			return nil, err
+		}
+		instance.Status = status
+
+		log.V(1).Info("Starting vmm")
+		cHyp, err := r.Hypervisor.Start(ctx, instance.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error starting vmm: %w", err)
+		}
+		return cHyp, nil
+	case errors.Is(err, vmm.ErrExited):
+		log.Info("Vmm exited unexpectedly")
+		if err := r.updateStatus(ctx, instance, api.InstanceStatus{
+			ObservedGeneration:       instance.Generation,
+			ObservedRebootGeneration: instance.Status.ObservedRebootGeneration,
+			State:                    api.InstanceStateTerminated,
+			ImageRef:                 instance.Status.ImageRef,
+		}); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	case errors.Is(err, vmm.ErrNotFound):
+		if r.terminatedUnexpectedly(instance) {
+			return nil, nil
 		}
 
-		newCHyp, err := r.CloudHypervisor.Start(ctx, apiSocket)
+		log.V(1).Info("Starting vmm")
+		cHyp, err := r.Hypervisor.Start(ctx, instance.ID)
 		if err != nil {
-			return reconcile.Result{}, err
+			return nil, fmt.Errorf("error starting vmm: %w", err)
 		}
+		return cHyp, nil
+	default:
+		return nil, fmt.Errorf("error connecting to vmm: %w", err)
+	}
+}
+
+func (r *InstanceReconciler) reconcile(ctx context.Context, log logr.Logger, instance *api.Instance) (reconcile.Result, error) {
+	cHyp, err := r.connect(ctx, log, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if cHyp == nil {
+		log.V(1).Info("Vmm terminated, waiting for spec change")
+		return reconcile.Result{}, nil
+	}
 
-		cHyp = newCHyp
+	img, err := r.pullImage(ctx, log, instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("error pulling image: %w", err)
 	}
 
 	vmInfo, err := cHyp.GetVMInfo(ctx)
 	if err != nil {
 		if !chypclient.IsStatusError(err, http.StatusNotFound) {
-			return reconcile.Result{}, err
+			return reconcile.Result{}, fmt.Errorf("error getting vm info: %w", err)
+		}
+
+		log.V(1).Info("Creating vm")
+		if err := r.create(ctx, cHyp, instance, img); err != nil {
+			return reconcile.Result{}, fmt.Errorf("error creating vm: %w", err)
+		}
+
+		vmInfo, err = cHyp.GetVMInfo(ctx)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error getting vm info: %w", err)
 		}
 	}
 
-	_ = vmInfo
-	return reconcile.Result{}, nil
+	vmInfo, err = r.reconcileResources(ctx, log, cHyp, instance, img, vmInfo)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.V(1).Info("Reconciling disks")
+	disksModified, disksErr := r.reconcileDisks(ctx, log, cHyp, instance, vmInfo)
+	if disksModified {
+		vmInfo, err = cHyp.GetVMInfo(ctx)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error getting vm info: %w", err)
+		}
+	}
+	if disksErr != nil {
+		log.Error(disksErr, "Error reconciling disks")
+	}
+
+	log.V(1).Info("Reconciling network interfaces")
+	nicsModified, nicsErr := r.reconcileNetworkInterfaces(ctx, log, cHyp, instance, vmInfo)
+	if nicsModified {
+		vmInfo, err = cHyp.GetVMInfo(ctx)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("error getting vm info: %w", err)
+		}
+	}
+	if nicsErr != nil {
+		log.Error(nicsErr, "Error reconciling network interfaces")
+	}
+
+	log.V(1).Info("Reconciling power", "Power", instance.Spec.Power, "VMState", vmInfo.State)
+	vmInfo, err = r.reconcilePower(ctx, cHyp, instance, vmInfo)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	vmInfo, err = r.reconcileReboot(ctx, log, cHyp, instance, vmInfo)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The serial console is only served once the vm is booted. Recording stops with the vm,
+	// so it is re-ensured on every reconciliation.
+	if r.Consoles != nil && (vmInfo.State == oapiclient.Running || vmInfo.State == oapiclient.Paused) {
+		if err := r.Consoles.Ensure(ctx, instance.ID); err != nil {
+			log.Error(err, "Error recording serial console")
+		}
+	}
+
+	// Once the vm is not running anymore, its vmm won't exit for the reboot.
+	rebootPending := instance.Status.RebootPending && vmInfo.State == oapiclient.Running
+	if err := r.updateStatus(ctx, instance, api.InstanceStatus{
+		ObservedGeneration:       instance.Generation,
+		ObservedRebootGeneration: instance.Spec.RebootGeneration,
+		State:                    r.instanceState(vmInfo.State),
+		ImageRef:                 imageRef(img),
+		Disks:                    r.diskStatuses(instance, vmInfo),
+		NetworkInterfaces:        r.networkInterfaceStatuses(instance, vmInfo),
+		ResizeLimits:             resizeLimits(vmInfo),
+		RebootPending:            rebootPending,
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+	if disksErr != nil {
+		return reconcile.Result{}, fmt.Errorf("error reconciling disks: %w", disksErr)
+	}
+	if nicsErr != nil {
+		return reconcile.Result{}, fmt.Errorf("error reconciling network interfaces: %w", nicsErr)
+	}
+
+	// State changes initiated from within the guest are not observable via store events,
+	// hence periodically re-check the vm.
+	return reconcile.Result{RequeueAfter: vmResyncInterval}, nil
 }
 
-func (r *InstanceReconciler) create(ctx context.Context, cHyp chypclient.Client, instance *api.Instance) error {
+// reconcilePower moves the vm towards the desired power of the instance.
+// Suspending pauses a running vm and keeps its memory state; a vm that is not running stays as it is.
+func (r *InstanceReconciler) reconcilePower(
+	ctx context.Context,
+	cHyp chypclient.Client,
+	instance *api.Instance,
+	vmInfo *oapiclient.VmInfo,
+) (*oapiclient.VmInfo, error) {
+	switch {
+	case instance.Spec.Power == api.PowerOn && (vmInfo.State == oapiclient.Created || vmInfo.State == oapiclient.Shutdown):
+		if err := cHyp.BootVM(ctx); err != nil {
+			return nil, fmt.Errorf("error booting vm: %w", err)
+		}
+	case instance.Spec.Power == api.PowerOn && vmInfo.State == oapiclient.Paused:
+		if err := cHyp.ResumeVM(ctx); err != nil {
+			return nil, fmt.Errorf("error resuming vm: %w", err)
+		}
+	case instance.Spec.Power == api.PowerSuspended && vmInfo.State == oapiclient.Running:
+		if err := cHyp.PauseVM(ctx); err != nil {
+			return nil, fmt.Errorf("error pausing vm: %w", err)
+		}
+	case instance.Spec.Power == api.PowerOff && (vmInfo.State == oapiclient.Running || vmInfo.State == oapiclient.Paused):
+		if err := cHyp.ShutdownVM(ctx); err != nil {
+			return nil, fmt.Errorf("error shutting down vm: %w", err)
+		}
+	default:
+		return vmInfo, nil
+	}
+
+	vmInfo, err := cHyp.GetVMInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vm info: %w", err)
+	}
+	return vmInfo, nil
+}
+
+// reconcileReboot reboots the vm if a reboot generation newer than the observed one was requested.
+// Reboots of a vm that is not running are dropped, as booting it again already starts the guest afresh.
+// A graceful reboot presses the power button of the vm. Once the guest has shut down, the vmm exits
+// and is started again by connect, as the reboot is recorded as pending beforehand.
+func (r *InstanceReconciler) reconcileReboot(
+	ctx context.Context,
+	log logr.Logger,
+	cHyp chypclient.Client,
+	instance *api.Instance,
+	vmInfo *oapiclient.VmInfo,
+) (*oapiclient.VmInfo, error) {
+	if instance.Spec.RebootGeneration <= instance.Status.ObservedRebootGeneration || vmInfo.State != oapiclient.Running {
+		return vmInfo, nil
+	}
+
+	log.V(1).Info("Rebooting vm", "RebootType", instance.Spec.RebootType, "RebootGeneration", instance.Spec.RebootGeneration)
+	switch instance.Spec.RebootType {
+	case api.RebootHard:
+		if err := cHyp.RebootVM(ctx); err != nil {
+			return nil, fmt.Errorf("error rebooting vm: %w", err)
+		}
+	default:
+		// The vmm may exit before the request returns, so the reboot has to be recorded first.
+		prevStatus := instance.Status
+		status := instance.Status
+		status.ObservedRebootGeneration = instance.Spec.RebootGeneration
+		status.RebootPending = true
+		if err := r.updateStatus(ctx, instance, status); err != nil {
+			return nil, err
+		}
+		instance.Status = status
+
+		if err := cHyp.PowerButtonVM(ctx); err != nil {
+			if err := r.updateStatus(ctx, instance, prevStatus); err != nil {
+				log.Error(err, "Error resetting pending reboot")
+			}
+			return nil, fmt.Errorf("error pressing vm power button: %w", err)
+		}
+		// The guest is shutting down but the vm keeps running until it is done.
+		return vmInfo, nil
+	}
+
+	vmInfo, err := cHyp.GetVMInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vm info: %w", err)
+	}
+	return vmInfo, nil
+}
+
+// vmResources returns the cpu count and memory the vm currently has.
+func vmResources(vmInfo *oapiclient.VmInfo) (cpuCount int32, memoryBytes int64) {
+	if cpus := vmInfo.Config.Cpus; cpus != nil {
+		cpuCount = int32(cpus.BootVcpus)
+	}
+	if memory := vmInfo.Config.Memory; memory != nil {
+		memoryBytes = memory.Size + generic.DerefOrZero(memory.HotpluggedSize)
+	}
+	return cpuCount, memoryBytes
+}
+
+// resizeLimits returns the bounds the resources of the vm can be changed within, or nil if the vm is not booted.
+func resizeLimits(vmInfo *oapiclient.VmInfo) *api.ResizeLimits {
+	if vmInfo.State != oapiclient.Running && vmInfo.State != oapiclient.Paused {
+		return nil
+	}
+
+	limits := &api.ResizeLimits{}
+	if cpus := vmInfo.Config.Cpus; cpus != nil {
+		limits.MaxCPUCount = int32(cpus.MaxVcpus)
+	}
+	if memory := vmInfo.Config.Memory; memory != nil {
+		limits.MinMemoryBytes = memory.Size
+		limits.MaxMemoryBytes = memory.Size + generic.DerefOrZero(memory.HotplugSize)
+	}
+	return limits
+}
+
+// reconcileResources applies the cpu count and memory of the instance to the vm.
+// A running vm is resized via vm.resize if the resources are within its resize limits. A vm that is about to
+// be booted is re-created instead, as its limits are fixed once created. Otherwise, the resources are applied
+// once the instance is powered off and on again.
+func (r *InstanceReconciler) reconcileResources(
+	ctx context.Context,
+	log logr.Logger,
+	cHyp chypclient.Client,
+	instance *api.Instance,
+	img *image.Image,
+	vmInfo *oapiclient.VmInfo,
+) (*oapiclient.VmInfo, error) {
+	cpuCount, memoryBytes := vmResources(vmInfo)
+	if cpuCount == instance.Spec.CPUCount && memoryBytes == instance.Spec.MemoryBytes {
+		return vmInfo, nil
+	}
+
+	switch {
+	case instance.Spec.Power == api.PowerOn && (vmInfo.State == oapiclient.Created || vmInfo.State == oapiclient.Shutdown):
+		log.V(1).Info("Re-creating vm with new resources", "CPUCount", instance.Spec.CPUCount, "MemoryBytes", instance.Spec.MemoryBytes)
+		if err := cHyp.DeleteVM(ctx); err != nil {
+			return nil, fmt.Errorf("error deleting vm: %w", err)
+		}
+		if err := r.create(ctx, cHyp, instance, img); err != nil {
+			return nil, fmt.Errorf("error creating vm: %w", err)
+		}
+	case vmInfo.State == oapiclient.Running && resizeLimits(vmInfo).Allows(instance.Spec.CPUCount, instance.Spec.MemoryBytes):
+		log.V(1).Info("Resizing vm", "CPUCount", instance.Spec.CPUCount, "MemoryBytes", instance.Spec.MemoryBytes)
+		if err := cHyp.ResizeVM(ctx, oapiclient.VmResize{
+			DesiredVcpus: generic.Pointer(int(instance.Spec.CPUCount)),
+			DesiredRam:   generic.Pointer(instance.Spec.MemoryBytes),
+		}); err != nil {
+			return nil, fmt.Errorf("error resizing vm: %w", err)
+		}
+	default:
+		log.V(1).Info("Resources can't be applied to the vm in its current state", "VMState", vmInfo.State)
+		return vmInfo, nil
+	}
+
+	vmInfo, err := cHyp.GetVMInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vm info: %w", err)
+	}
+	return vmInfo, nil
+}
+
+var vmStateToInstanceState = map[oapiclient.VmInfoState]api.InstanceState{
+	oapiclient.Created:  api.InstanceStatePending,
+	oapiclient.Running:  api.InstanceStateRunning,
+	oapiclient.Paused:   api.InstanceStateSuspended,
+	oapiclient.Shutdown: api.InstanceStateTerminated,
+}
+
+func (r *InstanceReconciler) instanceState(state oapiclient.VmInfoState) api.InstanceState {
+	if res, ok := vmStateToInstanceState[state]; ok {
+		return res
+	}
+	return api.InstanceStatePending
+}
+
+func (r *InstanceReconciler) updateStatus(ctx context.Context, instance *api.Instance, status api.InstanceStatus) error {
+	if reflect.DeepEqual(instance.Status, status) {
+		return nil
+	}
+
+	if _, err := r.Store.Update(ctx, api.InstanceKey(instance.ID), false, func(ctx context.Context, obj *api.Instance) (*api.Instance, error) {
+		obj.Status = status
+		return obj, nil
+	}); err != nil {
+		return fmt.Errorf("error updating instance status: %w", err)
+	}
+	return nil
+}
+
+// pullImage pulls the image of the instance, if any. Once resolved, the image is pinned to the
+// digest reported in the status, so later changes of the image's tag don't affect the instance.
+func (r *InstanceReconciler) pullImage(ctx context.Context, log logr.Logger, instance *api.Instance) (*image.Image, error) {
+	if instance.Spec.Image == "" {
+		return nil, nil
+	}
+
+	ref := instance.Status.ImageRef
+	if ref == "" {
+		ref = instance.Spec.Image
+	}
+
+	log.V(1).Info("Pulling image", "Image", ref)
+	return r.Images.Pull(ctx, ref)
+}
+
+func imageRef(img *image.Image) string {
+	if img == nil {
+		return ""
+	}
+	return img.Ref
+}
+
+func (r *InstanceReconciler) create(ctx context.Context, cHyp chypclient.Client, instance *api.Instance, img *image.Image) error {
 	cpusConfig := &oapiclient.CpusConfig{
 		BootVcpus: int(instance.Spec.CPUCount),
+		MaxVcpus:  int(max(instance.Spec.CPUCount, r.MaxCPUCount)),
 	}
 
 	memoryConfig := &oapiclient.MemoryConfig{
 		Size: instance.Spec.MemoryBytes,
 	}
+	// Memory beyond the memory of the instance is reserved for resizing the running vm.
+	if hotplugSize := r.MaxMemoryBytes - instance.Spec.MemoryBytes; hotplugSize > 0 {
+		memoryConfig.HotplugMethod = generic.Pointer(virtioMemHotplugMethod)
+		memoryConfig.HotplugSize = generic.Pointer(hotplugSize)
+	}
 
-	if err := cHyp.CreateVM(ctx, oapiclient.CreateVMJSONRequestBody{
-		Cpus:    cpusConfig,
-		Memory:  memoryConfig,
-		Payload: oapiclient.PayloadConfig{},
-	}); err != nil {
-		return err
+	var payloadConfig oapiclient.PayloadConfig
+	if r.Firmware != "" {
+		payloadConfig.Firmware = generic.Pointer(r.Firmware)
 	}
 
-	vmInfo, err := cHyp.GetVMInfo(ctx)
-	if err != nil {
-		return err
+	// The serial console is served on a socket so it can be streamed via exec.
+	serialConfig := &oapiclient.ConsoleConfig{
+		Mode:   oapiclient.ConsoleConfigModeSocket,
+		Socket: generic.Pointer(r.Hypervisor.SerialSocket(instance.ID)),
+	}
+	consoleConfig := &oapiclient.ConsoleConfig{
+		Mode: oapiclient.ConsoleConfigModeOff,
 	}
 
-	_ = vmInfo
-	return nil
+	var (
+		diskConfigs    []oapiclient.DiskConfig
+		platformConfig *oapiclient.PlatformConfig
+	)
+	if img != nil {
+		path, err := r.Images.EnsureRootDisk(instance.ID, img)
+		if err != nil {
+			return fmt.Errorf("error ensuring root disk: %w", err)
+		}
+
+		// The root disk is added first so the firmware boots from it.
+		diskConfigs = append(diskConfigs, oapiclient.DiskConfig{
+			Id:   generic.Pointer(rootDiskDeviceID),
+			Path: path,
+		})
+	}
+	if len(instance.Spec.IgnitionData) > 0 {
+		format, err := r.configDriveFormat(instance)
+		if err != nil {
+			return err
+		}
+
+		path, err := r.ConfigDrives.Ensure(instance.ID, format, instance.Spec.IgnitionData)
+		if err != nil {
+			return fmt.Errorf("error ensuring config drive: %w", err)
+		}
+
+		diskConfigs = append(diskConfigs, oapiclient.DiskConfig{
+			Id:       generic.Pointer(configDriveDeviceID),
+			Path:     path,
+			Readonly: generic.Pointer(true),
+		})
+		if format == configdrive.FormatCloudInit {
+			// cloud-init uses the smbios system serial number as hint to skip probing other data sources.
+			platformConfig = &oapiclient.PlatformConfig{
+				SerialNumber: generic.Pointer("ds=nocloud"),
+			}
+		}
+	}
+
+	body := oapiclient.CreateVMJSONRequestBody{
+		Cpus:     cpusConfig,
+		Memory:   memoryConfig,
+		Payload:  payloadConfig,
+		Serial:   serialConfig,
+		Console:  consoleConfig,
+		Platform: platformConfig,
+	}
+	if len(diskConfigs) > 0 {
+		body.Disks = &diskConfigs
+	}
+	return cHyp.CreateVM(ctx, body)
+}
+
+// The device ids of the root and config drive disks. They don't carry api.DiskDeviceIDPrefix so
+// they are never considered when reconciling disks.
+const (
+	rootDiskDeviceID    = "image"
+	configDriveDeviceID = "config-drive"
+)
+
+func (r *InstanceReconciler) configDriveFormat(instance *api.Instance) (configdrive.Format, error) {
+	format, ok := instance.Annotations[api.ConfigDriveFormatAnnotation]
+	if !ok {
+		return r.ConfigDriveFormat, nil
+	}
+	return configdrive.ParseFormat(format)
 }
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	chypclient "spheric.cloud/spheric/cloud-hypervisor/client"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/utils/generic"
+	"spheric.cloud/spheric/vee/api"
+)
+
+func vmDiskIDs(vmInfo *oapiclient.VmInfo) sets.Set[string] {
+	res := sets.New[string]()
+	for _, disk := range generic.DerefOrZero(vmInfo.Config.Disks) {
+		if id := generic.DerefOrZero(disk.Id); strings.HasPrefix(id, api.DiskDeviceIDPrefix) {
+			res.Insert(id)
+		}
+	}
+	return res
+}
+
+func (r *InstanceReconciler) diskPath(ctx context.Context, instance *api.Instance, disk *api.Disk) (string, error) {
+	switch {
+	case disk.EmptyDisk != nil:
+		return r.EmptyDisks.Ensure(instance.ID, disk.Name, disk.EmptyDisk.SizeBytes)
+	case disk.Connection != nil:
+		return r.DiskRegistry.Path(ctx, disk.Connection)
+	default:
+		return "", fmt.Errorf("disk %q does not specify any source", disk.Name)
+	}
+}
+
+// reconcileDisks adds missing and removes superfluous disks of the vm.
+// It returns whether any disk was added or removed.
+func (r *InstanceReconciler) reconcileDisks(
+	ctx context.Context,
+	log logr.Logger,
+	cHyp chypclient.Client,
+	instance *api.Instance,
+	vmInfo *oapiclient.VmInfo,
+) (bool, error) {
+	var (
+		actualIDs  = vmDiskIDs(vmInfo)
+		desiredIDs = sets.New[string]()
+		modified   bool
+		errs       []error
+	)
+
+	for _, disk := range instance.Spec.Disks {
+		id := api.DiskDeviceID(disk.Name)
+		desiredIDs.Insert(id)
+		if actualIDs.Has(id) {
+			continue
+		}
+
+		path, err := r.diskPath(ctx, instance, &disk)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("[disk %s] error determining path: %w", disk.Name, err))
+			continue
+		}
+
+		log.V(1).Info("Adding disk", "Disk", disk.Name, "Path", path)
+		if _, err := cHyp.AddDisk(ctx, oapiclient.DiskConfig{
+			Id:   generic.Pointer(id),
+			Path: path,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("[disk %s] error adding disk: %w", disk.Name, err))
+			continue
+		}
+		modified = true
+	}
+
+	for id := range actualIDs.Difference(desiredIDs) {
+		name := strings.TrimPrefix(id, api.DiskDeviceIDPrefix)
+		log.V(1).Info("Removing disk", "Disk", name)
+		if err := cHyp.RemoveDevice(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("[disk %s] error removing disk: %w", name, err))
+			continue
+		}
+		modified = true
+
+		if err := r.EmptyDisks.Remove(instance.ID, name); err != nil {
+			errs = append(errs, fmt.Errorf("[disk %s] error removing empty disk file: %w", name, err))
+		}
+	}
+
+	return modified, errors.Join(errs...)
+}
+
+func (r *InstanceReconciler) diskStatuses(instance *api.Instance, vmInfo *oapiclient.VmInfo) []api.DiskStatus {
+	actualIDs := vmDiskIDs(vmInfo)
+
+	var res []api.DiskStatus
+	for _, disk := range instance.Spec.Disks {
+		state := api.DiskStatePending
+		if actualIDs.Has(api.DiskDeviceID(disk.Name)) {
+			state = api.DiskStateAttached
+		}
+
+		res = append(res, api.DiskStatus{
+			Name:  disk.Name,
+			State: state,
+		})
+	}
+	return res
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	chypclient "spheric.cloud/spheric/cloud-hypervisor/client"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/utils/generic"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/network"
+)
+
+func vmNetworkInterfaceTaps(vmInfo *oapiclient.VmInfo) map[string]string {
+	res := make(map[string]string)
+	for _, net := range generic.DerefOrZero(vmInfo.Config.Net) {
+		if id := generic.DerefOrZero(net.Id); strings.HasPrefix(id, api.NetworkInterfaceDeviceIDPrefix) {
+			res[id] = generic.DerefOrZero(net.Tap)
+		}
+	}
+	return res
+}
+
+// reconcileNetworkInterfaces adds missing and removes superfluous network interfaces of the vm.
+// It returns whether any network interface was added or removed.
+func (r *InstanceReconciler) reconcileNetworkInterfaces(
+	ctx context.Context,
+	log logr.Logger,
+	cHyp chypclient.Client,
+	instance *api.Instance,
+	vmInfo *oapiclient.VmInfo,
+) (bool, error) {
+	var (
+		actualTaps = vmNetworkInterfaceTaps(vmInfo)
+		desiredIDs = sets.New[string]()
+		modified   bool
+		errs       []error
+	)
+
+	for _, nic := range instance.Spec.NetworkInterfaces {
+		id := api.NetworkInterfaceDeviceID(nic.Name)
+		desiredIDs.Insert(id)
+		if _, ok := actualTaps[id]; ok {
+			continue
+		}
+
+		log.V(1).Info("Applying network interface", "NetworkInterface", nic.Name)
+		tap, err := r.NetworkPlugin.Apply(ctx, instance.ID, &nic)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("[network interface %s] error applying: %w", nic.Name, err))
+			continue
+		}
+
+		log.V(1).Info("Adding network interface", "NetworkInterface", nic.Name, "Tap", tap)
+		if _, err := cHyp.AddNet(ctx, oapiclient.NetConfig{
+			Id:  generic.Pointer(id),
+			Tap: generic.Pointer(tap),
+			Mac: generic.Pointer(network.MAC(instance.ID, nic.Name).String()),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("[network interface %s] error adding network interface: %w", nic.Name, err))
+			continue
+		}
+		modified = true
+	}
+
+	for id := range actualTaps {
+		if desiredIDs.Has(id) {
+			continue
+		}
+
+		name := strings.TrimPrefix(id, api.NetworkInterfaceDeviceIDPrefix)
+		log.V(1).Info("Removing network interface", "NetworkInterface", name)
+		if err := cHyp.RemoveDevice(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("[network interface %s] error removing network interface: %w", name, err))
+			continue
+		}
+		modified = true
+
+		if err := r.NetworkPlugin.Delete(ctx, instance.ID, name); err != nil {
+			errs = append(errs, fmt.Errorf("[network interface %s] error deleting: %w", name, err))
+		}
+	}
+
+	return modified, errors.Join(errs...)
+}
+
+func (r *InstanceReconciler) deleteNetworkInterfaces(ctx context.Context, instance *api.Instance) error {
+	var errs []error
+	for _, nic := range instance.Spec.NetworkInterfaces {
+		if err := r.NetworkPlugin.Delete(ctx, instance.ID, nic.Name); err != nil {
+			errs = append(errs, fmt.Errorf("[network interface %s] error deleting: %w", nic.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *InstanceReconciler) networkInterfaceStatuses(instance *api.Instance, vmInfo *oapiclient.VmInfo) []api.NetworkInterfaceStatus {
+	actualTaps := vmNetworkInterfaceTaps(vmInfo)
+
+	var res []api.NetworkInterfaceStatus
+	for _, nic := range instance.Spec.NetworkInterfaces {
+		tap, ok := actualTaps[api.NetworkInterfaceDeviceID(nic.Name)]
+		if !ok {
+			res = append(res, api.NetworkInterfaceStatus{
+				Name:  nic.Name,
+				State: api.NetworkInterfaceStatePending,
+			})
+			continue
+		}
+
+		res = append(res, api.NetworkInterfaceStatus{
+			Name:   nic.Name,
+			Handle: tap,
+			State:  api.NetworkInterfaceStateAttached,
+		})
+	}
+	return res
+}
@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gstruct"
+	"spheric.cloud/spheric/actuo/meta"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/configdrive"
+	"spheric.cloud/spheric/vee/console"
+	"spheric.cloud/spheric/vee/image"
+)
+
+// writeImageLayout writes an OCI layout containing the given disk image, tagged v1.
+// It returns the layout directory and the digest of the image manifest.
+func writeImageLayout(disk []byte) (string, string) {
+	GinkgoHelper()
+
+	dir := GinkgoT().TempDir()
+	writeBlob := func(data []byte) string {
+		sum := sha256.Sum256(data)
+		Expect(os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "blobs", "sha256", hex.EncodeToString(sum[:])), data, 0644)).To(Succeed())
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	manifest, err := json.Marshal(image.Manifest{
+		MediaType: image.MediaTypeImageManifest,
+		Layers: []image.Descriptor{
+			{MediaType: image.MediaTypeDiskRaw, Digest: writeBlob(disk), Size: int64(len(disk))},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	manifestDigest := writeBlob(manifest)
+
+	index, err := json.Marshal(image.Manifest{
+		Manifests: []image.Descriptor{
+			{
+				MediaType:   image.MediaTypeImageManifest,
+				Digest:      manifestDigest,
+				Size:        int64(len(manifest)),
+				Annotations: map[string]string{image.AnnotationRefName: "v1"},
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(os.WriteFile(filepath.Join(dir, "index.json"), index, 0644)).To(Succeed())
+	return dir, manifestDigest
+}
+
+var _ = Describe("InstanceReconciler", func() {
+	const id = "foo"
+
+	createInstance := func(ctx SpecContext, spec api.InstanceSpec) {
+		GinkgoHelper()
+		_, err := store.Create(ctx, api.InstanceKey(id), &api.Instance{
+			ObjectMeta: meta.ObjectMeta{Name: id, Generation: 1},
+			ID:         id,
+			Spec:       spec,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	updateInstance := func(ctx SpecContext, update func(instance *api.Instance)) {
+		GinkgoHelper()
+		_, err := store.Update(ctx, api.InstanceKey(id), false, func(ctx context.Context, instance *api.Instance) (*api.Instance, error) {
+			update(instance)
+			return instance, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	instanceStatus := func(ctx SpecContext) func() (api.InstanceStatus, error) {
+		return func() (api.InstanceStatus, error) {
+			instance, err := store.Get(ctx, api.InstanceKey(id))
+			if err != nil {
+				return api.InstanceStatus{}, err
+			}
+			return instance.Status, nil
+		}
+	}
+
+	vmState := func() oapiclient.VmInfoState {
+		vmm := hypervisor.VMM(id)
+		if vmm == nil {
+			return ""
+		}
+		vmInfo := vmm.VMInfo()
+		if vmInfo == nil {
+			return ""
+		}
+		return vmInfo.State
+	}
+
+	It("should run an instance with disks and network interfaces", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{
+			Power:       api.PowerOn,
+			CPUCount:    2,
+			MemoryBytes: 1024 * 1024 * 1024,
+			Disks: []api.Disk{
+				{Name: "root", Device: "vda", EmptyDisk: &api.EmptyDisk{SizeBytes: 1024 * 1024}},
+			},
+			NetworkInterfaces: []api.NetworkInterface{
+				{Name: "eth0", IPs: []string{"10.0.0.1"}},
+			},
+		})
+
+		By("waiting for the instance to run")
+		Eventually(instanceStatus(ctx)).Should(Equal(api.InstanceStatus{
+			ObservedGeneration: 1,
+			State:              api.InstanceStateRunning,
+			Disks: []api.DiskStatus{
+				{Name: "root", State: api.DiskStateAttached},
+			},
+			NetworkInterfaces: []api.NetworkInterfaceStatus{
+				{Name: "eth0", Handle: "tap-eth0", State: api.NetworkInterfaceStateAttached},
+			},
+			ResizeLimits: &api.ResizeLimits{
+				MaxCPUCount:    4,
+				MinMemoryBytes: 1024 * 1024 * 1024,
+				MaxMemoryBytes: 2 * 1024 * 1024 * 1024,
+			},
+		}))
+
+		By("inspecting the vm")
+		vmInfo := hypervisor.VMM(id).VMInfo()
+		Expect(vmInfo.State).To(Equal(oapiclient.Running))
+		Expect(vmInfo.Config.Cpus).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"BootVcpus": Equal(2),
+			"MaxVcpus":  Equal(4),
+		})))
+		Expect(vmInfo.Config.Memory).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Size":          BeEquivalentTo(1024 * 1024 * 1024),
+			"HotplugMethod": PointTo(Equal("VirtioMem")),
+			"HotplugSize":   PointTo(BeEquivalentTo(1024 * 1024 * 1024)),
+		})))
+		Expect(vmInfo.Config.Disks).To(PointTo(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Id":   PointTo(Equal("disk-root")),
+			"Path": Equal(filepath.Join(emptyDiskDir, id, "root.raw")),
+		}))))
+		Expect(vmInfo.Config.Net).To(PointTo(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Id":  PointTo(Equal("nic-eth0")),
+			"Tap": PointTo(Equal("tap-eth0")),
+		}))))
+		Expect(vmInfo.Config.Serial).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Mode":   Equal(oapiclient.ConsoleConfigModeSocket),
+			"Socket": PointTo(Equal(hypervisor.SerialSocket(id))),
+		})))
+		Expect(filepath.Join(emptyDiskDir, id, "root.raw")).To(BeAnExistingFile())
+		Expect(networkPlugin.Applied()).To(ConsistOf(id + "/eth0"))
+	})
+
+	It("should record the serial console of running instances", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{
+			Power:       api.PowerOn,
+			CPUCount:    1,
+			MemoryBytes: 1024 * 1024 * 1024,
+		})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("writing to the echoing serial console")
+		attachCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		in, inWriter := io.Pipe()
+		defer func() { _ = inWriter.Close() }()
+		out := gbytes.NewBuffer()
+		go func() {
+			defer GinkgoRecover()
+			Expect(consoles.Attach(attachCtx, id, in, out)).To(Succeed())
+		}()
+		_, err := inWriter.Write([]byte("hello\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(out).Should(gbytes.Say("hello\n"))
+
+		By("reading the console log")
+		Eventually(func() (string, error) {
+			var log strings.Builder
+			err := consoles.ReadLog(ctx, id, console.LogOptions{}, &log)
+			return log.String(), err
+		}).Should(Equal("hello\n"))
+	})
+
+	It("should boot from a root disk created from the image", func(ctx SpecContext) {
+		disk := []byte("disk image contents")
+		layoutDir, manifestDigest := writeImageLayout(disk)
+
+		createInstance(ctx, api.InstanceSpec{
+			Power:       api.PowerOn,
+			Image:       image.LayoutPrefix + layoutDir + ":v1",
+			CPUCount:    1,
+			MemoryBytes: 1024 * 1024 * 1024,
+		})
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"State":    Equal(api.InstanceStateRunning),
+			"ImageRef": Equal(image.LayoutPrefix + layoutDir + "@" + manifestDigest),
+		}))
+
+		By("inspecting the vm")
+		Expect(hypervisor.VMM(id).VMInfo().Config.Disks).To(PointTo(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Id":   PointTo(Equal("image")),
+			"Path": Equal(images.RootDiskPath(id)),
+		}))))
+		Expect(os.ReadFile(images.RootDiskPath(id))).To(Equal(disk))
+
+		By("deleting the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.DeletionTimestamp = &meta.Time{Time: instance.CreationTimestamp.Time}
+		})
+		Eventually(func() error {
+			_, err := store.Get(ctx, api.InstanceKey(id))
+			return err
+		}).Should(MatchError(storagestore.ErrNotFound))
+		Expect(images.RootDiskPath(id)).NotTo(BeAnExistingFile())
+	})
+
+	It("should deliver the ignition data via a config drive", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{
+			Power:        api.PowerOn,
+			CPUCount:     1,
+			MemoryBytes:  1024 * 1024 * 1024,
+			IgnitionData: []byte(`{"ignition":{"version":"3.4.0"}}`),
+		})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("inspecting the vm")
+		vmInfo := hypervisor.VMM(id).VMInfo()
+		Expect(vmInfo.Config.Disks).To(PointTo(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Id":       PointTo(Equal("config-drive")),
+			"Path":     Equal(configDrives.Path(id)),
+			"Readonly": PointTo(BeTrue()),
+		}))))
+		Expect(vmInfo.Config.Platform).To(BeNil())
+		Expect(configDrives.Path(id)).To(BeAnExistingFile())
+	})
+
+	It("should use the config drive format of the instance annotation", func(ctx SpecContext) {
+		_, err := store.Create(ctx, api.InstanceKey(id), &api.Instance{
+			ObjectMeta: meta.ObjectMeta{
+				Name:        id,
+				Generation:  1,
+				Annotations: map[string]string{api.ConfigDriveFormatAnnotation: string(configdrive.FormatCloudInit)},
+			},
+			ID: id,
+			Spec: api.InstanceSpec{
+				Power:        api.PowerOn,
+				CPUCount:     1,
+				MemoryBytes:  1024 * 1024 * 1024,
+				IgnitionData: []byte("#cloud-config\n"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		Expect(hypervisor.VMM(id).VMInfo().Config.Platform).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"SerialNumber": PointTo(Equal("ds=nocloud")),
+		})))
+	})
+
+	It("should power off an instance", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("powering off the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.Power = api.PowerOff
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration": BeEquivalentTo(2),
+			"State":              Equal(api.InstanceStateTerminated),
+		}))
+		Expect(vmState()).To(Equal(oapiclient.Shutdown))
+	})
+
+	It("should suspend and resume an instance", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("suspending the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.Power = api.PowerSuspended
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration": BeEquivalentTo(2),
+			"State":              Equal(api.InstanceStateSuspended),
+		}))
+		Expect(vmState()).To(Equal(oapiclient.Paused))
+
+		By("resuming the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.Power = api.PowerOn
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration": BeEquivalentTo(3),
+			"State":              Equal(api.InstanceStateRunning),
+		}))
+		Expect(vmState()).To(Equal(oapiclient.Running))
+	})
+
+	It("should reboot an instance on a new reboot generation", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("requesting a hard reboot")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.RebootGeneration = 1
+			instance.Spec.RebootType = api.RebootHard
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(HaveField("ObservedRebootGeneration", BeEquivalentTo(1)))
+		Expect(hypervisor.VMM(id).Reboots()).To(Equal(1))
+
+		By("requesting a graceful reboot")
+		vmmBeforeReboot := hypervisor.VMM(id)
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.RebootGeneration = 2
+			instance.Spec.RebootType = api.RebootGraceful
+			instance.Generation++
+		})
+
+		By("waiting for the vmm to exit and to be started again")
+		Eventually(hypervisor.VMM).WithArguments(id).Should(SatisfyAll(
+			Not(BeNil()),
+			Not(BeIdenticalTo(vmmBeforeReboot)),
+		))
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration":       BeEquivalentTo(3),
+			"ObservedRebootGeneration": BeEquivalentTo(2),
+			"RebootPending":            BeFalse(),
+			"State":                    Equal(api.InstanceStateRunning),
+		}))
+		Expect(vmState()).To(Equal(oapiclient.Running))
+
+		By("asserting the instance keeps running")
+		Consistently(instanceStatus(ctx), "500ms").Should(HaveField("State", api.InstanceStateRunning))
+		Expect(hypervisor.VMM(id).Reboots()).To(BeZero())
+	})
+
+	It("should resize a running instance within its resize limits", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("resizing the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.CPUCount = 2
+			instance.Spec.MemoryBytes = 2 * 1024 * 1024 * 1024
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(HaveField("ObservedGeneration", BeEquivalentTo(2)))
+		vmInfo := hypervisor.VMM(id).VMInfo()
+		Expect(vmInfo.State).To(Equal(oapiclient.Running))
+		Expect(vmInfo.Config.Cpus).To(PointTo(HaveField("BootVcpus", Equal(2))))
+		Expect(vmInfo.Config.Memory).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Size":           BeEquivalentTo(1024 * 1024 * 1024),
+			"HotpluggedSize": PointTo(BeEquivalentTo(1024 * 1024 * 1024)),
+		})))
+	})
+
+	It("should re-create the vm with resources beyond its resize limits when powering on", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("resizing the running instance beyond its resize limits")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.CPUCount = 8
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(HaveField("ObservedGeneration", BeEquivalentTo(2)))
+		Expect(hypervisor.VMM(id).VMInfo().Config.Cpus).To(PointTo(HaveField("BootVcpus", Equal(1))))
+
+		By("powering the instance off")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.Power = api.PowerOff
+			instance.Generation++
+		})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateTerminated))
+
+		By("powering the instance on")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.Power = api.PowerOn
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration": BeEquivalentTo(4),
+			"State":              Equal(api.InstanceStateRunning),
+			"ResizeLimits": PointTo(MatchFields(IgnoreExtras, Fields{
+				"MaxCPUCount": BeEquivalentTo(8),
+			})),
+		}))
+		Expect(hypervisor.VMM(id).VMInfo().Config.Cpus).To(PointTo(HaveField("BootVcpus", Equal(8))))
+	})
+
+	It("should report unexpected vmm exits as terminated and restart on spec changes", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{Power: api.PowerOn, CPUCount: 1, MemoryBytes: 1024 * 1024 * 1024})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("crashing the vmm")
+		hypervisor.Crash(id)
+
+		Eventually(instanceStatus(ctx)).Should(Equal(api.InstanceStatus{
+			ObservedGeneration: 1,
+			State:              api.InstanceStateTerminated,
+		}))
+		Consistently(hypervisor.VMM, "500ms").WithArguments(id).Should(BeNil())
+
+		By("changing the spec of the instance")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.Spec.CPUCount = 2
+			instance.Generation++
+		})
+
+		Eventually(instanceStatus(ctx)).Should(MatchFields(IgnoreExtras, Fields{
+			"ObservedGeneration": BeEquivalentTo(2),
+			"State":              Equal(api.InstanceStateRunning),
+		}))
+		Expect(vmState()).To(Equal(oapiclient.Running))
+	})
+
+	It("should tear down deleted instances", func(ctx SpecContext) {
+		createInstance(ctx, api.InstanceSpec{
+			Power:       api.PowerOn,
+			CPUCount:    1,
+			MemoryBytes: 1024 * 1024 * 1024,
+			Disks: []api.Disk{
+				{Name: "root", Device: "vda", EmptyDisk: &api.EmptyDisk{SizeBytes: 1024 * 1024}},
+			},
+			NetworkInterfaces: []api.NetworkInterface{
+				{Name: "eth0"},
+			},
+			IgnitionData: []byte("{}"),
+		})
+		Eventually(instanceStatus(ctx)).Should(HaveField("State", api.InstanceStateRunning))
+
+		By("marking the instance for deletion")
+		updateInstance(ctx, func(instance *api.Instance) {
+			instance.DeletionTimestamp = &meta.Time{Time: instance.CreationTimestamp.Time}
+		})
+
+		Eventually(func() error {
+			_, err := store.Get(ctx, api.InstanceKey(id))
+			return err
+		}).Should(MatchError(storagestore.ErrNotFound))
+		Expect(hypervisor.VMM(id)).To(BeNil())
+		Expect(networkPlugin.Applied()).To(BeEmpty())
+		Expect(filepath.Join(emptyDiskDir, id)).NotTo(BeAnExistingFile())
+		Expect(configDrives.Path(id)).NotTo(BeAnExistingFile())
+		Expect(consoles.LogFile(id)).NotTo(BeAnExistingFile())
+	})
+})
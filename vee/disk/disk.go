@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"spheric.cloud/spheric/vee/api"
+)
+
+// Driver resolves api.DiskConnection objects of a single driver to paths usable by cloud-hypervisor.
+type Driver interface {
+	// Path returns the host path of the disk referenced by the given connection.
+	Path(ctx context.Context, conn *api.DiskConnection) (string, error)
+}
+
+// Registry is a registry of Driver implementations, keyed by the api.DiskConnection driver name.
+type Registry struct {
+	mu      sync.RWMutex
+	drivers map[string]Driver
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		drivers: make(map[string]Driver),
+	}
+}
+
+// Register registers the given driver for the given name.
+func (r *Registry) Register(name string, driver Driver) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.drivers[name]; ok {
+		return fmt.Errorf("driver %q is already registered", name)
+	}
+	r.drivers[name] = driver
+	return nil
+}
+
+// Get returns the driver registered for the given name.
+func (r *Registry) Get(name string) (Driver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	driver, ok := r.drivers[name]
+	if !ok {
+		return nil, fmt.Errorf("no driver registered for %q", name)
+	}
+	return driver, nil
+}
+
+// Path resolves the given connection using the driver registered for its driver name.
+func (r *Registry) Path(ctx context.Context, conn *api.DiskConnection) (string, error) {
+	driver, err := r.Get(conn.Driver)
+	if err != nil {
+		return "", err
+	}
+	return driver.Path(ctx, conn)
+}
+
+// NewDefaultRegistry returns a Registry with all built-in drivers registered.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	_ = r.Register(LocalFileDriverName, LocalFile{})
+	_ = r.Register(RawBlockDriverName, RawBlock{})
+	return r
+}
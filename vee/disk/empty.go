@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EmptyDisks manages sparse files backing api.EmptyDisk disks.
+type EmptyDisks struct {
+	dir string
+}
+
+func NewEmptyDisks(dir string) *EmptyDisks {
+	return &EmptyDisks{dir: dir}
+}
+
+func (e *EmptyDisks) instanceDir(instanceID string) string {
+	return filepath.Join(e.dir, instanceID)
+}
+
+func (e *EmptyDisks) path(instanceID, name string) string {
+	return filepath.Join(e.instanceDir(instanceID), name+".raw")
+}
+
+// Ensure ensures a sparse file of the given size exists for the given instance disk and returns its path.
+// Existing files are never shrunk.
+func (e *EmptyDisks) Ensure(instanceID, name string, sizeBytes int64) (string, error) {
+	if err := os.MkdirAll(e.instanceDir(instanceID), 0700); err != nil {
+		return "", fmt.Errorf("error creating instance disk directory: %w", err)
+	}
+
+	path := e.path(instanceID, name)
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return "", fmt.Errorf("error opening empty disk file: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("error stat-ing empty disk file: %w", err)
+	}
+
+	if stat.Size() < sizeBytes {
+		if err := f.Truncate(sizeBytes); err != nil {
+			return "", fmt.Errorf("error resizing empty disk file: %w", err)
+		}
+	}
+	return path, nil
+}
+
+// Remove removes the file of the given instance disk, if any.
+func (e *EmptyDisks) Remove(instanceID, name string) error {
+	if err := os.Remove(e.path(instanceID, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// RemoveAll removes the files of all disks of the given instance.
+func (e *EmptyDisks) RemoveAll(instanceID string) error {
+	return os.RemoveAll(e.instanceDir(instanceID))
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"spheric.cloud/spheric/vee/api"
+)
+
+const (
+	// LocalFileDriverName is the driver name of LocalFile.
+	LocalFileDriverName = "local-file"
+	// RawBlockDriverName is the driver name of RawBlock.
+	RawBlockDriverName = "raw-block"
+)
+
+// LocalFile is a Driver for disk images that are regular files on the host.
+// The connection handle is the absolute path of the file.
+type LocalFile struct{}
+
+func (LocalFile) Path(_ context.Context, conn *api.DiskConnection) (string, error) {
+	if !filepath.IsAbs(conn.Handle) {
+		return "", fmt.Errorf("handle %q is not an absolute path", conn.Handle)
+	}
+
+	stat, err := os.Stat(conn.Handle)
+	if err != nil {
+		return "", fmt.Errorf("error stat-ing %s: %w", conn.Handle, err)
+	}
+	if !stat.Mode().IsRegular() {
+		return "", fmt.Errorf("%s is not a regular file", conn.Handle)
+	}
+	return conn.Handle, nil
+}
+
+// RawBlock is a Driver for block devices on the host.
+// The connection handle is the absolute path of the block device.
+type RawBlock struct{}
+
+func (RawBlock) Path(_ context.Context, conn *api.DiskConnection) (string, error) {
+	if !filepath.IsAbs(conn.Handle) {
+		return "", fmt.Errorf("handle %q is not an absolute path", conn.Handle)
+	}
+
+	stat, err := os.Stat(conn.Handle)
+	if err != nil {
+		return "", fmt.Errorf("error stat-ing %s: %w", conn.Handle, err)
+	}
+	if mode := stat.Mode(); mode&os.ModeDevice == 0 || mode&os.ModeCharDevice != 0 {
+		return "", fmt.Errorf("%s is not a block device", conn.Handle)
+	}
+	return conn.Handle, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package host
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultProcDir is the default location of the proc filesystem.
+const DefaultProcDir = "/proc"
+
+// Resources are the compute resources of a host.
+type Resources struct {
+	CPUCount    int64
+	MemoryBytes uint64
+}
+
+// ReadResources reads the compute resources of the host from the given proc filesystem directory.
+func ReadResources(procDir string) (*Resources, error) {
+	cpuCount, err := readCPUCount(filepath.Join(procDir, "cpuinfo"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cpu count: %w", err)
+	}
+
+	memoryBytes, err := readMemoryBytes(filepath.Join(procDir, "meminfo"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading memory: %w", err)
+	}
+
+	return &Resources{
+		CPUCount:    cpuCount,
+		MemoryBytes: memoryBytes,
+	}, nil
+}
+
+func readCPUCount(filename string) (int64, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		key, _, ok := strings.Cut(sc.Text(), ":")
+		if ok && strings.TrimSpace(key) == "processor" {
+			count++
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, fmt.Errorf("no processors found in %s", filename)
+	}
+	return count, nil
+}
+
+func readMemoryBytes(filename string) (uint64, error) {
+	return readKiBValue(filename, "MemTotal")
+}
+
+// readKiBValue reads the value of the given key from a proc file with lines of the format '<key>: <amount> kB'
+// and returns it in bytes.
+func readKiBValue(filename, key string) (uint64, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		k, value, ok := strings.Cut(sc.Text(), ":")
+		if !ok || k != key {
+			continue
+		}
+
+		fields := strings.Fields(value)
+		if len(fields) != 2 || fields[1] != "kB" {
+			return 0, fmt.Errorf("malformed %s value %q", key, value)
+		}
+
+		kiB, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("malformed %s value %q: %w", key, value, err)
+		}
+		return kiB * 1024, nil
+	}
+	if err := sc.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("no %s found in %s", key, filename)
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package host_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "spheric.cloud/spheric/vee/host"
+)
+
+var _ = Describe("Host", func() {
+	var procDir string
+
+	BeforeEach(func() {
+		procDir = GinkgoT().TempDir()
+	})
+
+	writeProcFile := func(name, content string) {
+		GinkgoHelper()
+		Expect(os.WriteFile(filepath.Join(procDir, name), []byte(content), 0644)).To(Succeed())
+	}
+
+	Describe("ReadResources", func() {
+		It("should read the cpu count and memory", func() {
+			writeProcFile("cpuinfo", `processor	: 0
+vendor_id	: GenuineIntel
+model name	: Some CPU
+
+processor	: 1
+vendor_id	: GenuineIntel
+model name	: Some CPU
+`)
+			writeProcFile("meminfo", `MemTotal:       16384 kB
+MemFree:         1024 kB
+`)
+
+			Expect(ReadResources(procDir)).To(Equal(&Resources{
+				CPUCount:    2,
+				MemoryBytes: 16384 * 1024,
+			}))
+		})
+
+		It("should error if no processors are listed", func() {
+			writeProcFile("cpuinfo", "")
+			writeProcFile("meminfo", "MemTotal:       16384 kB\n")
+
+			_, err := ReadResources(procDir)
+			Expect(err).To(MatchError(ContainSubstring("no processors found")))
+		})
+
+		It("should error on malformed memory information", func() {
+			writeProcFile("cpuinfo", "processor	: 0\n")
+			writeProcFile("meminfo", "MemTotal:       lots\n")
+
+			_, err := ReadResources(procDir)
+			Expect(err).To(MatchError(ContainSubstring("malformed MemTotal")))
+		})
+
+		It("should read the resources of the actual host", func() {
+			res, err := ReadResources(DefaultProcDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.CPUCount).To(BeNumerically(">", 0))
+			Expect(res.MemoryBytes).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("ReadProcessUsage", func() {
+		It("should read the cpu time and resident memory of the process", func() {
+			Expect(os.Mkdir(filepath.Join(procDir, "42"), 0755)).To(Succeed())
+			writeProcFile("42/stat", "42 (cloud hyper (v)) S 1 42 42 0 -1 4194560 1 0 0 0 250 50 0 0 20 0 3 0 100 0 0\n")
+			writeProcFile("42/status", `Name:	cloud-hyperviso
+VmRSS:	    2048 kB
+`)
+
+			Expect(ReadProcessUsage(procDir, 42)).To(Equal(&ProcessUsage{
+				CPUTime:     3 * time.Second,
+				MemoryBytes: 2048 * 1024,
+			}))
+		})
+
+		It("should read the usage of the current process", func() {
+			usage, err := ReadProcessUsage(DefaultProcDir, os.Getpid())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.MemoryBytes).To(BeNumerically(">", 0))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package host
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicksPerSecond is the unit of the cpu times in /proc/<pid>/stat (USER_HZ).
+// It is 100 on all architectures Linux reports it to userspace for.
+const clockTicksPerSecond = 100
+
+// ProcessUsage is the resource usage of a process.
+type ProcessUsage struct {
+	// CPUTime is the cpu time consumed by the process in user and kernel mode, summed over all threads.
+	CPUTime time.Duration
+	// MemoryBytes is the resident memory of the process.
+	MemoryBytes uint64
+}
+
+// ReadProcessUsage reads the resource usage of the process with the given pid from the given proc filesystem directory.
+func ReadProcessUsage(procDir string, pid int) (*ProcessUsage, error) {
+	dir := filepath.Join(procDir, strconv.Itoa(pid))
+
+	cpuTime, err := readCPUTime(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cpu time: %w", err)
+	}
+
+	memoryBytes, err := readKiBValue(filepath.Join(dir, "status"), "VmRSS")
+	if err != nil {
+		return nil, fmt.Errorf("error reading memory: %w", err)
+	}
+
+	return &ProcessUsage{
+		CPUTime:     cpuTime,
+		MemoryBytes: memoryBytes,
+	}, nil
+}
+
+func readCPUTime(filename string) (time.Duration, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	// The command name (2nd field) may contain spaces and parentheses, so fields are
+	// counted from its closing parenthesis. utime and stime are the 14th and 15th field.
+	idx := bytes.LastIndexByte(data, ')')
+	if idx < 0 {
+		return 0, fmt.Errorf("malformed stat %q", data)
+	}
+	fields := strings.Fields(string(data[idx+1:]))
+	const utimeIdx, stimeIdx = 14 - 3, 15 - 3
+	if len(fields) <= stimeIdx {
+		return 0, fmt.Errorf("malformed stat %q", data)
+	}
+
+	var ticks uint64
+	for _, field := range fields[utimeIdx : stimeIdx+1] {
+		n, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("malformed stat: %w", err)
+		}
+		ticks += n
+	}
+	return time.Duration(ticks) * time.Second / clockTicksPerSecond, nil
+}
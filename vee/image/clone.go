@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+const sparseCopyChunkSize = 64 * 1024
+
+// cloneFile creates dst as copy-on-write clone (reflink) of src. If the file system does not
+// support reflinks, dst is created as sparse copy of src instead.
+func cloneFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = out.Close()
+		_ = os.Remove(out.Name())
+	}()
+
+	if err := unix.IoctlFileClone(int(out.Fd()), int(in.Fd())); err != nil {
+		if err := sparseCopy(out, in); err != nil {
+			return err
+		}
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Rename(out.Name(), dst)
+}
+
+// sparseCopy copies in to out, skipping chunks that only contain zeros.
+func sparseCopy(out, in *os.File) error {
+	var (
+		buf  = make([]byte, sparseCopyChunkSize)
+		zero = make([]byte, sparseCopyChunkSize)
+		size int64
+	)
+	for {
+		n, err := io.ReadFull(in, buf)
+		if n > 0 {
+			if bytes.Equal(buf[:n], zero[:n]) {
+				if _, err := out.Seek(int64(n), io.SeekCurrent); err != nil {
+					return err
+				}
+			} else if _, err := out.Write(buf[:n]); err != nil {
+				return err
+			}
+			size += int64(n)
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return err
+		}
+	}
+	// Trailing zero chunks were only skipped, hence truncate to extend the file to its full size.
+	return out.Truncate(size)
+}
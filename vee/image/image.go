@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package image resolves the images of instances to disk images and materializes them as root disks.
+//
+// Images are OCI artifacts (pulled from a registry or read from a local OCI layout directory) with a
+// layer containing a raw or qcow2 disk image. Manifests and disk images are cached content-addressed,
+// the root disk of each instance is a copy-on-write clone of the cached disk image.
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// ErrNotFound is returned if an image or any of its parts does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Image is a resolved image.
+type Image struct {
+	// Ref references the image by digest, e.g. registry.example.org/os/ubuntu@sha256:...
+	Ref string
+	// DiskDigest is the digest of the disk image of the image.
+	DiskDigest string
+	// DiskPath is the path of the cached disk image.
+	DiskPath string
+}
+
+type Options struct {
+	// Client is used to access registries. Defaults to http.DefaultClient.
+	Client *http.Client
+	// InsecureRegistries are the registries to access via plain http instead of https.
+	InsecureRegistries []string
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.Client == nil {
+		o.Client = http.DefaultClient
+	}
+}
+
+// Store pulls and caches images and manages the root disks created from them.
+type Store struct {
+	dir                string
+	client             *http.Client
+	insecureRegistries []string
+
+	// mu serializes pulls so concurrent pulls don't fetch the same blobs.
+	mu sync.Mutex
+}
+
+func NewStore(dir string, opts Options) *Store {
+	setOptionsDefaults(&opts)
+
+	return &Store{
+		dir:                dir,
+		client:             opts.Client,
+		insecureRegistries: opts.InsecureRegistries,
+	}
+}
+
+func (s *Store) blobsDir() string {
+	return filepath.Join(s.dir, "blobs")
+}
+
+func (s *Store) blobPath(digest string) string {
+	return filepath.Join(s.blobsDir(), blobPath(digest))
+}
+
+func (s *Store) rootDisksDir() string {
+	return filepath.Join(s.dir, "roots")
+}
+
+// RootDiskPath returns the path of the root disk of the given instance.
+func (s *Store) RootDiskPath(instanceID string) string {
+	return filepath.Join(s.rootDisksDir(), instanceID)
+}
+
+func (s *Store) source(ref Reference) source {
+	if ref.LayoutDir != "" {
+		return &layoutSource{dir: ref.LayoutDir}
+	}
+
+	scheme := "https"
+	if slices.Contains(s.insecureRegistries, ref.Registry) {
+		scheme = "http"
+	}
+	return &registrySource{
+		client:     s.client,
+		baseURL:    &url.URL{Scheme: scheme, Host: ref.apiHost()},
+		repository: ref.Repository,
+	}
+}
+
+// Pull resolves the given image reference, fetching its manifest and disk image unless cached.
+// References by digest whose manifest is cached are resolved without accessing the source.
+func (s *Store) Pull(ctx context.Context, image string) (*Image, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	src := s.source(ref)
+
+	tagOrDigest := ref.Digest
+	if tagOrDigest == "" {
+		tagOrDigest = ref.Tag
+	}
+	mediaType, digest, data, err := s.manifest(ctx, src, tagOrDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest: %w", err)
+	}
+
+	blobs := []string{digest}
+	if isIndex(mediaType) {
+		desc, err := selectPlatform(manifest.Manifests)
+		if err != nil {
+			return nil, err
+		}
+
+		_, _, data, err := s.manifest(ctx, src, desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, desc.Digest)
+		manifest = &Manifest{}
+		if err := json.Unmarshal(data, manifest); err != nil {
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+	}
+
+	idx := slices.IndexFunc(manifest.Layers, isDiskLayer)
+	if idx < 0 {
+		return nil, fmt.Errorf("image %s does not contain a disk image layer", ref)
+	}
+	layer := manifest.Layers[idx]
+
+	if err := s.ensureBlob(ctx, src, layer); err != nil {
+		return nil, fmt.Errorf("error pulling disk image: %w", err)
+	}
+
+	blobs = append(blobs, layer.Digest)
+
+	resolved := ref
+	resolved.Tag = ""
+	resolved.Digest = digest
+	if err := s.record(resolved.String(), image, blobs); err != nil {
+		return nil, fmt.Errorf("error recording image: %w", err)
+	}
+	return &Image{
+		Ref:        resolved.String(),
+		DiskDigest: layer.Digest,
+		DiskPath:   s.blobPath(layer.Digest),
+	}, nil
+}
+
+// selectPlatform selects the manifest of the host platform. Indices with a single manifest are
+// accepted regardless of its platform, since disk images are commonly not annotated with one.
+func selectPlatform(manifests []Descriptor) (*Descriptor, error) {
+	for _, desc := range manifests {
+		if p := desc.Platform; p != nil && p.OS == "linux" && p.Architecture == runtime.GOARCH {
+			return &desc, nil
+		}
+	}
+	if len(manifests) == 1 {
+		return &manifests[0], nil
+	}
+	return nil, fmt.Errorf("no manifest for platform linux/%s: %w", runtime.GOARCH, ErrNotFound)
+}
+
+// manifest returns the manifest with the given tag or digest, preferring the cache for digests.
+// Fetched manifests are cached.
+func (s *Store) manifest(ctx context.Context, src source, tagOrDigest string) (string, string, []byte, error) {
+	if validateDigest(tagOrDigest) == nil {
+		data, err := os.ReadFile(s.blobPath(tagOrDigest))
+		if err == nil {
+			mediaType, err := manifestMediaType(data)
+			if err != nil {
+				return "", "", nil, err
+			}
+			return mediaType, tagOrDigest, data, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", "", nil, fmt.Errorf("error reading cached manifest: %w", err)
+		}
+	}
+
+	mediaType, digest, data, err := src.manifest(ctx, tagOrDigest)
+	if err != nil {
+		return "", "", nil, err
+	}
+	if err := s.writeBlob(digest, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	}); err != nil {
+		return "", "", nil, fmt.Errorf("error caching manifest: %w", err)
+	}
+	return mediaType, digest, data, nil
+}
+
+// ensureBlob fetches the blob of the given descriptor unless cached, verifying its size and digest.
+func (s *Store) ensureBlob(ctx context.Context, src source, desc Descriptor) error {
+	if err := validateDigest(desc.Digest); err != nil {
+		return err
+	}
+	if _, err := os.Stat(s.blobPath(desc.Digest)); err == nil {
+		return nil
+	}
+
+	rc, err := src.blob(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rc.Close() }()
+
+	return s.writeBlob(desc.Digest, func(w io.Writer) error {
+		h := sha256.New()
+		n, err := io.Copy(io.MultiWriter(w, h), rc)
+		if err != nil {
+			return err
+		}
+		if desc.Size > 0 && n != desc.Size {
+			return fmt.Errorf("size mismatch: expected %d, got %d", desc.Size, n)
+		}
+		if actual := hashDigest(h); actual != desc.Digest {
+			return fmt.Errorf("digest mismatch: expected %s, got %s", desc.Digest, actual)
+		}
+		return nil
+	})
+}
+
+func hashDigest(h hash.Hash) string {
+	return sha256Algorithm + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// writeBlob writes the blob with the given digest. The blob only becomes visible once write succeeded.
+func (s *Store) writeBlob(digest string, write func(w io.Writer) error) error {
+	path := s.blobPath(digest)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if err := write(f); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// EnsureRootDisk returns the root disk of the given instance, creating it from the disk image
+// of the given image if it does not exist yet. Existing root disks are kept as they are, so the
+// state of the root disk is preserved across vm re-creations.
+func (s *Store) EnsureRootDisk(instanceID string, img *Image) (string, error) {
+	path := s.RootDiskPath(instanceID)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("error stat-ing root disk: %w", err)
+	}
+
+	if err := os.MkdirAll(s.rootDisksDir(), 0700); err != nil {
+		return "", fmt.Errorf("error creating root disk directory: %w", err)
+	}
+	if err := cloneFile(img.DiskPath, path); err != nil {
+		return "", fmt.Errorf("error creating root disk: %w", err)
+	}
+	return path, nil
+}
+
+// RemoveRootDisk removes the root disk of the given instance, if any.
+func (s *Store) RemoveRootDisk(instanceID string) error {
+	if err := os.Remove(s.RootDiskPath(instanceID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
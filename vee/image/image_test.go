@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"spheric.cloud/spheric/vee/image"
+)
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// testImage is a disk image artifact consisting of an image manifest wrapped in an index.
+type testImage struct {
+	disk           []byte
+	manifest       []byte
+	manifestDigest string
+	index          []byte
+	indexDigest    string
+	blobs          map[string][]byte
+}
+
+func newTestImage(disk []byte) *testImage {
+	GinkgoHelper()
+
+	img := &testImage{disk: disk, blobs: make(map[string][]byte)}
+	add := func(data []byte) string {
+		digest := digestOf(data)
+		img.blobs[digest] = data
+		return digest
+	}
+
+	manifest, err := json.Marshal(image.Manifest{
+		MediaType: image.MediaTypeImageManifest,
+		Layers: []image.Descriptor{
+			{MediaType: image.MediaTypeDiskRaw, Digest: add(disk), Size: int64(len(disk))},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	img.manifest = manifest
+	img.manifestDigest = add(manifest)
+
+	index, err := json.Marshal(image.Manifest{
+		MediaType: image.MediaTypeImageIndex,
+		Manifests: []image.Descriptor{
+			{
+				MediaType:   image.MediaTypeImageManifest,
+				Digest:      img.manifestDigest,
+				Size:        int64(len(manifest)),
+				Annotations: map[string]string{image.AnnotationRefName: "v1"},
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	img.index = index
+	img.indexDigest = digestOf(index)
+	return img
+}
+
+// writeLayout writes the image as OCI layout to a new directory.
+func (img *testImage) writeLayout() string {
+	GinkgoHelper()
+
+	dir := GinkgoT().TempDir()
+	Expect(os.WriteFile(filepath.Join(dir, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644)).To(Succeed())
+	Expect(os.WriteFile(filepath.Join(dir, "index.json"), img.index, 0644)).To(Succeed())
+	Expect(os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0755)).To(Succeed())
+	for digest, data := range img.blobs {
+		Expect(os.WriteFile(filepath.Join(dir, "blobs", "sha256", strings.TrimPrefix(digest, "sha256:")), data, 0644)).To(Succeed())
+	}
+	return dir
+}
+
+// serveRegistry serves the image as repository os/disk via a registry requiring bearer tokens.
+func (img *testImage) serveRegistry() (host string, blobFetches *atomic.Int32) {
+	GinkgoHelper()
+
+	const token = "secret"
+	blobFetches = &atomic.Int32{}
+
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:os/disk:pull" {
+			http.Error(w, "invalid scope", http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+	})
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="test",scope="repository:os/disk:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("/v2/os/disk/manifests/{ref}", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		switch ref := r.PathValue("ref"); ref {
+		case "v1", img.indexDigest:
+			w.Header().Set("Content-Type", image.MediaTypeImageIndex)
+			_, _ = w.Write(img.index)
+		case img.manifestDigest:
+			w.Header().Set("Content-Type", image.MediaTypeImageManifest)
+			_, _ = w.Write(img.manifest)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	mux.HandleFunc("/v2/os/disk/blobs/{digest}", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		data, ok := img.blobs[r.PathValue("digest")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		blobFetches.Add(1)
+		_, _ = w.Write(data)
+	})
+
+	srv = httptest.NewServer(mux)
+	DeferCleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	Expect(err).NotTo(HaveOccurred())
+	return u.Host, blobFetches
+}
+
+var _ = Describe("Reference", func() {
+	DescribeTable("ParseReference",
+		func(s string, expected image.Reference) {
+			ref, err := image.ParseReference(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref).To(Equal(expected))
+		},
+		Entry("docker hub image", "ubuntu",
+			image.Reference{Registry: "docker.io", Repository: "library/ubuntu", Tag: "latest"}),
+		Entry("registry with port", "localhost:5000/os/ubuntu:24.04",
+			image.Reference{Registry: "localhost:5000", Repository: "os/ubuntu", Tag: "24.04"}),
+		Entry("digest", "ghcr.io/os/flatcar@sha256:"+strings.Repeat("a", 64),
+			image.Reference{Registry: "ghcr.io", Repository: "os/flatcar", Digest: "sha256:" + strings.Repeat("a", 64)}),
+		Entry("layout", "oci:/var/lib/images:v1",
+			image.Reference{LayoutDir: "/var/lib/images", Tag: "v1"}),
+		Entry("layout without tag", "oci:/var/lib/images",
+			image.Reference{LayoutDir: "/var/lib/images"}),
+	)
+
+	It("should reject invalid references", func() {
+		for _, s := range []string{"", "Ubuntu", "ubuntu@sha256:abc", "ubuntu@md5:" + strings.Repeat("a", 32), "ubuntu:"} {
+			_, err := image.ParseReference(s)
+			Expect(err).To(HaveOccurred(), s)
+		}
+	})
+})
+
+var _ = Describe("Store", func() {
+	var (
+		store *image.Store
+		img   *testImage
+	)
+
+	BeforeEach(func() {
+		img = newTestImage([]byte("disk image contents"))
+	})
+
+	JustBeforeEach(func() {
+		store = image.NewStore(GinkgoT().TempDir(), image.Options{})
+	})
+
+	It("should pull an image from an OCI layout", func(ctx SpecContext) {
+		dir := img.writeLayout()
+
+		pulled, err := store.Pull(ctx, image.LayoutPrefix+dir+":v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pulled.Ref).To(Equal(image.LayoutPrefix + dir + "@" + img.manifestDigest))
+		Expect(pulled.DiskDigest).To(Equal(digestOf(img.disk)))
+		Expect(os.ReadFile(pulled.DiskPath)).To(Equal(img.disk))
+
+		By("resolving the pulled reference from the cache")
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		Expect(store.Pull(ctx, pulled.Ref)).To(Equal(pulled))
+	})
+
+	It("should pull an image from a registry", func(ctx SpecContext) {
+		host, blobFetches := img.serveRegistry()
+		store = image.NewStore(GinkgoT().TempDir(), image.Options{InsecureRegistries: []string{host}})
+
+		pulled, err := store.Pull(ctx, host+"/os/disk:v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pulled.Ref).To(Equal(host + "/os/disk@" + img.indexDigest))
+		Expect(os.ReadFile(pulled.DiskPath)).To(Equal(img.disk))
+		Expect(blobFetches.Load()).To(BeEquivalentTo(1))
+
+		By("pulling the image again")
+		Expect(store.Pull(ctx, host+"/os/disk:v1")).To(Equal(pulled))
+		Expect(blobFetches.Load()).To(BeEquivalentTo(1), "cached disk images should not be fetched again")
+	})
+
+	It("should reject disk images not matching their digest", func(ctx SpecContext) {
+		dir := img.writeLayout()
+		Expect(os.WriteFile(filepath.Join(dir, "blobs", "sha256", strings.TrimPrefix(digestOf(img.disk), "sha256:")),
+			[]byte("tampered disk image"), 0644)).To(Succeed())
+
+		_, err := store.Pull(ctx, image.LayoutPrefix+dir+":v1")
+		Expect(err).To(MatchError(ContainSubstring("mismatch")))
+	})
+
+	It("should report missing tags as not found", func(ctx SpecContext) {
+		dir := img.writeLayout()
+
+		_, err := store.Pull(ctx, image.LayoutPrefix+dir+":v2")
+		Expect(err).To(MatchError(image.ErrNotFound))
+	})
+
+	It("should create and keep root disks", func(ctx SpecContext) {
+		pulled, err := store.Pull(ctx, image.LayoutPrefix+img.writeLayout()+":v1")
+		Expect(err).NotTo(HaveOccurred())
+
+		path, err := store.EnsureRootDisk("foo", pulled)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(store.RootDiskPath("foo")))
+		Expect(os.ReadFile(path)).To(Equal(img.disk))
+
+		By("writing to the root disk")
+		Expect(os.WriteFile(path, []byte("modified"), 0600)).To(Succeed())
+		Expect(os.ReadFile(pulled.DiskPath)).To(Equal(img.disk), "the cached disk image should be unaffected")
+		Expect(store.EnsureRootDisk("foo", pulled)).To(Equal(path))
+		Expect(os.ReadFile(path)).To(Equal([]byte("modified")), "existing root disks should be kept")
+
+		By("removing the root disk")
+		Expect(store.RemoveRootDisk("foo")).To(Succeed())
+		Expect(path).NotTo(BeAnExistingFile())
+		Expect(store.RemoveRootDisk("foo")).To(Succeed())
+	})
+
+	It("should list and remove pulled images", func(ctx SpecContext) {
+		name := image.LayoutPrefix + img.writeLayout() + ":v1"
+		pulled, err := store.Pull(ctx, name)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("pulling the image by its reference")
+		Expect(store.Pull(ctx, pulled.Ref)).To(Equal(pulled))
+
+		infos, err := store.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(infos).To(ConsistOf(SatisfyAll(
+			HaveField("Ref", pulled.Ref),
+			HaveField("Names", ConsistOf(name)),
+			HaveField("SizeBytes", BeNumerically(">", len(img.disk))),
+		)))
+		Expect(infos[0].Matches(name)).To(BeTrue())
+		Expect(infos[0].Matches(infos[0].ID)).To(BeTrue())
+
+		fsInfo, err := store.FsInfo()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fsInfo.UsedBytes).To(Equal(infos[0].SizeBytes))
+		Expect(fsInfo.CapacityBytes).To(BeNumerically(">=", fsInfo.AvailableBytes))
+
+		By("creating a root disk from the image")
+		rootDisk, err := store.EnsureRootDisk("foo", pulled)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("removing the image")
+		Expect(store.Remove(name)).To(Succeed())
+		Expect(store.List()).To(BeEmpty())
+		Expect(pulled.DiskPath).NotTo(BeAnExistingFile())
+		Expect(os.ReadFile(rootDisk)).To(Equal(img.disk), "root disks should be unaffected")
+		Expect(store.Remove(name)).To(MatchError(image.ErrNotFound))
+	})
+
+	It("should keep blobs shared with other images when removing an image", func(ctx SpecContext) {
+		dir := img.writeLayout()
+		first, err := store.Pull(ctx, image.LayoutPrefix+dir+":v1")
+		Expect(err).NotTo(HaveOccurred())
+
+		other := newTestImage(img.disk)
+		second, err := store.Pull(ctx, image.LayoutPrefix+other.writeLayout()+":v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.DiskDigest).To(Equal(first.DiskDigest))
+
+		Expect(store.Remove(first.Ref)).To(Succeed())
+		Expect(store.List()).To(ConsistOf(HaveField("Ref", second.Ref)))
+		Expect(os.ReadFile(second.DiskPath)).To(Equal(img.disk))
+	})
+
+	It("should create root disks of disk images with holes", func(ctx SpecContext) {
+		disk := make([]byte, 300*1024)
+		copy(disk[200*1024:], "data")
+		img = newTestImage(disk)
+
+		pulled, err := store.Pull(ctx, image.LayoutPrefix+img.writeLayout()+":v1")
+		Expect(err).NotTo(HaveOccurred())
+
+		path, err := store.EnsureRootDisk("foo", pulled)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.ReadFile(path)).To(Equal(disk))
+	})
+})
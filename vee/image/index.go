@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+)
+
+// Info describes a pulled image.
+type Info struct {
+	// ID uniquely identifies the image.
+	ID string `json:"id"`
+	// Ref references the image by digest.
+	Ref string `json:"ref"`
+	// Names are the references the image was pulled by.
+	Names []string `json:"names,omitempty"`
+	// Blobs are the digests of the manifests and the disk image of the image.
+	Blobs []string `json:"blobs"`
+	// SizeBytes is the size of all blobs of the image.
+	SizeBytes uint64 `json:"sizeBytes"`
+}
+
+// Matches reports whether the image is referenced by the given id, reference or name.
+func (i *Info) Matches(image string) bool {
+	return i.ID == image || i.Ref == image || slices.Contains(i.Names, image)
+}
+
+// FsInfo describes the usage of the filesystem storing images.
+type FsInfo struct {
+	// UsedBytes are the bytes used by images.
+	UsedBytes uint64
+	// CapacityBytes is the total size of the filesystem.
+	CapacityBytes uint64
+	// AvailableBytes are the bytes available on the filesystem.
+	AvailableBytes uint64
+}
+
+func imageID(ref string) string {
+	sum := sha256.Sum256([]byte(ref))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Store) indexDir() string {
+	return filepath.Join(s.dir, "index")
+}
+
+func (s *Store) infoPath(id string) string {
+	return filepath.Join(s.indexDir(), id+".json")
+}
+
+// record records the pulled image in the index, adding the name it was pulled by.
+// The caller has to hold the lock.
+func (s *Store) record(ref, name string, blobs []string) error {
+	id := imageID(ref)
+	info, err := s.readInfo(id)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		info = &Info{ID: id, Ref: ref}
+	}
+
+	if name != ref && !slices.Contains(info.Names, name) {
+		info.Names = append(info.Names, name)
+	}
+	info.Blobs = blobs
+	info.SizeBytes = 0
+	for _, digest := range blobs {
+		stat, err := os.Stat(s.blobPath(digest))
+		if err != nil {
+			return fmt.Errorf("error stat-ing blob %s: %w", digest, err)
+		}
+		info.SizeBytes += uint64(stat.Size())
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.indexDir(), 0700); err != nil {
+		return err
+	}
+	tmp := s.infoPath(id) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.infoPath(id))
+}
+
+func (s *Store) readInfo(id string) (*Info, error) {
+	data, err := os.ReadFile(s.infoPath(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("image %s: %w", id, ErrNotFound)
+		}
+		return nil, err
+	}
+
+	info := &Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("error decoding image %s: %w", id, err)
+	}
+	return info, nil
+}
+
+// list lists all pulled images. The caller has to hold the lock.
+func (s *Store) list() ([]*Info, error) {
+	entries, err := os.ReadDir(s.indexDir())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var res []*Info
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		info, err := s.readInfo(id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, info)
+	}
+	return res, nil
+}
+
+// List lists all pulled images.
+func (s *Store) List() ([]*Info, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list()
+}
+
+// Remove removes the images referenced by the given id, reference or name, along with all their
+// blobs not used by other images. Root disks created from the images are not affected.
+func (s *Store) Remove(image string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos, err := s.list()
+	if err != nil {
+		return err
+	}
+
+	var (
+		removed []*Info
+		used    = make(map[string]struct{})
+	)
+	for _, info := range infos {
+		if info.Matches(image) {
+			removed = append(removed, info)
+			continue
+		}
+		for _, digest := range info.Blobs {
+			used[digest] = struct{}{}
+		}
+	}
+	if len(removed) == 0 {
+		return fmt.Errorf("image %s: %w", image, ErrNotFound)
+	}
+
+	for _, info := range removed {
+		if err := os.Remove(s.infoPath(info.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing image %s: %w", info.ID, err)
+		}
+		for _, digest := range info.Blobs {
+			if _, ok := used[digest]; ok {
+				continue
+			}
+			if err := os.Remove(s.blobPath(digest)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("error removing blob %s: %w", digest, err)
+			}
+		}
+	}
+	return nil
+}
+
+// FsInfo reports the usage of the filesystem storing images.
+func (s *Store) FsInfo() (*FsInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var used uint64
+	if err := filepath.WalkDir(s.blobsDir(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		used += uint64(info.Size())
+		return nil
+	}); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error determining used bytes: %w", err)
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return nil, err
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(s.dir, &stat); err != nil {
+		return nil, fmt.Errorf("error stat-ing filesystem: %w", err)
+	}
+	return &FsInfo{
+		UsedBytes:      used,
+		CapacityBytes:  stat.Blocks * uint64(stat.Bsize),
+		AvailableBytes: stat.Bavail * uint64(stat.Bsize),
+	}, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+)
+
+const (
+	MediaTypeImageIndex    = "application/vnd.oci.image.index.v1+json"
+	MediaTypeImageManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// MediaTypeDiskRaw is the media type of layers containing a raw disk image.
+	MediaTypeDiskRaw = "application/vnd.spheric.disk.raw"
+	// MediaTypeDiskQCOW2 is the media type of layers containing a qcow2 disk image.
+	MediaTypeDiskQCOW2 = "application/vnd.spheric.disk.qcow2"
+
+	// AnnotationTitle is the annotation carrying the file name of a layer, e.g. as set by oras.
+	AnnotationTitle = "org.opencontainers.image.title"
+	// AnnotationRefName is the annotation carrying the tag of a manifest in an OCI layout index.
+	AnnotationRefName = "org.opencontainers.image.ref.name"
+)
+
+// diskFileExtensions are the file extensions of layers considered disk images if they
+// don't carry one of the disk media types.
+var diskFileExtensions = []string{".raw", ".img", ".qcow2"}
+
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *Platform         `json:"platform,omitempty"`
+}
+
+// Manifest is an OCI image manifest or index, depending on its media type.
+type Manifest struct {
+	MediaType string       `json:"mediaType,omitempty"`
+	Manifests []Descriptor `json:"manifests,omitempty"`
+	Layers    []Descriptor `json:"layers,omitempty"`
+}
+
+func isIndex(mediaType string) bool {
+	return mediaType == MediaTypeImageIndex || mediaType == MediaTypeDockerManifestList
+}
+
+// isDiskLayer reports whether the given layer contains a disk image.
+func isDiskLayer(layer Descriptor) bool {
+	switch layer.MediaType {
+	case MediaTypeDiskRaw, MediaTypeDiskQCOW2:
+		return true
+	}
+	title := layer.Annotations[AnnotationTitle]
+	for _, ext := range diskFileExtensions {
+		if strings.HasSuffix(title, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+const sha256Algorithm = "sha256"
+
+func validateDigest(digest string) error {
+	algorithm, encoded, ok := strings.Cut(digest, ":")
+	if !ok {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	if algorithm != sha256Algorithm {
+		return fmt.Errorf("unsupported digest algorithm %q", algorithm)
+	}
+	if b, err := hex.DecodeString(encoded); err != nil || len(b) != sha256.Size {
+		return fmt.Errorf("invalid digest %q", digest)
+	}
+	return nil
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return sha256Algorithm + ":" + hex.EncodeToString(sum[:])
+}
+
+// blobPath returns the path of the blob with the given (validated) digest relative to a blob directory.
+func blobPath(digest string) string {
+	algorithm, encoded, _ := strings.Cut(digest, ":")
+	return path.Join(algorithm, encoded)
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// LayoutPrefix prefixes references to images in local OCI layout directories,
+	// e.g. oci:/var/lib/images/ubuntu:24.04.
+	LayoutPrefix = "oci:"
+
+	DefaultRegistry = "docker.io"
+	DefaultTag      = "latest"
+
+	dockerHubAPIHost = "registry-1.docker.io"
+)
+
+// Reference references an image, either in a registry or in a local OCI layout directory.
+type Reference struct {
+	// LayoutDir is the OCI layout directory the image is located in. If set, Registry and Repository are empty.
+	LayoutDir  string
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func splitTagAndDigest(s string) (name, tag, digest string, err error) {
+	name = s
+	if idx := strings.LastIndex(name, "@"); idx >= 0 {
+		name, digest = name[:idx], name[idx+1:]
+		if err := validateDigest(digest); err != nil {
+			return "", "", "", err
+		}
+	}
+	if idx := strings.LastIndex(name, ":"); idx > strings.LastIndex(name, "/") {
+		name, tag = name[:idx], name[idx+1:]
+		if tag == "" {
+			return "", "", "", fmt.Errorf("empty tag")
+		}
+	}
+	if name == "" {
+		return "", "", "", fmt.Errorf("empty name")
+	}
+	return name, tag, digest, nil
+}
+
+// ParseReference parses the given image reference.
+//
+// References to registries follow the docker conventions, i.e. images without registry are
+// pulled from docker hub and images without tag and digest default to the latest tag.
+// References prefixed with LayoutPrefix denote images in local OCI layout directories, where
+// images without tag and digest refer to the only image of the layout.
+func ParseReference(s string) (Reference, error) {
+	if layout, ok := strings.CutPrefix(s, LayoutPrefix); ok {
+		dir, tag, digest, err := splitTagAndDigest(layout)
+		if err != nil {
+			return Reference{}, fmt.Errorf("invalid image reference %q: %w", s, err)
+		}
+		return Reference{LayoutDir: dir, Tag: tag, Digest: digest}, nil
+	}
+
+	name, tag, digest, err := splitTagAndDigest(s)
+	if err != nil {
+		return Reference{}, fmt.Errorf("invalid image reference %q: %w", s, err)
+	}
+
+	registry, repository := DefaultRegistry, name
+	if first, rest, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		registry, repository = first, rest
+	}
+	if registry == DefaultRegistry && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	if repository != strings.ToLower(repository) {
+		return Reference{}, fmt.Errorf("invalid image reference %q: repository must be lowercase", s)
+	}
+
+	if tag == "" && digest == "" {
+		tag = DefaultTag
+	}
+	return Reference{Registry: registry, Repository: repository, Tag: tag, Digest: digest}, nil
+}
+
+// Name returns the reference without tag and digest.
+func (r Reference) Name() string {
+	if r.LayoutDir != "" {
+		return LayoutPrefix + r.LayoutDir
+	}
+	return r.Registry + "/" + r.Repository
+}
+
+func (r Reference) String() string {
+	s := r.Name()
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// apiHost returns the host serving the registry api of the registry of the reference.
+func (r Reference) apiHost() string {
+	if r.Registry == DefaultRegistry {
+		return dockerHubAPIHost
+	}
+	return r.Registry
+}
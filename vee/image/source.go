@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// source fetches manifests and blobs of a single repository.
+type source interface {
+	// manifest fetches the manifest with the given tag or digest and returns its media type, digest and contents.
+	manifest(ctx context.Context, tagOrDigest string) (mediaType, digest string, data []byte, err error)
+	// blob opens the blob with the given digest.
+	blob(ctx context.Context, digest string) (io.ReadCloser, error)
+}
+
+func manifestMediaType(data []byte) (string, error) {
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return "", fmt.Errorf("error decoding manifest: %w", err)
+	}
+	if m.MediaType != "" {
+		return m.MediaType, nil
+	}
+	// The media type is optional in OCI manifests, fall back to its structure.
+	if m.Manifests != nil {
+		return MediaTypeImageIndex, nil
+	}
+	return MediaTypeImageManifest, nil
+}
+
+// layoutSource is a source reading from an OCI layout directory.
+type layoutSource struct {
+	dir string
+}
+
+func (s *layoutSource) readBlob(digest string) ([]byte, error) {
+	if err := validateDigest(digest); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(s.dir, "blobs", blobPath(digest)))
+}
+
+func (s *layoutSource) manifest(_ context.Context, tagOrDigest string) (string, string, []byte, error) {
+	digest := tagOrDigest
+	if validateDigest(tagOrDigest) != nil {
+		data, err := os.ReadFile(filepath.Join(s.dir, "index.json"))
+		if err != nil {
+			return "", "", nil, fmt.Errorf("error reading layout index: %w", err)
+		}
+
+		index := &Manifest{}
+		if err := json.Unmarshal(data, index); err != nil {
+			return "", "", nil, fmt.Errorf("error decoding layout index: %w", err)
+		}
+
+		desc, err := s.lookup(index, tagOrDigest)
+		if err != nil {
+			return "", "", nil, err
+		}
+		digest = desc.Digest
+	}
+
+	data, err := s.readBlob(digest)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", "", nil, fmt.Errorf("manifest %s not found in layout: %w", digest, ErrNotFound)
+		}
+		return "", "", nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+	if actual := digestOf(data); actual != digest {
+		return "", "", nil, fmt.Errorf("manifest digest mismatch: expected %s, got %s", digest, actual)
+	}
+
+	mediaType, err := manifestMediaType(data)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return mediaType, digest, data, nil
+}
+
+// lookup looks up the manifest with the given tag. If no tag is given, the index has to contain a single manifest.
+func (s *layoutSource) lookup(index *Manifest, tag string) (*Descriptor, error) {
+	if tag == "" {
+		if len(index.Manifests) != 1 {
+			return nil, fmt.Errorf("layout contains %d manifests, a tag or digest is required", len(index.Manifests))
+		}
+		return &index.Manifests[0], nil
+	}
+
+	for _, desc := range index.Manifests {
+		if desc.Annotations[AnnotationRefName] == tag {
+			return &desc, nil
+		}
+	}
+	return nil, fmt.Errorf("tag %q not found in layout: %w", tag, ErrNotFound)
+}
+
+func (s *layoutSource) blob(_ context.Context, digest string) (io.ReadCloser, error) {
+	if err := validateDigest(digest); err != nil {
+		return nil, err
+	}
+	return os.Open(filepath.Join(s.dir, "blobs", blobPath(digest)))
+}
+
+var manifestAccept = strings.Join([]string{
+	MediaTypeImageIndex,
+	MediaTypeImageManifest,
+	MediaTypeDockerManifestList,
+	MediaTypeDockerManifest,
+}, ", ")
+
+// registrySource is a source pulling from a registry via the OCI distribution api.
+// Registries requiring authentication are accessed anonymously via bearer tokens.
+type registrySource struct {
+	client     *http.Client
+	baseURL    *url.URL
+	repository string
+
+	mu    sync.Mutex
+	token string
+}
+
+func (s *registrySource) url(kind, ref string) string {
+	return s.baseURL.JoinPath("v2", s.repository, kind, ref).String()
+}
+
+func (s *registrySource) get(ctx context.Context, u, accept string) (*http.Response, error) {
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+
+		s.mu.Lock()
+		token := s.token
+		s.mu.Unlock()
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return s.client.Do(req)
+	}
+
+	res, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusUnauthorized {
+		return res, nil
+	}
+
+	challenge := res.Header.Get("WWW-Authenticate")
+	_ = res.Body.Close()
+	if err := s.authenticate(ctx, challenge); err != nil {
+		return nil, err
+	}
+	return do()
+}
+
+// parseChallenge parses a bearer WWW-Authenticate challenge into its parameters.
+func parseChallenge(challenge string) (map[string]string, bool) {
+	scheme, rest, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return params, true
+}
+
+func (s *registrySource) authenticate(ctx context.Context, challenge string) error {
+	params, ok := parseChallenge(challenge)
+	if !ok || params["realm"] == "" {
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	u, err := url.Parse(params["realm"])
+	if err != nil {
+		return fmt.Errorf("invalid authentication realm: %w", err)
+	}
+	q := u.Query()
+	if service := params["service"]; service != "" {
+		q.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + s.repository + ":pull"
+	}
+	q.Set("scope", scope)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error requesting token: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error requesting token: unexpected status %s", res.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return fmt.Errorf("error decoding token: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token.Token
+	if s.token == "" {
+		s.token = token.AccessToken
+	}
+	return nil
+}
+
+func statusError(res *http.Response) error {
+	err := fmt.Errorf("unexpected status %s", res.Status)
+	if res.StatusCode == http.StatusNotFound {
+		err = fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return err
+}
+
+func (s *registrySource) manifest(ctx context.Context, tagOrDigest string) (string, string, []byte, error) {
+	res, err := s.get(ctx, s.url("manifests", tagOrDigest), manifestAccept)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("error fetching manifest: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return "", "", nil, fmt.Errorf("error fetching manifest: %w", statusError(res))
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("error reading manifest: %w", err)
+	}
+
+	digest := digestOf(data)
+	if validateDigest(tagOrDigest) == nil && digest != tagOrDigest {
+		return "", "", nil, fmt.Errorf("manifest digest mismatch: expected %s, got %s", tagOrDigest, digest)
+	}
+
+	mediaType, _, _ := strings.Cut(res.Header.Get("Content-Type"), ";")
+	if !isIndex(mediaType) && mediaType != MediaTypeImageManifest && mediaType != MediaTypeDockerManifest {
+		if mediaType, err = manifestMediaType(data); err != nil {
+			return "", "", nil, err
+		}
+	}
+	return mediaType, digest, data, nil
+}
+
+func (s *registrySource) blob(ctx context.Context, digest string) (io.ReadCloser, error) {
+	res, err := s.get(ctx, s.url("blobs", digest), "")
+	if err != nil {
+		return nil, fmt.Errorf("error fetching blob: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("error fetching blob: %w", statusError(res))
+	}
+	return res.Body, nil
+}
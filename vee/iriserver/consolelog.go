@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/console"
+)
+
+// consoleLogWriter sends everything written to it as console log chunks.
+type consoleLogWriter struct {
+	stream grpc.ServerStreamingServer[iri.GetConsoleLogResponse]
+}
+
+func (w consoleLogWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&iri.GetConsoleLogResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *Server) GetConsoleLog(req *iri.GetConsoleLogRequest, stream grpc.ServerStreamingServer[iri.GetConsoleLogResponse]) error {
+	if s.consoles == nil {
+		return status.Error(codes.Unimplemented, "console log is not supported")
+	}
+	if req.TailLines != nil && *req.TailLines < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid tail lines %d", *req.TailLines)
+	}
+
+	ctx := stream.Context()
+	if _, err := s.getInstance(ctx, req.InstanceId); err != nil {
+		return err
+	}
+
+	opts := console.LogOptions{
+		TailLines: req.TailLines,
+		Follow:    req.Follow,
+	}
+	if req.SinceTime != 0 {
+		opts.Since = time.Unix(0, req.SinceTime)
+	}
+	if err := s.consoles.ReadLog(ctx, req.InstanceId, opts, consoleLogWriter{stream}); err != nil {
+		return fmt.Errorf("error reading console log of instance %q: %w", req.InstanceId, err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/console"
+)
+
+// fakeConsoleLogs serves a fixed log and records the options it was read with.
+type fakeConsoleLogs struct {
+	log  string
+	opts chan console.LogOptions
+}
+
+func (f *fakeConsoleLogs) ReadLog(ctx context.Context, instanceID string, opts console.LogOptions, w io.Writer) error {
+	f.opts <- opts
+	_, err := io.WriteString(w, f.log)
+	return err
+}
+
+// readConsoleLog reads the whole console log stream of the given request.
+func readConsoleLog(ctx context.Context, req *iri.GetConsoleLogRequest) (string, error) {
+	stream, err := runtimeService.GetConsoleLog(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	var log []byte
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return string(log), nil
+			}
+			return "", err
+		}
+		log = append(log, res.Data...)
+	}
+}
+
+var _ = Describe("GetConsoleLog", func() {
+	It("should reject console log requests if no consoles are configured", func(ctx SpecContext) {
+		id := createInstance(ctx, nil).Metadata.Id
+
+		_, err := readConsoleLog(ctx, &iri.GetConsoleLogRequest{InstanceId: id})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	})
+
+	Context("with consoles", func() {
+		var consoles *fakeConsoleLogs
+
+		BeforeEach(func() {
+			consoles = &fakeConsoleLogs{
+				log:  "booting\nlogin: ",
+				opts: make(chan console.LogOptions, 1),
+			}
+			srvOpts.Consoles = consoles
+		})
+
+		It("should report the capability", func(ctx SpecContext) {
+			res, err := runtimeService.Version(ctx, &iri.VersionRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Capabilities.ConsoleLog).To(BeTrue())
+		})
+
+		It("should stream the console log of the instance", func(ctx SpecContext) {
+			id := createInstance(ctx, nil).Metadata.Id
+			since := time.Unix(1000, 0)
+
+			Expect(readConsoleLog(ctx, &iri.GetConsoleLogRequest{
+				InstanceId: id,
+				TailLines:  ptr.To[int64](10),
+				SinceTime:  since.UnixNano(),
+			})).To(Equal("booting\nlogin: "))
+			Expect(consoles.opts).To(Receive(Equal(console.LogOptions{
+				TailLines: ptr.To[int64](10),
+				Since:     since,
+			})))
+		})
+
+		It("should reject negative tail lines", func(ctx SpecContext) {
+			id := createInstance(ctx, nil).Metadata.Id
+
+			_, err := readConsoleLog(ctx, &iri.GetConsoleLogRequest{InstanceId: id, TailLines: ptr.To[int64](-1)})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("should report unknown instances as not found", func(ctx SpecContext) {
+			_, err := readConsoleLog(ctx, &iri.GetConsoleLogRequest{InstanceId: "unknown"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
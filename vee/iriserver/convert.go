@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"fmt"
+
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	utilslices "spheric.cloud/spheric/utils/slices"
+	"spheric.cloud/spheric/vee/api"
+)
+
+var (
+	iriPowerToPower = map[iri.Power]api.Power{
+		iri.Power_POWER_ON:        api.PowerOn,
+		iri.Power_POWER_OFF:       api.PowerOff,
+		iri.Power_POWER_SUSPENDED: api.PowerSuspended,
+	}
+	powerToIRIPower = map[api.Power]iri.Power{
+		api.PowerOn:        iri.Power_POWER_ON,
+		api.PowerOff:       iri.Power_POWER_OFF,
+		api.PowerSuspended: iri.Power_POWER_SUSPENDED,
+	}
+)
+
+func (s *Server) convertIRIPower(power iri.Power) (api.Power, error) {
+	res, ok := iriPowerToPower[power]
+	if !ok {
+		return "", fmt.Errorf("unknown power %v", power)
+	}
+	return res, nil
+}
+
+func (s *Server) convertPower(power api.Power) iri.Power {
+	res, ok := powerToIRIPower[power]
+	if !ok {
+		return iri.Power_POWER_ON
+	}
+	return res
+}
+
+var iriRebootTypeToRebootType = map[iri.RebootType]api.RebootType{
+	iri.RebootType_REBOOT_GRACEFUL: api.RebootGraceful,
+	iri.RebootType_REBOOT_HARD:     api.RebootHard,
+}
+
+func (s *Server) convertIRIRebootType(rebootType iri.RebootType) (api.RebootType, error) {
+	res, ok := iriRebootTypeToRebootType[rebootType]
+	if !ok {
+		return "", fmt.Errorf("unknown reboot type %v", rebootType)
+	}
+	return res, nil
+}
+
+var instanceStateToIRIInstanceState = map[api.InstanceState]iri.InstanceState{
+	api.InstanceStatePending:    iri.InstanceState_INSTANCE_PENDING,
+	api.InstanceStateRunning:    iri.InstanceState_INSTANCE_RUNNING,
+	api.InstanceStateSuspended:  iri.InstanceState_INSTANCE_SUSPENDED,
+	api.InstanceStateTerminated: iri.InstanceState_INSTANCE_TERMINATED,
+}
+
+func (s *Server) convertInstanceState(state api.InstanceState) iri.InstanceState {
+	res, ok := instanceStateToIRIInstanceState[state]
+	if !ok {
+		return iri.InstanceState_INSTANCE_PENDING
+	}
+	return res
+}
+
+var diskStateToIRIDiskState = map[api.DiskState]iri.DiskState{
+	api.DiskStatePending:  iri.DiskState_DISK_PENDING,
+	api.DiskStateAttached: iri.DiskState_DISK_ATTACHED,
+}
+
+func (s *Server) convertDiskStatus(status api.DiskStatus) *iri.DiskStatus {
+	state, ok := diskStateToIRIDiskState[status.State]
+	if !ok {
+		state = iri.DiskState_DISK_PENDING
+	}
+
+	return &iri.DiskStatus{
+		Name:  status.Name,
+		State: state,
+	}
+}
+
+func (s *Server) convertIRIDisk(disk *iri.Disk) api.Disk {
+	var emptyDisk *api.EmptyDisk
+	if iriEmptyDisk := disk.EmptyDisk; iriEmptyDisk != nil {
+		emptyDisk = &api.EmptyDisk{
+			SizeBytes: iriEmptyDisk.SizeBytes,
+		}
+	}
+
+	var connection *api.DiskConnection
+	if iriConnection := disk.Connection; iriConnection != nil {
+		connection = &api.DiskConnection{
+			Driver:     iriConnection.Driver,
+			Handle:     iriConnection.Handle,
+			Attributes: iriConnection.Attributes,
+			SecretData: iriConnection.SecretData,
+		}
+	}
+
+	return api.Disk{
+		Name:       disk.Name,
+		Device:     disk.Device,
+		EmptyDisk:  emptyDisk,
+		Connection: connection,
+	}
+}
+
+func (s *Server) convertDisk(disk api.Disk) *iri.Disk {
+	var emptyDisk *iri.EmptyDisk
+	if apiEmptyDisk := disk.EmptyDisk; apiEmptyDisk != nil {
+		emptyDisk = &iri.EmptyDisk{
+			SizeBytes: apiEmptyDisk.SizeBytes,
+		}
+	}
+
+	var connection *iri.DiskConnection
+	if apiConnection := disk.Connection; apiConnection != nil {
+		connection = &iri.DiskConnection{
+			Driver:     apiConnection.Driver,
+			Handle:     apiConnection.Handle,
+			Attributes: apiConnection.Attributes,
+			SecretData: apiConnection.SecretData,
+		}
+	}
+
+	return &iri.Disk{
+		Name:       disk.Name,
+		Device:     disk.Device,
+		EmptyDisk:  emptyDisk,
+		Connection: connection,
+	}
+}
+
+var networkInterfaceStateToIRINetworkInterfaceState = map[api.NetworkInterfaceState]iri.NetworkInterfaceState{
+	api.NetworkInterfaceStatePending:  iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING,
+	api.NetworkInterfaceStateAttached: iri.NetworkInterfaceState_NETWORK_INTERFACE_ATTACHED,
+}
+
+func (s *Server) convertNetworkInterfaceStatus(status api.NetworkInterfaceStatus) *iri.NetworkInterfaceStatus {
+	state, ok := networkInterfaceStateToIRINetworkInterfaceState[status.State]
+	if !ok {
+		state = iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING
+	}
+
+	return &iri.NetworkInterfaceStatus{
+		Name:   status.Name,
+		Handle: status.Handle,
+		State:  state,
+	}
+}
+
+func (s *Server) convertIRINetworkInterface(nic *iri.NetworkInterface) api.NetworkInterface {
+	var subnetMetadata *api.NetworkInterfaceSubnetMetadata
+	if iriSubnetMetadata := nic.SubnetMetadata; iriSubnetMetadata != nil {
+		subnetMetadata = &api.NetworkInterfaceSubnetMetadata{
+			NetworkName: iriSubnetMetadata.NetworkName,
+			NetworkUID:  iriSubnetMetadata.NetworkUid,
+			SubnetName:  iriSubnetMetadata.SubnetName,
+			SubnetUID:   iriSubnetMetadata.SubnetUid,
+		}
+	}
+
+	return api.NetworkInterface{
+		Name:           nic.Name,
+		SubnetMetadata: subnetMetadata,
+		IPs:            nic.Ips,
+		SubnetCIDRs:    nic.SubnetCidrs,
+	}
+}
+
+func (s *Server) convertNetworkInterface(nic api.NetworkInterface) *iri.NetworkInterface {
+	var subnetMetadata *iri.NetworkInterfaceSubnetMetadata
+	if apiSubnetMetadata := nic.SubnetMetadata; apiSubnetMetadata != nil {
+		subnetMetadata = &iri.NetworkInterfaceSubnetMetadata{
+			NetworkName: apiSubnetMetadata.NetworkName,
+			NetworkUid:  apiSubnetMetadata.NetworkUID,
+			SubnetName:  apiSubnetMetadata.SubnetName,
+			SubnetUid:   apiSubnetMetadata.SubnetUID,
+		}
+	}
+
+	return &iri.NetworkInterface{
+		Name:           nic.Name,
+		SubnetMetadata: subnetMetadata,
+		Ips:            nic.IPs,
+		SubnetCidrs:    nic.SubnetCIDRs,
+	}
+}
+
+func (s *Server) convertIRIInstanceSpec(spec *iri.InstanceSpec) (*api.InstanceSpec, error) {
+	power, err := s.convertIRIPower(spec.Power)
+	if err != nil {
+		return nil, err
+	}
+
+	var image string
+	if iriImage := spec.Image; iriImage != nil {
+		image = iriImage.Image
+	}
+
+	return &api.InstanceSpec{
+		Power:             power,
+		Image:             image,
+		Type:              spec.Type,
+		CPUCount:          int32(spec.CpuCount),
+		MemoryBytes:       int64(spec.MemoryBytes),
+		IgnitionData:      spec.IgnitionData,
+		Disks:             utilslices.Map(spec.Disks, s.convertIRIDisk),
+		NetworkInterfaces: utilslices.Map(spec.NetworkInterfaces, s.convertIRINetworkInterface),
+	}, nil
+}
+
+func (s *Server) convertInstance(instance *api.Instance) *iri.Instance {
+	var deletedAt int64
+	if deletionTimestamp := instance.DeletionTimestamp; deletionTimestamp != nil {
+		deletedAt = deletionTimestamp.UnixNano()
+	}
+
+	var image *iri.ImageSpec
+	if instance.Spec.Image != "" {
+		image = &iri.ImageSpec{Image: instance.Spec.Image}
+	}
+
+	return &iri.Instance{
+		Metadata: &iri.ObjectMetadata{
+			Id:          instance.ID,
+			Annotations: instance.Annotations,
+			Labels:      instance.Labels,
+			Generation:  instance.Generation,
+			CreatedAt:   instance.CreationTimestamp.UnixNano(),
+			DeletedAt:   deletedAt,
+		},
+		Spec: &iri.InstanceSpec{
+			Power:             s.convertPower(instance.Spec.Power),
+			Image:             image,
+			Type:              instance.Spec.Type,
+			CpuCount:          int64(instance.Spec.CPUCount),
+			MemoryBytes:       uint64(instance.Spec.MemoryBytes),
+			IgnitionData:      instance.Spec.IgnitionData,
+			Disks:             utilslices.Map(instance.Spec.Disks, s.convertDisk),
+			NetworkInterfaces: utilslices.Map(instance.Spec.NetworkInterfaces, s.convertNetworkInterface),
+		},
+		Status: &iri.InstanceStatus{
+			ObservedGeneration: instance.Status.ObservedGeneration,
+			State:              s.convertInstanceState(instance.Status.State),
+			ImageRef:           instance.Status.ImageRef,
+			Disks:              utilslices.Map(instance.Status.Disks, s.convertDiskStatus),
+			NetworkInterfaces:  utilslices.Map(instance.Status.NetworkInterfaces, s.convertNetworkInterfaceStatus),
+		},
+	}
+}
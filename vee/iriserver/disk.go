@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+)
+
+func (s *Server) AttachDisk(ctx context.Context, req *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error) {
+	iriDisk := req.GetDisk()
+	if iriDisk == nil {
+		return nil, status.Error(codes.InvalidArgument, "must specify disk")
+	}
+	if (iriDisk.EmptyDisk == nil) == (iriDisk.Connection == nil) {
+		return nil, status.Error(codes.InvalidArgument, "must specify exactly one of empty disk or connection")
+	}
+
+	disk := s.convertIRIDisk(iriDisk)
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		if slices.ContainsFunc(spec.Disks, func(existing api.Disk) bool { return existing.Name == disk.Name }) {
+			return status.Errorf(codes.AlreadyExists, "instance %q disk %q already exists", req.InstanceId, disk.Name)
+		}
+
+		spec.Disks = append(spec.Disks, disk)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.AttachDiskResponse{}, nil
+}
+
+func (s *Server) DetachDisk(ctx context.Context, req *iri.DetachDiskRequest) (*iri.DetachDiskResponse, error) {
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		idx := slices.IndexFunc(spec.Disks, func(disk api.Disk) bool { return disk.Name == req.Name })
+		if idx < 0 {
+			return status.Errorf(codes.NotFound, "instance %q disk attachment %q not found", req.InstanceId, req.Name)
+		}
+
+		spec.Disks = slices.Delete(spec.Disks, idx, idx+1)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.DetachDiskResponse{}, nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+var _ = Describe("Disk", func() {
+	It("should attach and detach disks", func(ctx SpecContext) {
+		id := createInstance(ctx, nil).Metadata.Id
+
+		By("attaching a disk")
+		_, err := runtimeService.AttachDisk(ctx, &iri.AttachDiskRequest{
+			InstanceId: id,
+			Disk: &iri.Disk{
+				Name:      "root",
+				Device:    "vda",
+				EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		instance := getInstance(ctx, id)
+		Expect(instance.Metadata.Generation).To(BeEquivalentTo(2))
+		Expect(instance.Spec.Disks).To(ConsistOf(HaveField("Name", "root")))
+
+		By("attaching a disk with the same name again")
+		_, err = runtimeService.AttachDisk(ctx, &iri.AttachDiskRequest{
+			InstanceId: id,
+			Disk: &iri.Disk{
+				Name:      "root",
+				Device:    "vdb",
+				EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024},
+			},
+		})
+		Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+
+		By("detaching the disk")
+		_, err = runtimeService.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: id, Name: "root"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getInstance(ctx, id).Spec.Disks).To(BeEmpty())
+
+		By("detaching the disk again")
+		_, err = runtimeService.DetachDisk(ctx, &iri.DetachDiskRequest{InstanceId: id, Name: "root"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should reject disks without exactly one source", func(ctx SpecContext) {
+		id := createInstance(ctx, nil).Metadata.Id
+
+		_, err := runtimeService.AttachDisk(ctx, &iri.AttachDiskRequest{
+			InstanceId: id,
+			Disk:       &iri.Disk{Name: "root", Device: "vda"},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = runtimeService.AttachDisk(ctx, &iri.AttachDiskRequest{
+			InstanceId: id,
+			Disk: &iri.Disk{
+				Name:       "root",
+				Device:     "vda",
+				EmptyDisk:  &iri.EmptyDisk{SizeBytes: 1024},
+				Connection: &iri.DiskConnection{Driver: "local-file", Handle: "/disk.raw"},
+			},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/image"
+)
+
+func (s *Server) imagesSupported() error {
+	if s.images == nil {
+		return status.Error(codes.Unimplemented, "images are not supported")
+	}
+	return nil
+}
+
+// usesImage reports whether the instance uses the given image, either by its spec or its resolved image.
+func usesImage(instance *api.Instance, info *image.Info) bool {
+	return (instance.Spec.Image != "" && info.Matches(instance.Spec.Image)) ||
+		(instance.Status.ImageRef != "" && info.Matches(instance.Status.ImageRef))
+}
+
+func (s *Server) imageInUse(instances []*api.Instance, info *image.Info) bool {
+	return slices.ContainsFunc(instances, func(instance *api.Instance) bool {
+		return usesImage(instance, info)
+	})
+}
+
+func (s *Server) convertImage(info *image.Info, inUse bool) *iri.Image {
+	return &iri.Image{
+		Id:        info.ID,
+		Ref:       info.Ref,
+		Names:     slices.Clone(info.Names),
+		SizeBytes: info.SizeBytes,
+		InUse:     inUse,
+	}
+}
+
+func (s *Server) ListImages(ctx context.Context, req *iri.ListImagesRequest) (*iri.ListImagesResponse, error) {
+	if err := s.imagesSupported(); err != nil {
+		return nil, err
+	}
+
+	infos, err := s.images.List()
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+	instances, err := s.listInstances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := req.GetFilter().GetImage().GetImage()
+	res := make([]*iri.Image, 0, len(infos))
+	for _, info := range infos {
+		if filter != "" && !info.Matches(filter) {
+			continue
+		}
+		res = append(res, s.convertImage(info, s.imageInUse(instances, info)))
+	}
+	return &iri.ListImagesResponse{Images: res}, nil
+}
+
+func (s *Server) PullImage(ctx context.Context, req *iri.PullImageRequest) (*iri.PullImageResponse, error) {
+	if err := s.imagesSupported(); err != nil {
+		return nil, err
+	}
+
+	name := req.GetImage().GetImage()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "must specify image")
+	}
+	if _, err := image.ParseReference(name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid image: %v", err)
+	}
+
+	img, err := s.images.Pull(ctx, name)
+	if err != nil {
+		if errors.Is(err, image.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "image %q not found", name)
+		}
+		return nil, fmt.Errorf("error pulling image %q: %w", name, err)
+	}
+	return &iri.PullImageResponse{ImageRef: img.Ref}, nil
+}
+
+func (s *Server) RemoveImage(ctx context.Context, req *iri.RemoveImageRequest) (*iri.RemoveImageResponse, error) {
+	if err := s.imagesSupported(); err != nil {
+		return nil, err
+	}
+
+	name := req.GetImage().GetImage()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "must specify image")
+	}
+
+	infos, err := s.images.List()
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+	instances, err := s.listInstances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.Matches(name) && s.imageInUse(instances, info) {
+			return nil, status.Errorf(codes.FailedPrecondition, "image %q is in use", name)
+		}
+	}
+
+	if err := s.images.Remove(name); err != nil {
+		if errors.Is(err, image.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "image %q not found", name)
+		}
+		return nil, fmt.Errorf("error removing image %q: %w", name, err)
+	}
+	return &iri.RemoveImageResponse{}, nil
+}
+
+func (s *Server) ImageFsInfo(ctx context.Context, req *iri.ImageFsInfoRequest) (*iri.ImageFsInfoResponse, error) {
+	if err := s.imagesSupported(); err != nil {
+		return nil, err
+	}
+
+	info, err := s.images.FsInfo()
+	if err != nil {
+		return nil, fmt.Errorf("error getting image filesystem info: %w", err)
+	}
+	return &iri.ImageFsInfoResponse{
+		UsedBytes:      info.UsedBytes,
+		CapacityBytes:  info.CapacityBytes,
+		AvailableBytes: info.AvailableBytes,
+	}, nil
+}
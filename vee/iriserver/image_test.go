@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/image"
+)
+
+// writeImageLayout writes an OCI layout containing an image with the given disk image tagged v1.
+func writeImageLayout(disk []byte) string {
+	GinkgoHelper()
+
+	dir := GinkgoT().TempDir()
+	blobsDir := filepath.Join(dir, "blobs", "sha256")
+	Expect(os.MkdirAll(blobsDir, 0755)).To(Succeed())
+	writeBlob := func(data []byte) string {
+		sum := sha256.Sum256(data)
+		encoded := hex.EncodeToString(sum[:])
+		Expect(os.WriteFile(filepath.Join(blobsDir, encoded), data, 0644)).To(Succeed())
+		return "sha256:" + encoded
+	}
+
+	manifest, err := json.Marshal(image.Manifest{
+		MediaType: image.MediaTypeImageManifest,
+		Layers: []image.Descriptor{
+			{MediaType: image.MediaTypeDiskRaw, Digest: writeBlob(disk), Size: int64(len(disk))},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	index, err := json.Marshal(image.Manifest{
+		MediaType: image.MediaTypeImageIndex,
+		Manifests: []image.Descriptor{
+			{
+				MediaType:   image.MediaTypeImageManifest,
+				Digest:      writeBlob(manifest),
+				Size:        int64(len(manifest)),
+				Annotations: map[string]string{image.AnnotationRefName: "v1"},
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(os.WriteFile(filepath.Join(dir, "oci-layout"), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644)).To(Succeed())
+	Expect(os.WriteFile(filepath.Join(dir, "index.json"), index, 0644)).To(Succeed())
+	return dir
+}
+
+var _ = Describe("Images", func() {
+	It("should reject image requests without an image store", func(ctx SpecContext) {
+		_, err := imageService.ListImages(ctx, &iri.ListImagesRequest{})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+
+		res, err := runtimeService.Version(ctx, &iri.VersionRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Capabilities.Images).To(BeFalse())
+	})
+
+	Context("with an image store", func() {
+		BeforeEach(func() {
+			srvOpts.Images = image.NewStore(GinkgoT().TempDir(), image.Options{})
+		})
+
+		It("should pull, list and remove images", func(ctx SpecContext) {
+			name := image.LayoutPrefix + writeImageLayout([]byte("disk")) + ":v1"
+
+			res, err := runtimeService.Version(ctx, &iri.VersionRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Capabilities.Images).To(BeTrue())
+
+			By("pulling the image")
+			pullRes, err := imageService.PullImage(ctx, &iri.PullImageRequest{Image: &iri.ImageSpec{Image: name}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pullRes.ImageRef).NotTo(BeEmpty())
+
+			By("listing the image")
+			listRes, err := imageService.ListImages(ctx, &iri.ListImagesRequest{
+				Filter: &iri.ImageFilter{Image: &iri.ImageSpec{Image: name}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listRes.Images).To(ConsistOf(SatisfyAll(
+				HaveField("Ref", pullRes.ImageRef),
+				HaveField("Names", ConsistOf(name)),
+				HaveField("SizeBytes", BeNumerically(">", 0)),
+				HaveField("InUse", BeFalse()),
+			)))
+
+			fsRes, err := imageService.ImageFsInfo(ctx, &iri.ImageFsInfoRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fsRes.UsedBytes).To(Equal(listRes.Images[0].SizeBytes))
+			Expect(fsRes.CapacityBytes).To(BeNumerically(">=", fsRes.AvailableBytes))
+
+			By("removing the image")
+			_, err = imageService.RemoveImage(ctx, &iri.RemoveImageRequest{Image: &iri.ImageSpec{Image: name}})
+			Expect(err).NotTo(HaveOccurred())
+
+			listRes, err = imageService.ListImages(ctx, &iri.ListImagesRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listRes.Images).To(BeEmpty())
+
+			_, err = imageService.RemoveImage(ctx, &iri.RemoveImageRequest{Image: &iri.ImageSpec{Image: name}})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("should not remove images in use", func(ctx SpecContext) {
+			name := image.LayoutPrefix + writeImageLayout([]byte("disk")) + ":v1"
+			_, err := imageService.PullImage(ctx, &iri.PullImageRequest{Image: &iri.ImageSpec{Image: name}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = runtimeService.CreateInstance(ctx, &iri.CreateInstanceRequest{
+				Instance: &iri.Instance{
+					Metadata: &iri.ObjectMetadata{},
+					Spec: &iri.InstanceSpec{
+						Image:       &iri.ImageSpec{Image: name},
+						CpuCount:    1,
+						MemoryBytes: 1024 * 1024 * 1024,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			listRes, err := imageService.ListImages(ctx, &iri.ListImagesRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listRes.Images).To(ConsistOf(HaveField("InUse", BeTrue())))
+
+			_, err = imageService.RemoveImage(ctx, &iri.RemoveImageRequest{Image: &iri.ImageSpec{Image: name}})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+	})
+})
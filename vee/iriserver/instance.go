@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"spheric.cloud/spheric/actuo/meta"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/iri-api/listing"
+	"spheric.cloud/spheric/vee/api"
+)
+
+// idLength is the length of generated instance ids.
+// It is kept short since the id is part of the instance's socket paths.
+const idLength = 32
+
+func generateID() string {
+	data := make([]byte, idLength/2)
+	for {
+		_, _ = rand.Read(data)
+		id := hex.EncodeToString(data)
+
+		// Truncated versions of the id should not be numerical.
+		if _, err := strconv.ParseInt(id[:12], 10, 64); err == nil {
+			continue
+		}
+
+		return id
+	}
+}
+
+func (s *Server) getInstance(ctx context.Context, id string) (*api.Instance, error) {
+	instance, err := s.store.Get(ctx, api.InstanceKey(id))
+	if err != nil {
+		if errors.Is(err, storagestore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "instance %q not found", id)
+		}
+		return nil, fmt.Errorf("error getting instance %q: %w", id, err)
+	}
+	return instance, nil
+}
+
+func (s *Server) updateInstance(ctx context.Context, id string, update func(instance *api.Instance) error) (*api.Instance, error) {
+	instance, err := s.store.Update(ctx, api.InstanceKey(id), false, func(ctx context.Context, instance *api.Instance) (*api.Instance, error) {
+		if err := update(instance); err != nil {
+			return nil, err
+		}
+		return instance, nil
+	})
+	if err != nil {
+		if errors.Is(err, storagestore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "instance %q not found", id)
+		}
+		return nil, err
+	}
+	return instance, nil
+}
+
+// updateInstanceSpec updates the spec of the instance with the given id, bumping its generation if the
+// spec actually changed.
+func (s *Server) updateInstanceSpec(ctx context.Context, id string, update func(spec *api.InstanceSpec) error) (*api.Instance, error) {
+	return s.updateInstance(ctx, id, func(instance *api.Instance) error {
+		return updateSpec(instance, update)
+	})
+}
+
+// updateSpec updates the spec of the given instance, bumping its generation if the spec actually changed.
+func updateSpec(instance *api.Instance, update func(spec *api.InstanceSpec) error) error {
+	oldSpecData, err := json.Marshal(instance.Spec)
+	if err != nil {
+		return err
+	}
+
+	if err := update(&instance.Spec); err != nil {
+		return err
+	}
+
+	newSpecData, err := json.Marshal(instance.Spec)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(oldSpecData, newSpecData) {
+		instance.Generation++
+	}
+	return nil
+}
+
+func (s *Server) listInstances(ctx context.Context, filter *iri.InstanceFilter) ([]*api.Instance, error) {
+	matcher, err := listing.NewInstanceMatcher(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	matches := func(instance *api.Instance) bool {
+		return matcher.Matches(instance.ID, instance.Labels, s.convertInstanceState(instance.Status.State))
+	}
+
+	if id := filter.GetId(); id != "" {
+		instance, err := s.getInstance(ctx, id)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		if !matches(instance) {
+			return nil, nil
+		}
+		return []*api.Instance{instance}, nil
+	}
+
+	list, err := s.store.List(ctx, api.InstancesKey)
+	if err != nil {
+		return nil, fmt.Errorf("error listing instances: %w", err)
+	}
+
+	var res []*api.Instance
+	for instance := range list.All() {
+		if !matches(instance) {
+			continue
+		}
+		res = append(res, instance)
+	}
+	return res, nil
+}
+
+func (s *Server) ListInstances(ctx context.Context, req *iri.ListInstancesRequest) (*iri.ListInstancesResponse, error) {
+	instances, err := s.listInstances(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	instances, nextPageToken, err := listing.Paginate(instances, func(instance *api.Instance) string {
+		return instance.ID
+	}, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := make([]*iri.Instance, 0, len(instances))
+	for _, instance := range instances {
+		res = append(res, s.convertInstance(instance))
+	}
+	return &iri.ListInstancesResponse{Instances: res, NextPageToken: nextPageToken}, nil
+}
+
+func (s *Server) CreateInstance(ctx context.Context, req *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error) {
+	iriInstance := req.GetInstance()
+	if iriInstance.GetSpec() == nil {
+		return nil, status.Error(codes.InvalidArgument, "must specify instance spec")
+	}
+
+	spec, err := s.convertIRIInstanceSpec(iriInstance.Spec)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid instance spec: %v", err)
+	}
+
+	id := generateID()
+	instance := &api.Instance{
+		ObjectMeta: meta.ObjectMeta{
+			Name:              id,
+			Generation:        1,
+			CreationTimestamp: meta.Time{Time: time.Now()},
+			Labels:            maps.Clone(iriInstance.GetMetadata().GetLabels()),
+			Annotations:       maps.Clone(iriInstance.GetMetadata().GetAnnotations()),
+		},
+		ID:   id,
+		Spec: *spec,
+		Status: api.InstanceStatus{
+			State: api.InstanceStatePending,
+		},
+	}
+
+	instance, err = s.store.Create(ctx, api.InstanceKey(id), instance)
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance: %w", err)
+	}
+
+	return &iri.CreateInstanceResponse{
+		Instance: s.convertInstance(instance),
+	}, nil
+}
+
+func (s *Server) DeleteInstance(ctx context.Context, req *iri.DeleteInstanceRequest) (*iri.DeleteInstanceResponse, error) {
+	// The instance is only marked for deletion here. The actual removal from the store happens
+	// once the instance reconciler has torn down the instance's resources.
+	if _, err := s.updateInstance(ctx, req.InstanceId, func(instance *api.Instance) error {
+		if instance.DeletionTimestamp == nil {
+			instance.DeletionTimestamp = &meta.Time{Time: time.Now()}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.DeleteInstanceResponse{}, nil
+}
+
+func (s *Server) UpdateInstanceAnnotations(ctx context.Context, req *iri.UpdateInstanceAnnotationsRequest) (*iri.UpdateInstanceAnnotationsResponse, error) {
+	if _, err := s.updateInstance(ctx, req.InstanceId, func(instance *api.Instance) error {
+		instance.Annotations = maps.Clone(req.Annotations)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.UpdateInstanceAnnotationsResponse{}, nil
+}
+
+func (s *Server) UpdateInstancePower(ctx context.Context, req *iri.UpdateInstancePowerRequest) (*iri.UpdateInstancePowerResponse, error) {
+	power, err := s.convertIRIPower(req.Power)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid power: %v", err)
+	}
+
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		spec.Power = power
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.UpdateInstancePowerResponse{}, nil
+}
+
+// RebootInstance requests a reboot of the instance by increasing its reboot generation.
+// The instance reconciler carries out the reboot once it observes the new generation.
+func (s *Server) RebootInstance(ctx context.Context, req *iri.RebootInstanceRequest) (*iri.RebootInstanceResponse, error) {
+	rebootType, err := s.convertIRIRebootType(req.Type)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reboot type: %v", err)
+	}
+
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		if spec.Power != api.PowerOn {
+			return status.Errorf(codes.FailedPrecondition, "instance %q is not powered on", req.InstanceId)
+		}
+		spec.RebootGeneration++
+		spec.RebootType = rebootType
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.RebootInstanceResponse{}, nil
+}
+
+// UpdateInstanceResources updates the type and resources of the instance. While the instance is booted, the
+// resources can only be changed within its resize limits, beyond them the instance has to be powered off first.
+func (s *Server) UpdateInstanceResources(ctx context.Context, req *iri.UpdateInstanceResourcesRequest) (*iri.UpdateInstanceResourcesResponse, error) {
+	if req.CpuCount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify cpu count")
+	}
+	if req.MemoryBytes == 0 {
+		return nil, status.Error(codes.InvalidArgument, "must specify memory bytes")
+	}
+	cpuCount, memoryBytes := int32(req.CpuCount), int64(req.MemoryBytes)
+
+	if _, err := s.updateInstance(ctx, req.InstanceId, func(instance *api.Instance) error {
+		// Check the limits against the instance being written, they change as soon as the vm boots.
+		if limits := instance.Status.ResizeLimits; limits != nil && !limits.Allows(cpuCount, memoryBytes) {
+			return status.Errorf(codes.FailedPrecondition, "instance %q has to be powered off to be resized to %d cpus and %d bytes of memory",
+				req.InstanceId, cpuCount, memoryBytes)
+		}
+
+		return updateSpec(instance, func(spec *api.InstanceSpec) error {
+			spec.Type = req.Type
+			spec.CPUCount = cpuCount
+			spec.MemoryBytes = memoryBytes
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.UpdateInstanceResourcesResponse{}, nil
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+)
+
+func createInstance(ctx SpecContext, labels map[string]string) *iri.Instance {
+	GinkgoHelper()
+	res, err := runtimeService.CreateInstance(ctx, &iri.CreateInstanceRequest{
+		Instance: &iri.Instance{
+			Metadata: &iri.ObjectMetadata{Labels: labels},
+			Spec: &iri.InstanceSpec{
+				Power:       iri.Power_POWER_ON,
+				Type:        "small",
+				CpuCount:    1,
+				MemoryBytes: 1024 * 1024 * 1024,
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return res.Instance
+}
+
+func getInstance(ctx SpecContext, id string) *iri.Instance {
+	GinkgoHelper()
+	res, err := runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+		Filter: &iri.InstanceFilter{Id: id},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(res.Instances).To(HaveLen(1))
+	return res.Instances[0]
+}
+
+var _ = Describe("Instance", func() {
+	It("should create an instance", func(ctx SpecContext) {
+		instance := createInstance(ctx, map[string]string{"foo": "bar"})
+		Expect(instance.Metadata.Id).NotTo(BeEmpty())
+		Expect(instance.Metadata.Generation).To(BeEquivalentTo(1))
+		Expect(instance.Metadata.CreatedAt).NotTo(BeZero())
+		Expect(instance.Metadata.Labels).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(instance.Spec.Power).To(Equal(iri.Power_POWER_ON))
+		Expect(instance.Spec.Type).To(Equal("small"))
+		Expect(instance.Status.State).To(Equal(iri.InstanceState_INSTANCE_PENDING))
+
+		By("inspecting the stored instance")
+		stored, err := store.Get(ctx, api.InstanceKey(instance.Metadata.Id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Spec.CPUCount).To(BeEquivalentTo(1))
+		Expect(stored.Spec.MemoryBytes).To(BeEquivalentTo(1024 * 1024 * 1024))
+	})
+
+	It("should reject instances without spec", func(ctx SpecContext) {
+		_, err := runtimeService.CreateInstance(ctx, &iri.CreateInstanceRequest{
+			Instance: &iri.Instance{},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should list instances by id and labels", func(ctx SpecContext) {
+		foo := createInstance(ctx, map[string]string{"app": "foo"})
+		bar := createInstance(ctx, map[string]string{"app": "bar"})
+
+		res, err := runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(HaveLen(2))
+
+		res, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{LabelSelector: map[string]string{"app": "bar"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(HaveLen(1))
+		Expect(res.Instances[0].Metadata.Id).To(Equal(bar.Metadata.Id))
+
+		res, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{Id: foo.Metadata.Id, LabelSelector: map[string]string{"app": "bar"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(BeEmpty())
+
+		res, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{Id: "unknown"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(BeEmpty())
+	})
+
+	It("should list instances by set-based selectors and states", func(ctx SpecContext) {
+		foo := createInstance(ctx, map[string]string{"app": "foo"})
+		bar := createInstance(ctx, map[string]string{"app": "bar"})
+		createInstance(ctx, map[string]string{"app": "baz"})
+
+		res, err := runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{
+				LabelSelectorRequirements: []*iri.LabelSelectorRequirement{{
+					Key:      "app",
+					Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_IN,
+					Values:   []string{"foo", "bar"},
+				}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(ConsistOf(
+			HaveField("Metadata.Id", foo.Metadata.Id),
+			HaveField("Metadata.Id", bar.Metadata.Id),
+		))
+
+		res, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{States: []iri.InstanceState{iri.InstanceState_INSTANCE_RUNNING}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(BeEmpty())
+
+		res, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{States: []iri.InstanceState{iri.InstanceState_INSTANCE_PENDING}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Instances).To(HaveLen(3))
+
+		_, err = runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+			Filter: &iri.InstanceFilter{
+				LabelSelectorRequirements: []*iri.LabelSelectorRequirement{{
+					Key:      "app",
+					Operator: iri.LabelSelectorOperator_LABEL_SELECTOR_OPERATOR_EXISTS,
+					Values:   []string{"foo"},
+				}},
+			},
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should page through instances", func(ctx SpecContext) {
+		var ids []string
+		for range 5 {
+			ids = append(ids, createInstance(ctx, nil).Metadata.Id)
+		}
+
+		var (
+			listed    []string
+			pageToken string
+		)
+		for {
+			res, err := runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{
+				PageSize:  2,
+				PageToken: pageToken,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(res.Instances)).To(BeNumerically("<=", 2))
+			for _, instance := range res.Instances {
+				listed = append(listed, instance.Metadata.Id)
+			}
+
+			if res.NextPageToken == "" {
+				break
+			}
+			pageToken = res.NextPageToken
+		}
+		Expect(listed).To(ConsistOf(ids))
+
+		_, err := runtimeService.ListInstances(ctx, &iri.ListInstancesRequest{PageSize: -1})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should only bump the generation on spec changes", func(ctx SpecContext) {
+		instance := createInstance(ctx, nil)
+		id := instance.Metadata.Id
+
+		By("updating the annotations")
+		_, err := runtimeService.UpdateInstanceAnnotations(ctx, &iri.UpdateInstanceAnnotationsRequest{
+			InstanceId:  id,
+			Annotations: map[string]string{"foo": "bar"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		instance = getInstance(ctx, id)
+		Expect(instance.Metadata.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(instance.Metadata.Generation).To(BeEquivalentTo(1))
+
+		By("setting the power to its current value")
+		_, err = runtimeService.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+			InstanceId: id,
+			Power:      iri.Power_POWER_ON,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getInstance(ctx, id).Metadata.Generation).To(BeEquivalentTo(1))
+
+		By("powering off the instance")
+		_, err = runtimeService.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+			InstanceId: id,
+			Power:      iri.Power_POWER_OFF,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		instance = getInstance(ctx, id)
+		Expect(instance.Spec.Power).To(Equal(iri.Power_POWER_OFF))
+		Expect(instance.Metadata.Generation).To(BeEquivalentTo(2))
+	})
+
+	It("should request reboots by increasing the reboot generation", func(ctx SpecContext) {
+		instance := createInstance(ctx, nil)
+		id := instance.Metadata.Id
+
+		By("rebooting the instance twice")
+		_, err := runtimeService.RebootInstance(ctx, &iri.RebootInstanceRequest{InstanceId: id})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = runtimeService.RebootInstance(ctx, &iri.RebootInstanceRequest{
+			InstanceId: id,
+			Type:       iri.RebootType_REBOOT_HARD,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		stored, err := store.Get(ctx, api.InstanceKey(id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Spec.RebootGeneration).To(BeEquivalentTo(2))
+		Expect(stored.Spec.RebootType).To(Equal(api.RebootHard))
+		Expect(stored.Generation).To(BeEquivalentTo(3))
+
+		By("powering off the instance")
+		_, err = runtimeService.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+			InstanceId: id,
+			Power:      iri.Power_POWER_OFF,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("asserting powered off instances cannot be rebooted")
+		_, err = runtimeService.RebootInstance(ctx, &iri.RebootInstanceRequest{InstanceId: id})
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	It("should update the resources of instances within their resize limits", func(ctx SpecContext) {
+		instance := createInstance(ctx, nil)
+		id := instance.Metadata.Id
+
+		By("resizing the instance that has not been booted yet")
+		_, err := runtimeService.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{
+			InstanceId:  id,
+			Type:        "large",
+			CpuCount:    8,
+			MemoryBytes: 8 * 1024 * 1024 * 1024,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		stored, err := store.Get(ctx, api.InstanceKey(id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Spec.Type).To(Equal("large"))
+		Expect(stored.Spec.CPUCount).To(BeEquivalentTo(8))
+		Expect(stored.Spec.MemoryBytes).To(BeEquivalentTo(8 * 1024 * 1024 * 1024))
+		Expect(stored.Generation).To(BeEquivalentTo(2))
+
+		By("reporting resize limits as the booted vm would")
+		_, err = store.Update(ctx, api.InstanceKey(id), false, func(ctx context.Context, instance *api.Instance) (*api.Instance, error) {
+			instance.Status.ResizeLimits = &api.ResizeLimits{
+				MaxCPUCount:    8,
+				MinMemoryBytes: 8 * 1024 * 1024 * 1024,
+				MaxMemoryBytes: 16 * 1024 * 1024 * 1024,
+			}
+			return instance, nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("resizing the instance within its resize limits")
+		_, err = runtimeService.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{
+			InstanceId:  id,
+			Type:        "large-mem",
+			CpuCount:    4,
+			MemoryBytes: 16 * 1024 * 1024 * 1024,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("asserting resizes beyond the resize limits are rejected")
+		_, err = runtimeService.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{
+			InstanceId:  id,
+			Type:        "xlarge",
+			CpuCount:    16,
+			MemoryBytes: 16 * 1024 * 1024 * 1024,
+		})
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		stored, err = store.Get(ctx, api.InstanceKey(id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Spec.Type).To(Equal("large-mem"))
+		Expect(stored.Spec.CPUCount).To(BeEquivalentTo(4))
+
+		By("asserting resources have to be specified")
+		_, err = runtimeService.UpdateInstanceResources(ctx, &iri.UpdateInstanceResourcesRequest{InstanceId: id, Type: "none"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should mark instances for deletion", func(ctx SpecContext) {
+		instance := createInstance(ctx, nil)
+		id := instance.Metadata.Id
+
+		_, err := runtimeService.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: id})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("asserting the instance is still present until torn down")
+		instance = getInstance(ctx, id)
+		Expect(instance.Metadata.DeletedAt).NotTo(BeZero())
+	})
+
+	It("should report unknown instances as not found", func(ctx SpecContext) {
+		_, err := runtimeService.DeleteInstance(ctx, &iri.DeleteInstanceRequest{InstanceId: "unknown"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		_, err = runtimeService.UpdateInstancePower(ctx, &iri.UpdateInstancePowerRequest{
+			InstanceId: "unknown",
+			Power:      iri.Power_POWER_OFF,
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
@@ -6,25 +6,100 @@ package iriserver
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/blang/semver/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
 	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/console"
+	"spheric.cloud/spheric/vee/host"
+	"spheric.cloud/spheric/vee/image"
 	"spheric.cloud/spheric/vee/version"
+	"spheric.cloud/spheric/vee/vmm"
 )
 
+// Streamer hands out the urls streaming requests are served at.
+type Streamer interface {
+	GetExec(req *iri.ExecRequest) (*iri.ExecResponse, error)
+	GetPortForward(req *iri.PortForwardRequest) (*iri.PortForwardResponse, error)
+}
+
+// ConsoleLogs reads the recorded serial console logs of instances.
+type ConsoleLogs interface {
+	ReadLog(ctx context.Context, instanceID string, opts console.LogOptions, w io.Writer) error
+}
+
 type Server struct {
 	iri.UnimplementedRuntimeServiceServer
-	dir string
+	iri.UnimplementedImageServiceServer
+	dir      string
+	store    storagestore.Store[string, *api.Instance]
+	streamer Streamer
+	consoles ConsoleLogs
+	hyp      vmm.Hypervisor
+	images   *image.Store
+
+	procDir             string
+	reservedCPUCount    int64
+	reservedMemoryBytes uint64
+	instanceTypes       map[string]InstanceType
+}
+
+// InstanceType are the resources of an instance type.
+type InstanceType struct {
+	CPUCount    int64
+	MemoryBytes uint64
+}
+
+type Options struct {
+	// ProcDir is the location of the proc filesystem to read host resources from.
+	// Defaults to host.DefaultProcDir.
+	ProcDir string
+	// ReservedCPUCount is the number of host CPUs not to be used for instances.
+	ReservedCPUCount int64
+	// ReservedMemoryBytes is the amount of host memory not to be used for instances.
+	ReservedMemoryBytes uint64
+	// InstanceTypes are instance types to report quantities for, in addition
+	// to the types of existing instances.
+	InstanceTypes map[string]InstanceType
+	// Streamer hands out streaming urls. If unset, streaming requests are rejected as unimplemented.
+	Streamer Streamer
+	// Consoles reads the serial console logs of instances. If unset, console log requests are rejected as unimplemented.
+	Consoles ConsoleLogs
+	// Hypervisor is used to read instance statistics. If unset, statistics requests are rejected as unimplemented.
+	Hypervisor vmm.Hypervisor
+	// Images is the store images are managed in. If unset, image requests are rejected as unimplemented.
+	Images *image.Store
 }
 
-func New(dir string) (*Server, error) {
+func setOptionsDefaults(o *Options) {
+	if o.ProcDir == "" {
+		o.ProcDir = host.DefaultProcDir
+	}
+}
+
+func New(dir string, store storagestore.Store[string, *api.Instance], opts Options) (*Server, error) {
+	setOptionsDefaults(&opts)
+
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, fmt.Errorf("error ensuring directory at %q: %w", dir, err)
 	}
 
 	return &Server{
-		dir: dir,
+		dir:                 dir,
+		store:               store,
+		streamer:            opts.Streamer,
+		consoles:            opts.Consoles,
+		hyp:                 opts.Hypervisor,
+		images:              opts.Images,
+		procDir:             opts.ProcDir,
+		reservedCPUCount:    opts.ReservedCPUCount,
+		reservedMemoryBytes: opts.ReservedMemoryBytes,
+		instanceTypes:       opts.InstanceTypes,
 	}, nil
 }
 
@@ -47,58 +122,40 @@ func (s *Server) Version(ctx context.Context, request *iri.VersionRequest) (*iri
 	return &iri.VersionResponse{
 		RuntimeName:    version.RuntimeName,
 		RuntimeVersion: runtimeVersion,
+		Capabilities: &iri.RuntimeCapabilities{
+			HotplugDisks:             true,
+			HotplugNetworkInterfaces: true,
+			WatchInstances:           false, // Not implemented yet, spherelet falls back to polling.
+			InstanceStats:            s.hyp != nil,
+			Exec:                     s.streamer != nil,
+			Reboot:                   true,
+			Suspend:                  true,
+			Images:                   s.images != nil,
+			PortForward:              s.streamer != nil,
+			ConsoleLog:               s.consoles != nil,
+			Resize:                   true,
+		},
 	}, nil
 }
 
-func (s *Server) ListInstances(ctx context.Context, request *iri.ListInstancesRequest) (*iri.ListInstancesResponse, error) {
-	panic("implement me")
-}
-
-func (s *Server) CreateInstance(ctx context.Context, request *iri.CreateInstanceRequest) (*iri.CreateInstanceResponse, error) {
-	panic("implement me")
-}
-
-func (s *Server) DeleteInstance(ctx context.Context, request *iri.DeleteInstanceRequest) (*iri.DeleteInstanceResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) UpdateInstanceAnnotations(ctx context.Context, request *iri.UpdateInstanceAnnotationsRequest) (*iri.UpdateInstanceAnnotationsResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) UpdateInstancePower(ctx context.Context, request *iri.UpdateInstancePowerRequest) (*iri.UpdateInstancePowerResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) AttachDisk(ctx context.Context, request *iri.AttachDiskRequest) (*iri.AttachDiskResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) DetachDisk(ctx context.Context, request *iri.DetachDiskRequest) (*iri.DetachDiskResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s *Server) AttachNetworkInterface(ctx context.Context, request *iri.AttachNetworkInterfaceRequest) (*iri.AttachNetworkInterfaceResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
+func (s *Server) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
+	if s.streamer == nil {
+		return nil, status.Error(codes.Unimplemented, "exec is not supported")
+	}
 
-func (s *Server) DetachNetworkInterface(ctx context.Context, request *iri.DetachNetworkInterfaceRequest) (*iri.DetachNetworkInterfaceResponse, error) {
-	//TODO implement me
-	panic("implement me")
+	if _, err := s.getInstance(ctx, req.InstanceId); err != nil {
+		return nil, err
+	}
+	return s.streamer.GetExec(req)
 }
 
-func (s *Server) Status(ctx context.Context, request *iri.StatusRequest) (*iri.StatusResponse, error) {
-	//TODO implement me
-	panic("implement me")
-}
+func (s *Server) PortForward(ctx context.Context, req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	if s.streamer == nil {
+		return nil, status.Error(codes.Unimplemented, "port forward is not supported")
+	}
 
-func (s *Server) Exec(ctx context.Context, request *iri.ExecRequest) (*iri.ExecResponse, error) {
-	//TODO implement me
-	panic("implement me")
+	if _, err := s.getInstance(ctx, req.InstanceId); err != nil {
+		return nil, err
+	}
+	return s.streamer.GetPortForward(req)
 }
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apiserver/pkg/storage/etcd3/testserver"
+	"spheric.cloud/spheric/actuo/codec"
+	"spheric.cloud/spheric/actuo/storage/etcd"
+	storagestore "spheric.cloud/spheric/actuo/storage/store"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	. "spheric.cloud/spheric/utils/testing"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/iriserver"
+)
+
+func TestIRIServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IRI Server Suite")
+}
+
+var (
+	runtimeService iri.RuntimeServiceClient
+	imageService   iri.ImageServiceClient
+	store          storagestore.Store[string, *api.Instance]
+	srvOpts        iriserver.Options
+)
+
+// Run an iriserver.Server backed by a test etcd for every spec. Specs can adapt srvOpts
+// in their own BeforeEach before the server is started via JustBeforeEach.
+var _ = BeforeEach(func() {
+	srvOpts = iriserver.Options{}
+})
+
+var _ = JustBeforeEach(func() {
+	cl := testserver.RunEtcd(GinkgoTB(), testserver.NewTestConfig(GinkgoTB()))
+	store = etcd.NewSimple[*api.Instance](
+		cl,
+		codec.JSON[*api.Instance](),
+		storagestore.DefaultFactory[*api.Instance](),
+		storagestore.DefaultMetaVersioner[*api.Instance](),
+	)
+
+	dir := ShortSocketDir(GinkgoT())
+
+	procDir := filepath.Join(dir, "proc")
+	Expect(os.Mkdir(procDir, 0755)).To(Succeed())
+	Expect(os.WriteFile(filepath.Join(procDir, "cpuinfo"), []byte("processor\t: 0\n\nprocessor\t: 1\n"), 0644)).To(Succeed())
+	Expect(os.WriteFile(filepath.Join(procDir, "meminfo"), []byte("MemTotal:       8388608 kB\n"), 0644)).To(Succeed())
+
+	srvOpts.ProcDir = procDir
+	srv, err := iriserver.New(filepath.Join(dir, "data"), store, srvOpts)
+	Expect(err).NotTo(HaveOccurred())
+
+	grpcSrv := grpc.NewServer()
+	iri.RegisterRuntimeServiceServer(grpcSrv, srv)
+	iri.RegisterImageServiceServer(grpcSrv, srv)
+
+	socket := filepath.Join(dir, "iri.sock")
+	l, err := net.Listen("unix", socket)
+	Expect(err).NotTo(HaveOccurred())
+	go func() { _ = grpcSrv.Serve(l) }()
+	DeferCleanup(grpcSrv.Stop)
+
+	conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	runtimeService = iri.NewRuntimeServiceClient(conn)
+	imageService = iri.NewImageServiceClient(conn)
+})
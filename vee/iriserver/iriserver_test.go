@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+)
+
+type fakeStreamer struct{}
+
+func (fakeStreamer) GetExec(req *iri.ExecRequest) (*iri.ExecResponse, error) {
+	return &iri.ExecResponse{Url: "http://streaming/exec/" + req.InstanceId}, nil
+}
+
+func (fakeStreamer) GetPortForward(req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	return &iri.PortForwardResponse{Url: "http://streaming/portforward/" + req.InstanceId}, nil
+}
+
+var _ = Describe("Server", func() {
+	It("should report its version", func(ctx SpecContext) {
+		res, err := runtimeService.Version(ctx, &iri.VersionRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RuntimeName).To(Equal("vee"))
+		Expect(res.RuntimeVersion).NotTo(BeEmpty())
+		Expect(res.Capabilities).To(SatisfyAll(
+			HaveField("HotplugDisks", BeTrue()),
+			HaveField("HotplugNetworkInterfaces", BeTrue()),
+			HaveField("WatchInstances", BeFalse()),
+			HaveField("InstanceStats", BeFalse()),
+			HaveField("Exec", BeFalse()),
+			HaveField("Reboot", BeTrue()),
+			HaveField("Suspend", BeTrue()),
+			HaveField("Images", BeFalse()),
+			HaveField("PortForward", BeFalse()),
+			HaveField("ConsoleLog", BeFalse()),
+			HaveField("Resize", BeTrue()),
+		))
+	})
+
+	Describe("Exec", func() {
+		It("should reject exec if no streamer is configured", func(ctx SpecContext) {
+			id := createInstance(ctx, nil).Metadata.Id
+
+			_, err := runtimeService.Exec(ctx, &iri.ExecRequest{InstanceId: id})
+			Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+		})
+
+		Context("with a streamer", func() {
+			BeforeEach(func() {
+				srvOpts.Streamer = fakeStreamer{}
+			})
+
+			It("should return the exec url of the instance", func(ctx SpecContext) {
+				id := createInstance(ctx, nil).Metadata.Id
+
+				res, err := runtimeService.Exec(ctx, &iri.ExecRequest{InstanceId: id})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.Url).To(Equal("http://streaming/exec/" + id))
+			})
+
+			It("should report unknown instances as not found", func(ctx SpecContext) {
+				_, err := runtimeService.Exec(ctx, &iri.ExecRequest{InstanceId: "unknown"})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+
+	Describe("PortForward", func() {
+		It("should reject port forward if no streamer is configured", func(ctx SpecContext) {
+			id := createInstance(ctx, nil).Metadata.Id
+
+			_, err := runtimeService.PortForward(ctx, &iri.PortForwardRequest{InstanceId: id})
+			Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+		})
+
+		Context("with a streamer", func() {
+			BeforeEach(func() {
+				srvOpts.Streamer = fakeStreamer{}
+			})
+
+			It("should return the port forward url of the instance", func(ctx SpecContext) {
+				id := createInstance(ctx, nil).Metadata.Id
+
+				res, err := runtimeService.PortForward(ctx, &iri.PortForwardRequest{InstanceId: id, Port: []int32{22}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(res.Url).To(Equal("http://streaming/portforward/" + id))
+			})
+
+			It("should report unknown instances as not found", func(ctx SpecContext) {
+				_, err := runtimeService.PortForward(ctx, &iri.PortForwardRequest{InstanceId: "unknown"})
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+			})
+		})
+	})
+})
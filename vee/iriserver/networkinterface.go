@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+)
+
+func (s *Server) AttachNetworkInterface(ctx context.Context, req *iri.AttachNetworkInterfaceRequest) (*iri.AttachNetworkInterfaceResponse, error) {
+	iriNic := req.GetNetworkInterface()
+	if iriNic == nil {
+		return nil, status.Error(codes.InvalidArgument, "must specify network interface")
+	}
+
+	nic := s.convertIRINetworkInterface(iriNic)
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		if slices.ContainsFunc(spec.NetworkInterfaces, func(existing api.NetworkInterface) bool { return existing.Name == nic.Name }) {
+			return status.Errorf(codes.AlreadyExists, "instance %q network interface %q already exists", req.InstanceId, nic.Name)
+		}
+
+		spec.NetworkInterfaces = append(spec.NetworkInterfaces, nic)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.AttachNetworkInterfaceResponse{}, nil
+}
+
+func (s *Server) DetachNetworkInterface(ctx context.Context, req *iri.DetachNetworkInterfaceRequest) (*iri.DetachNetworkInterfaceResponse, error) {
+	if _, err := s.updateInstanceSpec(ctx, req.InstanceId, func(spec *api.InstanceSpec) error {
+		idx := slices.IndexFunc(spec.NetworkInterfaces, func(nic api.NetworkInterface) bool { return nic.Name == req.Name })
+		if idx < 0 {
+			return status.Errorf(codes.NotFound, "instance %q network interface attachment %q not found", req.InstanceId, req.Name)
+		}
+
+		spec.NetworkInterfaces = slices.Delete(spec.NetworkInterfaces, idx, idx+1)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &iri.DetachNetworkInterfaceResponse{}, nil
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/vmm"
+)
+
+// counter returns the given cloud-hypervisor device counter, treating missing and negative values as zero.
+func counter(counters oapiclient.VmCounters, deviceID, name string) uint64 {
+	return uint64(max(counters[deviceID][name], 0))
+}
+
+func convertInstanceStats(instance *api.Instance, stats *vmm.Stats, now time.Time) *iri.InstanceStats {
+	disks := make([]*iri.DiskStats, 0, len(instance.Spec.Disks))
+	for _, disk := range instance.Spec.Disks {
+		id := api.DiskDeviceID(disk.Name)
+		if _, ok := stats.Counters[id]; !ok {
+			continue
+		}
+
+		disks = append(disks, &iri.DiskStats{
+			Name:       disk.Name,
+			ReadBytes:  counter(stats.Counters, id, "read_bytes"),
+			WriteBytes: counter(stats.Counters, id, "write_bytes"),
+			ReadOps:    counter(stats.Counters, id, "read_ops"),
+			WriteOps:   counter(stats.Counters, id, "write_ops"),
+		})
+	}
+
+	nics := make([]*iri.NetworkInterfaceStats, 0, len(instance.Spec.NetworkInterfaces))
+	for _, nic := range instance.Spec.NetworkInterfaces {
+		id := api.NetworkInterfaceDeviceID(nic.Name)
+		if _, ok := stats.Counters[id]; !ok {
+			continue
+		}
+
+		nics = append(nics, &iri.NetworkInterfaceStats{
+			Name:      nic.Name,
+			RxBytes:   counter(stats.Counters, id, "rx_bytes"),
+			RxPackets: counter(stats.Counters, id, "rx_frames"),
+			TxBytes:   counter(stats.Counters, id, "tx_bytes"),
+			TxPackets: counter(stats.Counters, id, "tx_frames"),
+		})
+	}
+
+	return &iri.InstanceStats{
+		InstanceId: instance.ID,
+		Timestamp:  now.UnixNano(),
+		Cpu: &iri.CpuStats{
+			UsageCoreNanoseconds: uint64(stats.CPUTime.Nanoseconds()),
+		},
+		Memory: &iri.MemoryStats{
+			UsageBytes:     stats.MemoryBytes,
+			AvailableBytes: uint64(instance.Spec.MemoryBytes),
+		},
+		Disks:             disks,
+		NetworkInterfaces: nics,
+	}
+}
+
+// instanceStats returns the statistics of the given instance. If the instance has no running vmm, nil is returned.
+func (s *Server) instanceStats(ctx context.Context, instance *api.Instance) (*iri.InstanceStats, error) {
+	stats, err := s.hyp.Stats(ctx, instance.ID)
+	if err != nil {
+		if errors.Is(err, vmm.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting stats of instance %q: %w", instance.ID, err)
+	}
+	return convertInstanceStats(instance, stats, time.Now()), nil
+}
+
+func (s *Server) InstanceStats(ctx context.Context, req *iri.InstanceStatsRequest) (*iri.InstanceStatsResponse, error) {
+	if s.hyp == nil {
+		return nil, status.Error(codes.Unimplemented, "instance stats are not supported")
+	}
+
+	instance, err := s.getInstance(ctx, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.instanceStats(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "instance %q is not running", req.InstanceId)
+	}
+	return &iri.InstanceStatsResponse{Stats: stats}, nil
+}
+
+func (s *Server) ListInstanceStats(ctx context.Context, req *iri.ListInstanceStatsRequest) (*iri.ListInstanceStatsResponse, error) {
+	if s.hyp == nil {
+		return nil, status.Error(codes.Unimplemented, "instance stats are not supported")
+	}
+
+	instances, err := s.listInstances(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*iri.InstanceStats, 0, len(instances))
+	for _, instance := range instances {
+		stats, err := s.instanceStats(ctx, instance)
+		if err != nil {
+			return nil, err
+		}
+		if stats != nil {
+			res = append(res, stats)
+		}
+	}
+	return &iri.ListInstanceStatsResponse{Stats: res}, nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	. "spheric.cloud/spheric/utils/testing"
+	"spheric.cloud/spheric/vee/host"
+	vmmfake "spheric.cloud/spheric/vee/vmm/fake"
+)
+
+var _ = Describe("Stats", func() {
+	It("should reject stats if no hypervisor is configured", func(ctx SpecContext) {
+		id := createInstance(ctx, nil).Metadata.Id
+
+		_, err := runtimeService.InstanceStats(ctx, &iri.InstanceStatsRequest{InstanceId: id})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	})
+
+	Context("with a hypervisor", func() {
+		var hypervisor *vmmfake.Hypervisor
+
+		BeforeEach(func() {
+			dir := ShortSocketDir(GinkgoT())
+
+			hypervisor = vmmfake.NewHypervisor(dir)
+			DeferCleanup(hypervisor.Close)
+			srvOpts.Hypervisor = hypervisor
+		})
+
+		startVM := func(ctx SpecContext, id string) *vmmfake.VMM {
+			GinkgoHelper()
+			cHyp, err := hypervisor.Start(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cHyp.CreateVM(ctx, oapiclient.VmConfig{})).To(Succeed())
+			return hypervisor.VMM(id)
+		}
+
+		It("should report the stats of running instances", func(ctx SpecContext) {
+			id := createInstance(ctx, nil).Metadata.Id
+			_, err := runtimeService.AttachDisk(ctx, &iri.AttachDiskRequest{
+				InstanceId: id,
+				Disk:       &iri.Disk{Name: "data", Device: "vda", EmptyDisk: &iri.EmptyDisk{SizeBytes: 1024}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			vmm := startVM(ctx, id)
+			vmm.SetCounters(oapiclient.VmCounters{
+				"disk-data":    {"read_bytes": 4096, "write_bytes": 512, "read_ops": 8, "write_ops": 1},
+				"config-drive": {"read_bytes": 2048},
+			})
+			hypervisor.SetUsage(id, host.ProcessUsage{CPUTime: 2 * time.Second, MemoryBytes: 512 * 1024 * 1024})
+
+			res, err := runtimeService.InstanceStats(ctx, &iri.InstanceStatsRequest{InstanceId: id})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Stats.InstanceId).To(Equal(id))
+			Expect(res.Stats.Timestamp).NotTo(BeZero())
+			Expect(res.Stats.Cpu.UsageCoreNanoseconds).To(BeEquivalentTo(2 * time.Second))
+			Expect(res.Stats.Memory.UsageBytes).To(BeEquivalentTo(512 * 1024 * 1024))
+			Expect(res.Stats.Memory.AvailableBytes).To(BeEquivalentTo(1024 * 1024 * 1024))
+			Expect(res.Stats.Disks).To(HaveExactElements(&iri.DiskStats{
+				Name:       "data",
+				ReadBytes:  4096,
+				WriteBytes: 512,
+				ReadOps:    8,
+				WriteOps:   1,
+			}))
+			Expect(res.Stats.NetworkInterfaces).To(BeEmpty())
+		})
+
+		It("should list the stats of running instances only", func(ctx SpecContext) {
+			runningID := createInstance(ctx, map[string]string{"foo": "bar"}).Metadata.Id
+			stoppedID := createInstance(ctx, map[string]string{"foo": "bar"}).Metadata.Id
+			startVM(ctx, runningID)
+
+			res, err := runtimeService.ListInstanceStats(ctx, &iri.ListInstanceStatsRequest{
+				Filter: &iri.InstanceFilter{LabelSelector: map[string]string{"foo": "bar"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Stats).To(ConsistOf(HaveField("InstanceId", runningID)))
+
+			_, err = runtimeService.InstanceStats(ctx, &iri.InstanceStatsRequest{InstanceId: stoppedID})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("should report unknown instances as not found", func(ctx SpecContext) {
+			_, err := runtimeService.InstanceStats(ctx, &iri.InstanceStatsRequest{InstanceId: "unknown"})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/api"
+	"spheric.cloud/spheric/vee/host"
+)
+
+func subtractUint64(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
+
+// consumesResources reports whether the instance's resources are (or are about to be) in use.
+// Suspended instances keep their memory and have to be resumable, hence they count as well.
+func consumesResources(instance *api.Instance) bool {
+	return instance.DeletionTimestamp == nil && instance.Spec.Power != api.PowerOff
+}
+
+// instanceTypes returns the configured instance types merged with the types of existing instances.
+// Configured instance types take precedence.
+func (s *Server) knownInstanceTypes(instances []*api.Instance) map[string]InstanceType {
+	res := make(map[string]InstanceType)
+	for _, instance := range instances {
+		if instance.Spec.Type == "" {
+			continue
+		}
+
+		res[instance.Spec.Type] = InstanceType{
+			CPUCount:    int64(instance.Spec.CPUCount),
+			MemoryBytes: uint64(instance.Spec.MemoryBytes),
+		}
+	}
+	maps.Copy(res, s.instanceTypes)
+	return res
+}
+
+func instanceQuantity(resources *iri.RuntimeResources, instanceType InstanceType) int64 {
+	quantity := int64(-1)
+	if instanceType.CPUCount > 0 {
+		quantity = resources.CpuCount / instanceType.CPUCount
+	}
+	if instanceType.MemoryBytes > 0 {
+		memoryQuantity := int64(resources.MemoryBytes / instanceType.MemoryBytes)
+		if quantity < 0 || memoryQuantity < quantity {
+			quantity = memoryQuantity
+		}
+	}
+	return max(quantity, 0)
+}
+
+func setInstanceQuantities(resources *iri.RuntimeResources, instanceTypes map[string]InstanceType) {
+	resources.InstanceQuantities = make(map[string]int64, len(instanceTypes))
+	for name, instanceType := range instanceTypes {
+		resources.InstanceQuantities[name] = instanceQuantity(resources, instanceType)
+	}
+}
+
+func (s *Server) Status(ctx context.Context, req *iri.StatusRequest) (*iri.StatusResponse, error) {
+	hostResources, err := host.ReadResources(s.procDir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading host resources: %w", err)
+	}
+
+	instances, err := s.listInstances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &iri.RuntimeResources{
+		CpuCount:    max(hostResources.CPUCount-s.reservedCPUCount, 0),
+		MemoryBytes: subtractUint64(hostResources.MemoryBytes, s.reservedMemoryBytes),
+	}
+
+	var (
+		usedCPUCount    int64
+		usedMemoryBytes uint64
+	)
+	for _, instance := range instances {
+		if !consumesResources(instance) {
+			continue
+		}
+
+		usedCPUCount += int64(instance.Spec.CPUCount)
+		usedMemoryBytes += uint64(instance.Spec.MemoryBytes)
+	}
+
+	allocatable := &iri.RuntimeResources{
+		CpuCount:    max(capacity.CpuCount-usedCPUCount, 0),
+		MemoryBytes: subtractUint64(capacity.MemoryBytes, usedMemoryBytes),
+	}
+
+	instanceTypes := s.knownInstanceTypes(instances)
+	setInstanceQuantities(capacity, instanceTypes)
+	setInstanceQuantities(allocatable, instanceTypes)
+
+	return &iri.StatusResponse{
+		Capacity:    capacity,
+		Allocatable: allocatable,
+	}, nil
+}
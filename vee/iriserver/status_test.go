@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package iriserver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	"spheric.cloud/spheric/vee/iriserver"
+)
+
+var _ = Describe("Status", func() {
+	BeforeEach(func() {
+		srvOpts.ReservedMemoryBytes = 2 * 1024 * 1024 * 1024
+		srvOpts.InstanceTypes = map[string]iriserver.InstanceType{
+			"large": {CPUCount: 2, MemoryBytes: 4 * 1024 * 1024 * 1024},
+		}
+	})
+
+	It("should report capacity and allocatable resources", func(ctx SpecContext) {
+		createInstance(ctx, nil)
+
+		res, err := runtimeService.Status(ctx, &iri.StatusRequest{})
+		Expect(err).NotTo(HaveOccurred())
+
+		// The host has 2 cpus and 8Gi memory, of which 2Gi are reserved.
+		Expect(res.Capacity.CpuCount).To(BeEquivalentTo(2))
+		Expect(res.Capacity.MemoryBytes).To(BeEquivalentTo(6 * 1024 * 1024 * 1024))
+		Expect(res.Capacity.InstanceQuantities).To(Equal(map[string]int64{
+			"small": 2,
+			"large": 1,
+		}))
+
+		// The created instance consumes 1 cpu and 1Gi memory.
+		Expect(res.Allocatable.CpuCount).To(BeEquivalentTo(1))
+		Expect(res.Allocatable.MemoryBytes).To(BeEquivalentTo(5 * 1024 * 1024 * 1024))
+		Expect(res.Allocatable.InstanceQuantities).To(Equal(map[string]int64{
+			"small": 1,
+			"large": 0,
+		}))
+	})
+})
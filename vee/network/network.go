@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"context"
+
+	"spheric.cloud/spheric/vee/api"
+)
+
+// Plugin manages the host side of instance network interfaces.
+type Plugin interface {
+	// Apply ensures the host side of the given network interface exists.
+	// It returns the handle of the host interface that cloud-hypervisor should be attached to.
+	Apply(ctx context.Context, instanceID string, nic *api.NetworkInterface) (string, error)
+
+	// Delete removes the host side of the network interface with the given name, if any.
+	Delete(ctx context.Context, instanceID, name string) error
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// inNetNSEnv marks that the test binary runs inside its own user and network namespace.
+const inNetNSEnv = "VEE_NETWORK_TEST_IN_NETNS"
+
+func TestNetwork(t *testing.T) {
+	if os.Getenv(inNetNSEnv) == "" {
+		// Re-execute the tests in an unprivileged user and network namespace, so that
+		// links can be created without affecting (or requiring privileges on) the host.
+		if err := exec.Command("unshare", "--user", "--map-root-user", "--net", "true").Run(); err != nil {
+			t.Skipf("cannot create unprivileged network namespace: %v", err)
+		}
+
+		cmd := exec.Command("unshare", append([]string{"--user", "--map-root-user", "--net", os.Args[0]}, os.Args[1:]...)...)
+		cmd.Env = append(os.Environ(), inNetNSEnv+"=1")
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("error running tests in network namespace: %v", err)
+		}
+		return
+	}
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Network Suite")
+}
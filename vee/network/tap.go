@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"spheric.cloud/spheric/vee/api"
+)
+
+const (
+	// maxLinkNameLength is the maximum length of Linux network interface names.
+	maxLinkNameLength = 15
+
+	tapPrefix    = "vt"
+	bridgePrefix = "vb"
+
+	// defaultNetworkKey is used to determine the bridge of network interfaces without network metadata.
+	defaultNetworkKey = "default"
+)
+
+func hashedName(prefix string, parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		_, _ = h.Write([]byte(part))
+		_, _ = h.Write([]byte{0})
+	}
+	return prefix + hex.EncodeToString(h.Sum(nil))[:maxLinkNameLength-len(prefix)]
+}
+
+// TAPName returns the name of the TAP device of the given instance network interface.
+func TAPName(instanceID, name string) string {
+	return hashedName(tapPrefix, instanceID, name)
+}
+
+// BridgeName returns the name of the bridge for the network with the given UID.
+func BridgeName(networkUID string) string {
+	return hashedName(bridgePrefix, networkUID)
+}
+
+// MAC returns a stable, locally administered MAC address for the given instance network interface.
+func MAC(instanceID, name string) net.HardwareAddr {
+	sum := sha256.Sum256([]byte(instanceID + "/" + name))
+	mac := net.HardwareAddr(sum[:6])
+	// Set the locally administered bit and clear the multicast bit.
+	mac[0] = (mac[0] | 0x02) &^ 0x01
+	return mac
+}
+
+type TAPOptions struct {
+	// IPCommand is the iproute2 ip command to use. Defaults to "ip".
+	IPCommand string
+	// User is the id of the user owning created TAP devices. Defaults to the current user.
+	User *int
+}
+
+func (o *TAPOptions) defaults() {
+	if o.IPCommand == "" {
+		o.IPCommand = "ip"
+	}
+	if o.User == nil {
+		uid := os.Getuid()
+		o.User = &uid
+	}
+}
+
+// TAP is a Plugin creating a TAP device per network interface, connecting it
+// to a bridge per network UID.
+type TAP struct {
+	ipCommand string
+	user      int
+}
+
+func NewTAP(opts TAPOptions) *TAP {
+	opts.defaults()
+	return &TAP{
+		ipCommand: opts.IPCommand,
+		user:      *opts.User,
+	}
+}
+
+func (t *TAP) ip(ctx context.Context, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.ipCommand, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running %s %s: %w, output: %s",
+			t.ipCommand, strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func (t *TAP) linkExists(ctx context.Context, name string) (bool, error) {
+	out, err := t.ip(ctx, "-o", "link", "show")
+	if err != nil {
+		return false, err
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		// Lines have the format '<index>: <name>[@<parent>]: <flags> ...'
+		fields := strings.SplitN(line, ": ", 3)
+		if len(fields) < 2 {
+			continue
+		}
+		linkName, _, _ := strings.Cut(fields[1], "@")
+		if linkName == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// linkMaster returns the master of the given link, if any.
+func (t *TAP) linkMaster(ctx context.Context, name string) (string, error) {
+	out, err := t.ip(ctx, "-o", "link", "show", "dev", name)
+	if err != nil {
+		return "", err
+	}
+
+	fields := strings.Fields(string(out))
+	for i, field := range fields {
+		if field == "master" && i+1 < len(fields) {
+			return fields[i+1], nil
+		}
+	}
+	return "", nil
+}
+
+func (t *TAP) hasLinkMembers(ctx context.Context, bridge string) (bool, error) {
+	out, err := t.ip(ctx, "-o", "link", "show", "master", bridge)
+	if err != nil {
+		return false, err
+	}
+	return len(bytes.TrimSpace(out)) > 0, nil
+}
+
+func networkKey(nic *api.NetworkInterface) string {
+	if md := nic.SubnetMetadata; md != nil && md.NetworkUID != "" {
+		return md.NetworkUID
+	}
+	return defaultNetworkKey
+}
+
+func (t *TAP) ensureBridge(ctx context.Context, bridge string, subnetCIDRs []string) error {
+	exists, err := t.linkExists(ctx, bridge)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := t.ip(ctx, "link", "add", "name", bridge, "type", "bridge"); err != nil {
+			return err
+		}
+	}
+
+	for _, cidr := range subnetCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid subnet cidr %q: %w", cidr, err)
+		}
+
+		// The bridge acts as gateway and gets the first address of each subnet.
+		gateway := prefix.Masked().Addr().Next()
+		if _, err := t.ip(ctx, "addr", "replace", gateway.String()+"/"+strconv.Itoa(prefix.Bits()), "dev", bridge); err != nil {
+			return err
+		}
+	}
+
+	if _, err := t.ip(ctx, "link", "set", bridge, "up"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *TAP) Apply(ctx context.Context, instanceID string, nic *api.NetworkInterface) (string, error) {
+	bridge := BridgeName(networkKey(nic))
+	if err := t.ensureBridge(ctx, bridge, nic.SubnetCIDRs); err != nil {
+		return "", fmt.Errorf("error ensuring bridge %s: %w", bridge, err)
+	}
+
+	tap := TAPName(instanceID, nic.Name)
+	exists, err := t.linkExists(ctx, tap)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		if _, err := t.ip(ctx, "tuntap", "add", "dev", tap, "mode", "tap", "user", strconv.Itoa(t.user)); err != nil {
+			return "", fmt.Errorf("error creating tap %s: %w", tap, err)
+		}
+	}
+
+	if _, err := t.ip(ctx, "link", "set", tap, "master", bridge, "up"); err != nil {
+		return "", fmt.Errorf("error attaching tap %s to bridge %s: %w", tap, bridge, err)
+	}
+	return tap, nil
+}
+
+func (t *TAP) Delete(ctx context.Context, instanceID, name string) error {
+	tap := TAPName(instanceID, name)
+	exists, err := t.linkExists(ctx, tap)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	bridge, err := t.linkMaster(ctx, tap)
+	if err != nil {
+		return err
+	}
+
+	if _, err := t.ip(ctx, "tuntap", "del", "dev", tap, "mode", "tap"); err != nil {
+		return fmt.Errorf("error deleting tap %s: %w", tap, err)
+	}
+
+	if bridge == "" || !strings.HasPrefix(bridge, bridgePrefix) {
+		return nil
+	}
+
+	// Remove the bridge once its last tap is gone.
+	hasMembers, err := t.hasLinkMembers(ctx, bridge)
+	if err != nil {
+		return err
+	}
+	if !hasMembers {
+		if _, err := t.ip(ctx, "link", "del", "dev", bridge); err != nil {
+			return fmt.Errorf("error deleting bridge %s: %w", bridge, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package network_test
+
+import (
+	"context"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"spheric.cloud/spheric/vee/api"
+	. "spheric.cloud/spheric/vee/network"
+)
+
+func ipOutput(ctx context.Context, args ...string) string {
+	GinkgoHelper()
+	out, err := exec.CommandContext(ctx, "ip", args...).CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), "output: %s", string(out))
+	return string(out)
+}
+
+func linkExists(ctx context.Context, name string) bool {
+	return exec.CommandContext(ctx, "ip", "link", "show", "dev", name).Run() == nil
+}
+
+var _ = Describe("TAP", func() {
+	var plugin *TAP
+
+	BeforeEach(func() {
+		plugin = NewTAP(TAPOptions{})
+	})
+
+	It("should generate valid link names and mac addresses", func() {
+		Expect(TAPName("some-instance", "nic")).To(HaveLen(15))
+		Expect(BridgeName("some-network")).To(HaveLen(15))
+		Expect(TAPName("some-instance", "nic")).NotTo(Equal(TAPName("some-instance", "other-nic")))
+
+		mac := MAC("some-instance", "nic")
+		Expect(mac).To(HaveLen(6))
+		Expect(mac[0]&0x02).To(Equal(byte(0x02)), "locally administered bit should be set")
+		Expect(mac[0]&0x01).To(Equal(byte(0x00)), "multicast bit should be cleared")
+	})
+
+	It("should create and delete taps and bridges", func(ctx SpecContext) {
+		nic := &api.NetworkInterface{
+			Name: "nic",
+			SubnetMetadata: &api.NetworkInterfaceSubnetMetadata{
+				NetworkUID: "network-uid",
+			},
+			IPs:         []string{"10.0.0.10"},
+			SubnetCIDRs: []string{"10.0.0.0/24"},
+		}
+
+		By("applying the network interface")
+		handle, err := plugin.Apply(ctx, "instance-1", nic)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handle).To(Equal(TAPName("instance-1", "nic")))
+
+		bridge := BridgeName("network-uid")
+		Expect(ipOutput(ctx, "-o", "link", "show", "dev", handle)).To(ContainSubstring("master " + bridge))
+		Expect(ipOutput(ctx, "-o", "addr", "show", "dev", bridge)).To(ContainSubstring("10.0.0.1/24"))
+
+		By("applying the network interface again")
+		Expect(plugin.Apply(ctx, "instance-1", nic)).To(Equal(handle))
+
+		By("applying a second network interface in the same network")
+		otherHandle, err := plugin.Apply(ctx, "instance-2", nic)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipOutput(ctx, "-o", "link", "show", "dev", otherHandle)).To(ContainSubstring("master " + bridge))
+
+		By("deleting the first network interface")
+		Expect(plugin.Delete(ctx, "instance-1", "nic")).To(Succeed())
+		Expect(linkExists(ctx, handle)).To(BeFalse())
+		Expect(linkExists(ctx, bridge)).To(BeTrue(), "bridge should be kept while it has members")
+
+		By("deleting the second network interface")
+		Expect(plugin.Delete(ctx, "instance-2", "nic")).To(Succeed())
+		Expect(linkExists(ctx, otherHandle)).To(BeFalse())
+		Expect(linkExists(ctx, bridge)).To(BeFalse(), "bridge should be removed with its last member")
+
+		By("deleting an already deleted network interface")
+		Expect(plugin.Delete(ctx, "instance-2", "nic")).To(Succeed())
+	})
+
+	It("should use a default bridge for network interfaces without network metadata", func(ctx SpecContext) {
+		handle, err := plugin.Apply(ctx, "instance", &api.NetworkInterface{Name: "nic"})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(plugin.Delete, "instance", "nic")
+
+		Expect(ipOutput(ctx, "-o", "link", "show", "dev", handle)).To(ContainSubstring("master " + BridgeName("default")))
+	})
+
+	It("should reject invalid subnet cidrs", func(ctx SpecContext) {
+		_, err := plugin.Apply(ctx, "instance", &api.NetworkInterface{
+			Name:        "nic",
+			SubnetCIDRs: []string{"invalid"},
+		})
+		Expect(err).To(MatchError(ContainSubstring("invalid subnet cidr")))
+	})
+})
@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package streaming implements the server handing out and serving the streaming urls of vee,
+// e.g. for attaching to the serial console of an instance via exec or forwarding its ports.
+package streaming
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/remotecommand"
+	ctrl "sigs.k8s.io/controller-runtime"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	sriportforward "spheric.cloud/spheric/spherelet/sri/streaming/portforward"
+	sriremotecommand "spheric.cloud/spheric/spherelet/sri/streaming/remotecommand"
+)
+
+const (
+	DefaultAddress  = "127.0.0.1:20251"
+	DefaultTokenTTL = 1 * time.Minute
+
+	execPath        = "/exec/"
+	portForwardPath = "/portforward/"
+)
+
+type Options struct {
+	// Address is the address the server listens on. Defaults to DefaultAddress.
+	Address string
+	// BaseURL is the url streaming urls are handed out relative to.
+	// Defaults to http://<Address>.
+	BaseURL *url.URL
+	// TokenTTL is how long a handed out streaming url stays valid if unused.
+	// Defaults to DefaultTokenTTL.
+	TokenTTL time.Duration
+	// GuestAddress returns the address forwarded ports of an instance are dialed at.
+	// If unset, port forwarding is rejected as unimplemented.
+	GuestAddress func(ctx context.Context, instanceID string) (string, error)
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.Address == "" {
+		o.Address = DefaultAddress
+	}
+	if o.BaseURL == nil {
+		o.BaseURL = &url.URL{Scheme: "http", Host: o.Address}
+	}
+	if o.TokenTTL == 0 {
+		o.TokenTTL = DefaultTokenTTL
+	}
+}
+
+// Console attaches streams to the serial consoles of instances.
+type Console interface {
+	Attach(ctx context.Context, instanceID string, in io.Reader, out io.Writer) error
+}
+
+// streamRequest is a handed out streaming url.
+type streamRequest struct {
+	// path is the path the url was handed out for, e.g. execPath.
+	path       string
+	instanceID string
+	expiresAt  time.Time
+}
+
+// Server hands out single-use streaming urls and serves the serial console or
+// the forwarded ports of the requested instance on them.
+type Server struct {
+	address      string
+	baseURL      *url.URL
+	tokenTTL     time.Duration
+	console      Console
+	guestAddress func(ctx context.Context, instanceID string) (string, error)
+	exec         *sriremotecommand.ExecHandler
+	portForward  *sriportforward.PortForwardHandler
+	server       *http.Server
+
+	mu       sync.Mutex
+	requests map[string]streamRequest
+}
+
+// New creates a new Server attaching exec sessions to the given console.
+func New(console Console, opts Options) (*Server, error) {
+	setOptionsDefaults(&opts)
+
+	s := &Server{
+		address:      opts.Address,
+		baseURL:      opts.BaseURL,
+		tokenTTL:     opts.TokenTTL,
+		console:      console,
+		guestAddress: opts.GuestAddress,
+		requests:     make(map[string]streamRequest),
+	}
+
+	exec, err := sriremotecommand.NewExecHandler(sriremotecommand.ExecFunc(s.execSerial), sriremotecommand.ExecHandlerOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating exec handler: %w", err)
+	}
+	s.exec = exec
+
+	portForward, err := sriportforward.NewPortForwardHandler(sriportforward.PortForwarderFunc(s.portForwardGuest), sriportforward.PortForwardHandlerOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error creating port forward handler: %w", err)
+	}
+	s.portForward = portForward
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(execPath+"{token}", s.serveExec)
+	mux.HandleFunc(portForwardPath+"{token}", s.servePortForward)
+	s.server = &http.Server{Handler: mux}
+	return s, nil
+}
+
+func generateToken() (string, error) {
+	data := make([]byte, 24)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// GetExec returns the url to stream the serial console of the requested instance from.
+func (s *Server) GetExec(req *iri.ExecRequest) (*iri.ExecResponse, error) {
+	u, err := s.newStreamURL(execPath, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &iri.ExecResponse{Url: u}, nil
+}
+
+// GetPortForward returns the url to forward the ports of the requested instance via.
+func (s *Server) GetPortForward(req *iri.PortForwardRequest) (*iri.PortForwardResponse, error) {
+	if s.guestAddress == nil {
+		return nil, status.Error(codes.Unimplemented, "port forward is not supported")
+	}
+
+	u, err := s.newStreamURL(portForwardPath, req.InstanceId)
+	if err != nil {
+		return nil, err
+	}
+	return &iri.PortForwardResponse{Url: u}, nil
+}
+
+// newStreamURL hands out a new single-use url at the given path for the given instance.
+func (s *Server) newStreamURL(urlPath, instanceID string) (string, error) {
+	token, err := generateToken()
+	if err != nil {
+		return "", fmt.Errorf("error generating token: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for token, req := range s.requests {
+		if now.After(req.expiresAt) {
+			delete(s.requests, token)
+		}
+	}
+	s.requests[token] = streamRequest{
+		path:       urlPath,
+		instanceID: instanceID,
+		expiresAt:  now.Add(s.tokenTTL),
+	}
+
+	return s.baseURL.JoinPath(path.Join(urlPath, token)).String(), nil
+}
+
+// consumeRequest returns the request for the given path and token and invalidates the token.
+func (s *Server) consumeRequest(urlPath, token string) (streamRequest, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req, ok := s.requests[token]
+	if !ok || req.path != urlPath {
+		return streamRequest{}, false
+	}
+	delete(s.requests, token)
+	if time.Now().After(req.expiresAt) {
+		return streamRequest{}, false
+	}
+	return req, true
+}
+
+type instanceIDKey struct{}
+
+// consumeRequestInto consumes the request for the token of the given http request and
+// returns the http request with the instance of the consumed request in its context.
+func (s *Server) consumeRequestInto(w http.ResponseWriter, req *http.Request, urlPath string) (*http.Request, bool) {
+	streamReq, ok := s.consumeRequest(urlPath, req.PathValue("token"))
+	if !ok {
+		http.Error(w, "unknown or expired token", http.StatusNotFound)
+		return nil, false
+	}
+
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx).WithValues("InstanceID", streamReq.instanceID)
+	ctx = ctrl.LoggerInto(ctx, log)
+	ctx = context.WithValue(ctx, instanceIDKey{}, streamReq.instanceID)
+	return req.WithContext(ctx), true
+}
+
+func (s *Server) serveExec(w http.ResponseWriter, req *http.Request) {
+	req, ok := s.consumeRequestInto(w, req, execPath)
+	if !ok {
+		return
+	}
+
+	s.exec.Handle(w, req, sriremotecommand.ExecOptions{})
+}
+
+func (s *Server) servePortForward(w http.ResponseWriter, req *http.Request) {
+	req, ok := s.consumeRequestInto(w, req, portForwardPath)
+	if !ok {
+		return
+	}
+
+	s.portForward.Handle(w, req)
+}
+
+// execSerial connects the streams to the serial console of the instance in the context.
+func (s *Server) execSerial(ctx context.Context, in io.Reader, out io.WriteCloser, resize remotecommand.TerminalSizeQueue) error {
+	log := ctrl.LoggerFrom(ctx)
+	instanceID := ctx.Value(instanceIDKey{}).(string)
+
+	// A serial console has no out-of-band channel to propagate the terminal size to the guest.
+	// Drain the resize queue so the client is not blocked on it.
+	if resize != nil {
+		go func() {
+			for size := resize.Next(); size != nil; size = resize.Next() {
+				log.V(2).Info("Ignoring terminal resize", "Width", size.Width, "Height", size.Height)
+			}
+		}()
+	}
+
+	return s.console.Attach(ctx, instanceID, in, out)
+}
+
+// portForwardGuest connects the stream to the given port of the instance in the context.
+func (s *Server) portForwardGuest(ctx context.Context, port int32, stream io.ReadWriteCloser) error {
+	instanceID := ctx.Value(instanceIDKey{}).(string)
+
+	host, err := s.guestAddress(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting guest address: %w", err)
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(port))))
+	if err != nil {
+		return fmt.Errorf("error connecting to guest port: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	done := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(conn, stream)
+		done <- err
+	}()
+	go func() {
+		_, err := io.Copy(stream, conn)
+		done <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-done:
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// ListenAndServe serves streaming requests until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	l, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", s.address, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = s.server.Shutdown(shutdownCtx)
+	}()
+
+	log.Info("Starting streaming server", "Address", l.Addr().String())
+	s.server.BaseContext = func(net.Listener) context.Context { return ctx }
+	if err := s.server.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP serves streaming requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.server.Handler.ServeHTTP(w, req)
+}
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package streaming_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	pollingInterval   = 50 * time.Millisecond
+	eventuallyTimeout = 3 * time.Second
+)
+
+func TestStreaming(t *testing.T) {
+	SetDefaultEventuallyPollingInterval(pollingInterval)
+	SetDefaultEventuallyTimeout(eventuallyTimeout)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Streaming Suite")
+}
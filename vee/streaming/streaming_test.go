@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package streaming_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	iri "spheric.cloud/spheric/iri-api/apis/runtime/v1alpha1"
+	. "spheric.cloud/spheric/utils/testing"
+	"spheric.cloud/spheric/vee/console"
+	. "spheric.cloud/spheric/vee/streaming"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		srv      *Server
+		httpSrv  *httptest.Server
+		consoles *console.Manager
+		opts     Options
+	)
+
+	BeforeEach(func() {
+		opts = Options{}
+	})
+
+	JustBeforeEach(func() {
+		dir := ShortSocketDir(GinkgoT())
+
+		serialSocket := func(instanceID string) string {
+			return filepath.Join(dir, instanceID+".sock")
+		}
+
+		By("serving an echoing serial console for instance foo")
+		l, err := net.Listen("unix", serialSocket("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(l.Close)
+		go func() {
+			for {
+				conn, err := l.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer func() { _ = conn.Close() }()
+					_, _ = io.Copy(conn, conn)
+				}()
+			}
+		}()
+
+		httpSrv = httptest.NewUnstartedServer(nil)
+		baseURL, err := url.Parse("http://" + httpSrv.Listener.Addr().String())
+		Expect(err).NotTo(HaveOccurred())
+		opts.BaseURL = baseURL
+
+		consoles = console.NewManager(filepath.Join(dir, "consoles"), serialSocket, console.Options{})
+		DeferCleanup(consoles.Remove, "foo")
+
+		srv, err = New(consoles, opts)
+		Expect(err).NotTo(HaveOccurred())
+		httpSrv.Config.Handler = srv
+		httpSrv.Start()
+		DeferCleanup(httpSrv.Close)
+	})
+
+	stream := func(ctx context.Context, execURL string, stdin io.Reader, stdout io.Writer) error {
+		u, err := url.Parse(execURL)
+		Expect(err).NotTo(HaveOccurred())
+
+		exec, err := remotecommand.NewSPDYExecutor(&rest.Config{}, http.MethodGet, u)
+		Expect(err).NotTo(HaveOccurred())
+
+		return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdin:  stdin,
+			Stdout: stdout,
+			Tty:    true,
+		})
+	}
+
+	It("should stream the serial console of the instance", func(ctx SpecContext) {
+		res, err := srv.GetExec(&iri.ExecRequest{InstanceId: "foo"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Url).To(HavePrefix(httpSrv.URL + "/exec/"))
+
+		stdinReader, stdinWriter := io.Pipe()
+		DeferCleanup(stdinWriter.Close)
+		stdout := gbytes.NewBuffer()
+
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			defer GinkgoRecover()
+			_ = stream(streamCtx, res.Url, stdinReader, stdout)
+		}()
+
+		_, err = stdinWriter.Write([]byte("hello"))
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(stdout).Should(gbytes.Say("hello"))
+
+		By("inspecting the recorded console log")
+		Eventually(func() (string, error) {
+			var log strings.Builder
+			err := consoles.ReadLog(ctx, "foo", console.LogOptions{}, &log)
+			return log.String(), err
+		}).Should(Equal("hello"))
+	})
+
+	It("should only allow using an exec url once", func(ctx SpecContext) {
+		res, err := srv.GetExec(&iri.ExecRequest{InstanceId: "foo"})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("using the url without upgrading the connection")
+		httpRes, err := http.Get(res.Url)
+		Expect(err).NotTo(HaveOccurred())
+		_ = httpRes.Body.Close()
+		Expect(httpRes.StatusCode).NotTo(Equal(http.StatusNotFound))
+
+		By("using the url again")
+		httpRes, err = http.Get(res.Url)
+		Expect(err).NotTo(HaveOccurred())
+		_ = httpRes.Body.Close()
+		Expect(httpRes.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("should not allow using an exec url for port forwarding", func(ctx SpecContext) {
+		res, err := srv.GetExec(&iri.ExecRequest{InstanceId: "foo"})
+		Expect(err).NotTo(HaveOccurred())
+
+		httpRes, err := http.Get(strings.Replace(res.Url, "/exec/", "/portforward/", 1))
+		Expect(err).NotTo(HaveOccurred())
+		_ = httpRes.Body.Close()
+		Expect(httpRes.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("should reject port forwarding if the guest address is unknown", func() {
+		_, err := srv.GetPortForward(&iri.PortForwardRequest{InstanceId: "foo"})
+		Expect(status.Code(err)).To(Equal(codes.Unimplemented))
+	})
+
+	Context("with a guest address", func() {
+		var guestPort int
+
+		BeforeEach(func() {
+			By("serving an echoing guest port")
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(l.Close)
+			go func() {
+				for {
+					conn, err := l.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer func() { _ = conn.Close() }()
+						_, _ = io.Copy(conn, conn)
+					}()
+				}
+			}()
+			guestPort = l.Addr().(*net.TCPAddr).Port
+
+			opts.GuestAddress = func(ctx context.Context, instanceID string) (string, error) {
+				return "127.0.0.1", nil
+			}
+		})
+
+		It("should forward the ports of the instance", func(ctx SpecContext) {
+			res, err := srv.GetPortForward(&iri.PortForwardRequest{InstanceId: "foo", Port: []int32{int32(guestPort)}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Url).To(HavePrefix(httpSrv.URL + "/portforward/"))
+
+			u, err := url.Parse(res.Url)
+			Expect(err).NotTo(HaveOccurred())
+			transport, upgrader, err := spdy.RoundTripperFor(&rest.Config{})
+			Expect(err).NotTo(HaveOccurred())
+			dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodGet, u)
+
+			stopCh, readyCh := make(chan struct{}), make(chan struct{})
+			defer close(stopCh)
+			fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf(":%d", guestPort)},
+				stopCh, readyCh, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				defer GinkgoRecover()
+				_ = fw.ForwardPorts()
+			}()
+			Eventually(ctx, readyCh).Should(BeClosed())
+
+			ports, err := fw.GetPorts()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ports).To(HaveLen(1))
+
+			By("talking to the guest port via the forwarded local port")
+			conn, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", ports[0].Local))
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = conn.Close() }()
+
+			_, err = conn.Write([]byte("hello"))
+			Expect(err).NotTo(HaveOccurred())
+			buf := make([]byte, 5)
+			_, err = io.ReadFull(conn, buf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(buf)).To(Equal("hello"))
+		})
+	})
+
+	Context("with a short token ttl", func() {
+		BeforeEach(func() {
+			opts.TokenTTL = 10 * time.Millisecond
+		})
+
+		It("should reject expired exec urls", func(ctx SpecContext) {
+			res, err := srv.GetExec(&iri.ExecRequest{InstanceId: "foo"})
+			Expect(err).NotTo(HaveOccurred())
+
+			time.Sleep(20 * time.Millisecond)
+			Expect(stream(ctx, res.Url, gbytes.NewBuffer(), io.Discard)).NotTo(Succeed())
+		})
+	})
+})
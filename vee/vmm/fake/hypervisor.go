@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides an in-process hypervisor that serves the cloud-hypervisor REST API
+// over unix sockets and simulates vm state, so vee can be tested without KVM.
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	chypclient "spheric.cloud/spheric/cloud-hypervisor/client"
+	"spheric.cloud/spheric/vee/host"
+	"spheric.cloud/spheric/vee/vmm"
+)
+
+type instanceVMM struct {
+	vmm       *VMM
+	apiSocket string
+	server    *http.Server
+}
+
+// Hypervisor is a vmm.Hypervisor whose VMMs are served in-process.
+type Hypervisor struct {
+	dir string
+
+	mu     sync.Mutex
+	vmms   map[string]*instanceVMM
+	exited map[string]struct{}
+	usage  map[string]host.ProcessUsage
+
+	exits chan string
+}
+
+var _ vmm.Hypervisor = (*Hypervisor)(nil)
+
+// NewHypervisor creates a new Hypervisor that places the api sockets of its VMMs in dir.
+func NewHypervisor(dir string) *Hypervisor {
+	return &Hypervisor{
+		dir:    dir,
+		vmms:   make(map[string]*instanceVMM),
+		exited: make(map[string]struct{}),
+		usage:  make(map[string]host.ProcessUsage),
+		exits:  make(chan string, 100),
+	}
+}
+
+// Exits returns a channel that receives the ids of instances whose VMM exited without being stopped.
+// If the channel is not drained, exits are dropped.
+func (h *Hypervisor) Exits() <-chan string {
+	return h.exits
+}
+
+func (h *Hypervisor) Start(ctx context.Context, id string) (chypclient.Client, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.vmms[id]; ok {
+		return nil, fmt.Errorf("vmm for instance %q already running", id)
+	}
+	delete(h.exited, id)
+
+	apiSocket := filepath.Join(h.dir, id+".sock")
+	if err := os.Remove(apiSocket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	l, err := net.Listen("unix", apiSocket)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on api socket: %w", err)
+	}
+
+	v := NewVMM(func() { h.exit(id, false) })
+	srv := &http.Server{Handler: v.Handler()}
+	go func() { _ = srv.Serve(l) }()
+
+	h.vmms[id] = &instanceVMM{
+		vmm:       v,
+		apiSocket: apiSocket,
+		server:    srv,
+	}
+	return chypclient.Connect(apiSocket)
+}
+
+func (h *Hypervisor) Connect(id string) (chypclient.Client, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.exited[id]; ok {
+		delete(h.exited, id)
+		return nil, vmm.ErrExited
+	}
+
+	v, ok := h.vmms[id]
+	if !ok {
+		return nil, vmm.ErrNotFound
+	}
+	return chypclient.Connect(v.apiSocket)
+}
+
+func (h *Hypervisor) Stop(_ context.Context, id string) error {
+	h.exit(id, true)
+	return nil
+}
+
+func (h *Hypervisor) SerialSocket(id string) string {
+	return filepath.Join(h.dir, id+".serial.sock")
+}
+
+// SetUsage sets the process usage reported for the VMM of the instance with the given id.
+func (h *Hypervisor) SetUsage(id string, usage host.ProcessUsage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.usage[id] = usage
+}
+
+// Stats returns the usage set via SetUsage and the counters of the vm of the instance with the given id.
+func (h *Hypervisor) Stats(ctx context.Context, id string) (*vmm.Stats, error) {
+	h.mu.Lock()
+	v, ok := h.vmms[id]
+	usage := h.usage[id]
+	h.mu.Unlock()
+	if !ok {
+		return nil, vmm.ErrNotFound
+	}
+
+	cHyp, err := chypclient.Connect(v.apiSocket)
+	if err != nil {
+		return nil, err
+	}
+	counters, err := cHyp.GetVMCounters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vm counters: %w", err)
+	}
+
+	return &vmm.Stats{
+		ProcessUsage: usage,
+		Counters:     counters,
+	}, nil
+}
+
+// Crash simulates an unexpected exit of the VMM of the instance with the given id.
+func (h *Hypervisor) Crash(id string) {
+	h.exit(id, false)
+}
+
+func (h *Hypervisor) exit(id string, stopped bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if stopped {
+		delete(h.exited, id)
+	}
+
+	v, ok := h.vmms[id]
+	if !ok {
+		return
+	}
+
+	_ = v.server.Close()
+	v.vmm.Close()
+	_ = os.Remove(v.apiSocket)
+	delete(h.vmms, id)
+	if !stopped {
+		h.exited[id] = struct{}{}
+		select {
+		case h.exits <- id:
+		default:
+		}
+	}
+}
+
+// VMM returns the VMM of the instance with the given id, or nil if there is none.
+func (h *Hypervisor) VMM(id string) *VMM {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	v, ok := h.vmms[id]
+	if !ok {
+		return nil
+	}
+	return v.vmm
+}
+
+// Close stops all VMMs.
+func (h *Hypervisor) Close() {
+	h.mu.Lock()
+	ids := make([]string, 0, len(h.vmms))
+	for id := range h.vmms {
+		ids = append(ids, id)
+	}
+	h.mu.Unlock()
+
+	for _, id := range ids {
+		h.exit(id, true)
+	}
+}
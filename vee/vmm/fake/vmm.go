@@ -0,0 +1,441 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/utils/generic"
+)
+
+// VMM simulates the REST API of a cloud-hypervisor process and the state of its vm.
+// If the vm is created with a socket serial console, the console echoes everything written to it.
+type VMM struct {
+	mu         sync.Mutex
+	vm         *oapiclient.VmInfo
+	serial     net.Listener
+	nextDevice int
+	vmCounters oapiclient.VmCounters
+	reboots    int
+	onShutdown func()
+}
+
+// NewVMM creates a new VMM. onShutdown, if non-nil, is called when the VMM shuts down, either because
+// it was requested to or because the guest shut down after the power button was pressed.
+func NewVMM(onShutdown func()) *VMM {
+	return &VMM{onShutdown: onShutdown}
+}
+
+// VMInfo returns a copy of the information about the vm, or nil if no vm was created.
+func (v *VMM) VMInfo() *oapiclient.VmInfo {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		return nil
+	}
+
+	// Round-trip via json to get a deep copy.
+	data, _ := json.Marshal(v.vm)
+	vm := &oapiclient.VmInfo{}
+	_ = json.Unmarshal(data, vm)
+	return vm
+}
+
+// SetVMState sets the state of the vm, e.g. to simulate a shutdown initiated from within the guest.
+func (v *VMM) SetVMState(state oapiclient.VmInfoState) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm != nil {
+		v.vm.State = state
+	}
+}
+
+// SetCounters sets the device counters reported for the vm.
+func (v *VMM) SetCounters(counters oapiclient.VmCounters) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.vmCounters = counters
+}
+
+// Reboots returns how often the vm was reset via vm.reboot.
+func (v *VMM) Reboots() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.reboots
+}
+
+func (v *VMM) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/vmm.ping", v.ping)
+	mux.HandleFunc("PUT /api/v1/vmm.shutdown", v.shutdownVMM)
+	mux.HandleFunc("PUT /api/v1/vm.create", v.create)
+	mux.HandleFunc("PUT /api/v1/vm.delete", v.delete)
+	mux.HandleFunc("GET /api/v1/vm.info", v.info)
+	mux.HandleFunc("GET /api/v1/vm.counters", v.counters)
+	mux.HandleFunc("PUT /api/v1/vm.boot", v.transition(
+		[]oapiclient.VmInfoState{oapiclient.Created, oapiclient.Shutdown}, oapiclient.Running))
+	mux.HandleFunc("PUT /api/v1/vm.shutdown", v.transition(
+		[]oapiclient.VmInfoState{oapiclient.Running, oapiclient.Paused}, oapiclient.Shutdown))
+	mux.HandleFunc("PUT /api/v1/vm.reboot", v.reboot)
+	mux.HandleFunc("PUT /api/v1/vm.power-button", v.powerButton)
+	mux.HandleFunc("PUT /api/v1/vm.pause", v.transition(
+		[]oapiclient.VmInfoState{oapiclient.Running}, oapiclient.Paused))
+	mux.HandleFunc("PUT /api/v1/vm.resume", v.transition(
+		[]oapiclient.VmInfoState{oapiclient.Paused}, oapiclient.Running))
+	mux.HandleFunc("PUT /api/v1/vm.resize", v.resize)
+	mux.HandleFunc("PUT /api/v1/vm.add-disk", v.addDisk)
+	mux.HandleFunc("PUT /api/v1/vm.add-net", v.addNet)
+	mux.HandleFunc("PUT /api/v1/vm.add-device", v.addDevice)
+	mux.HandleFunc("PUT /api/v1/vm.remove-device", v.removeDevice)
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+func decodeJSON(w http.ResponseWriter, r *http.Request, into any) bool {
+	if err := json.NewDecoder(r.Body).Decode(into); err != nil {
+		http.Error(w, fmt.Sprintf("error decoding request: %v", err), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (v *VMM) ping(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, oapiclient.VmmPingResponse{
+		Version: "fake",
+		Pid:     generic.Pointer(int64(os.Getpid())),
+	})
+}
+
+func (v *VMM) shutdownVMM(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+	v.shutdown()
+}
+
+func (v *VMM) shutdown() {
+	if v.onShutdown != nil {
+		// Shut down asynchronously so the response can still be delivered.
+		go v.onShutdown()
+	}
+}
+
+func (v *VMM) create(w http.ResponseWriter, r *http.Request) {
+	config := oapiclient.VmConfig{}
+	if !decodeJSON(w, r, &config) {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm != nil {
+		http.Error(w, "vm already created", http.StatusInternalServerError)
+		return
+	}
+
+	if serial := config.Serial; serial != nil && serial.Mode == oapiclient.ConsoleConfigModeSocket {
+		l, err := net.Listen("unix", generic.DerefOrZero(serial.Socket))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error listening on serial socket: %v", err), http.StatusInternalServerError)
+			return
+		}
+		v.serial = l
+		go serveEcho(l)
+	}
+
+	v.vm = &oapiclient.VmInfo{
+		Config: config,
+		State:  oapiclient.Created,
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func serveEcho(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer func() { _ = conn.Close() }()
+			_, _ = io.Copy(conn, conn)
+		}()
+	}
+}
+
+func (v *VMM) closeSerial() {
+	if v.serial != nil {
+		_ = v.serial.Close()
+		v.serial = nil
+	}
+}
+
+// Close releases the resources of the VMM.
+func (v *VMM) Close() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.closeSerial()
+}
+
+func (v *VMM) delete(w http.ResponseWriter, _ *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.closeSerial()
+	v.vm = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *VMM) info(w http.ResponseWriter, _ *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, v.vm)
+}
+
+func (v *VMM) counters(w http.ResponseWriter, _ *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusNotFound)
+		return
+	}
+	counters := v.vmCounters
+	if counters == nil {
+		counters = oapiclient.VmCounters{}
+	}
+	writeJSON(w, counters)
+}
+
+// transition returns a handler that moves the vm into the target state if it is in one of the given states.
+func (v *VMM) transition(from []oapiclient.VmInfoState, to oapiclient.VmInfoState) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+
+		if v.vm == nil {
+			http.Error(w, "vm not created", http.StatusNotFound)
+			return
+		}
+		if !slices.Contains(from, v.vm.State) {
+			http.Error(w, fmt.Sprintf("invalid vm state %s", v.vm.State), http.StatusMethodNotAllowed)
+			return
+		}
+
+		v.vm.State = to
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// powerButton simulates a guest that shuts down once the power button is pressed.
+// Like cloud-hypervisor, the VMM exits once the guest has shut down.
+func (v *VMM) powerButton(w http.ResponseWriter, _ *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusNotFound)
+		return
+	}
+	if v.vm.State != oapiclient.Running {
+		http.Error(w, fmt.Sprintf("invalid vm state %s", v.vm.State), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	v.shutdown()
+}
+
+func (v *VMM) reboot(w http.ResponseWriter, _ *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusNotFound)
+		return
+	}
+	if v.vm.State != oapiclient.Running {
+		http.Error(w, fmt.Sprintf("invalid vm state %s", v.vm.State), http.StatusMethodNotAllowed)
+		return
+	}
+
+	v.reboots++
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (v *VMM) resize(w http.ResponseWriter, r *http.Request) {
+	req := oapiclient.VmResize{}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusNotFound)
+		return
+	}
+
+	// Like cloud-hypervisor, vcpus can be resized up to the maximum vcpus and memory within the
+	// hot-pluggable memory on top of the boot memory.
+	cpus := generic.DerefOrZero(v.vm.Config.Cpus)
+	memory := generic.DerefOrZero(v.vm.Config.Memory)
+	if req.DesiredVcpus != nil {
+		if *req.DesiredVcpus < 1 || *req.DesiredVcpus > cpus.MaxVcpus {
+			http.Error(w, fmt.Sprintf("invalid desired vcpus %d", *req.DesiredVcpus), http.StatusInternalServerError)
+			return
+		}
+		cpus.BootVcpus = *req.DesiredVcpus
+	}
+	if req.DesiredRam != nil {
+		hotplugged := *req.DesiredRam - memory.Size
+		if hotplugged < 0 || hotplugged > generic.DerefOrZero(memory.HotplugSize) {
+			http.Error(w, fmt.Sprintf("invalid desired ram %d", *req.DesiredRam), http.StatusInternalServerError)
+			return
+		}
+		memory.HotpluggedSize = generic.Pointer(hotplugged)
+	}
+
+	v.vm.Config.Cpus = &cpus
+	v.vm.Config.Memory = &memory
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// plug adds a device via the given add function, responding the way cloud-hypervisor does:
+// Devices added to a running vm are hot-plugged and reported, devices added to a vm that has not been
+// booted yet are cold-plugged without response content.
+func (v *VMM) plug(w http.ResponseWriter, id *string, prefix string, add func(id string)) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil {
+		http.Error(w, "vm not created", http.StatusInternalServerError)
+		return
+	}
+
+	v.nextDevice++
+	deviceID := fmt.Sprintf("_%s%d", prefix, v.nextDevice)
+	if id != nil && *id != "" {
+		deviceID = *id
+	}
+	if v.hasDevice(deviceID) {
+		http.Error(w, fmt.Sprintf("device %s already exists", deviceID), http.StatusInternalServerError)
+		return
+	}
+	add(deviceID)
+
+	if v.vm.State == oapiclient.Created {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, oapiclient.PciDeviceInfo{
+		Id:  deviceID,
+		Bdf: fmt.Sprintf("0000:00:%02x.0", v.nextDevice),
+	})
+}
+
+func (v *VMM) hasDevice(id string) bool {
+	for _, disk := range generic.DerefOrZero(v.vm.Config.Disks) {
+		if generic.DerefOrZero(disk.Id) == id {
+			return true
+		}
+	}
+	for _, net := range generic.DerefOrZero(v.vm.Config.Net) {
+		if generic.DerefOrZero(net.Id) == id {
+			return true
+		}
+	}
+	for _, device := range generic.DerefOrZero(v.vm.Config.Devices) {
+		if generic.DerefOrZero(device.Id) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *VMM) addDisk(w http.ResponseWriter, r *http.Request) {
+	disk := oapiclient.DiskConfig{}
+	if !decodeJSON(w, r, &disk) {
+		return
+	}
+
+	v.plug(w, disk.Id, "disk", func(id string) {
+		disk.Id = &id
+		v.vm.Config.Disks = generic.Pointer(append(generic.DerefOrZero(v.vm.Config.Disks), disk))
+	})
+}
+
+func (v *VMM) addNet(w http.ResponseWriter, r *http.Request) {
+	net := oapiclient.NetConfig{}
+	if !decodeJSON(w, r, &net) {
+		return
+	}
+
+	v.plug(w, net.Id, "net", func(id string) {
+		net.Id = &id
+		v.vm.Config.Net = generic.Pointer(append(generic.DerefOrZero(v.vm.Config.Net), net))
+	})
+}
+
+func (v *VMM) addDevice(w http.ResponseWriter, r *http.Request) {
+	device := oapiclient.DeviceConfig{}
+	if !decodeJSON(w, r, &device) {
+		return
+	}
+
+	v.plug(w, device.Id, "device", func(id string) {
+		device.Id = &id
+		v.vm.Config.Devices = generic.Pointer(append(generic.DerefOrZero(v.vm.Config.Devices), device))
+	})
+}
+
+func (v *VMM) removeDevice(w http.ResponseWriter, r *http.Request) {
+	req := oapiclient.VmRemoveDevice{}
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	id := generic.DerefOrZero(req.Id)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.vm == nil || !v.hasDevice(id) {
+		http.Error(w, fmt.Sprintf("device %s not found", id), http.StatusNotFound)
+		return
+	}
+
+	hasID := func(deviceID *string) bool { return generic.DerefOrZero(deviceID) == id }
+	if disks := v.vm.Config.Disks; disks != nil {
+		*disks = slices.DeleteFunc(*disks, func(disk oapiclient.DiskConfig) bool { return hasID(disk.Id) })
+	}
+	if nets := v.vm.Config.Net; nets != nil {
+		*nets = slices.DeleteFunc(*nets, func(net oapiclient.NetConfig) bool { return hasID(net.Id) })
+	}
+	if devices := v.vm.Config.Devices; devices != nil {
+		*devices = slices.DeleteFunc(*devices, func(device oapiclient.DeviceConfig) bool { return hasID(device.Id) })
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
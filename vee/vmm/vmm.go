@@ -0,0 +1,401 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package vmm manages the cloud-hypervisor processes (VMMs) backing vee instances.
+//
+// VMMs are started detached from vee, so they survive restarts of vee. The metadata of each VMM
+// is persisted next to its api socket, which allows re-adopting live VMMs and reaping dead ones
+// once vee starts again.
+package vmm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	cloudhypervisor "spheric.cloud/spheric/cloud-hypervisor"
+	chypclient "spheric.cloud/spheric/cloud-hypervisor/client"
+	oapiclient "spheric.cloud/spheric/cloud-hypervisor/oapi-client"
+	"spheric.cloud/spheric/vee/host"
+)
+
+var (
+	// ErrNotFound is returned if no VMM is known for an instance.
+	ErrNotFound = errors.New("vmm not found")
+	// ErrExited is returned if the VMM of an instance exited without being stopped.
+	ErrExited = errors.New("vmm exited")
+)
+
+const (
+	apiSocketName    = "api.sock"
+	serialSocketName = "serial.sock"
+	metadataFileName = "vmm.json"
+	logFileName      = "vmm.log"
+
+	stopTimeout      = 10 * time.Second
+	stopPollInterval = 100 * time.Millisecond
+)
+
+// Hypervisor manages the VMMs backing instances.
+type Hypervisor interface {
+	// Start starts a VMM for the instance with the given id.
+	Start(ctx context.Context, id string) (chypclient.Client, error)
+	// Connect returns a client for the VMM of the instance with the given id.
+	// If no VMM is known, ErrNotFound is returned. If the VMM exited without being stopped,
+	// ErrExited is returned once and the VMM is forgotten afterward.
+	Connect(id string) (chypclient.Client, error)
+	// Stop stops the VMM of the instance with the given id, if any.
+	Stop(ctx context.Context, id string) error
+	// SerialSocket returns the path of the socket the serial console of the instance's vm should be served at.
+	SerialSocket(id string) string
+	// Stats returns the resource usage of the VMM of the instance with the given id.
+	// Unlike Connect, exited VMMs are not reaped. If there is no live VMM, ErrNotFound is returned.
+	Stats(ctx context.Context, id string) (*Stats, error)
+}
+
+var _ Hypervisor = (*Manager)(nil)
+
+// Stats are the resource usage of a VMM and the device counters of its vm.
+type Stats struct {
+	host.ProcessUsage
+	// Counters are the counters of the vm's devices, keyed by device id.
+	Counters oapiclient.VmCounters
+}
+
+// Metadata is the persisted metadata of a VMM.
+type Metadata struct {
+	PID       int       `json:"pid"`
+	APISocket string    `json:"apiSocket"`
+	StartTime time.Time `json:"startTime"`
+}
+
+type Options struct {
+	// CloudHypervisor is used to start VMMs. Defaults to cloudhypervisor.Default.
+	CloudHypervisor *cloudhypervisor.CloudHypervisor
+	// ProcDir is the directory the proc filesystem is mounted at. Defaults to host.DefaultProcDir.
+	ProcDir string
+}
+
+func setOptionsDefaults(o *Options) {
+	if o.CloudHypervisor == nil {
+		o.CloudHypervisor = cloudhypervisor.Default
+	}
+	if o.ProcDir == "" {
+		o.ProcDir = host.DefaultProcDir
+	}
+}
+
+// Manager starts, adopts and stops VMMs. Each VMM gets its own directory below the
+// manager's directory, containing its api socket, metadata and log.
+type Manager struct {
+	dir             string
+	cloudHypervisor *cloudhypervisor.CloudHypervisor
+	procDir         string
+
+	mu       sync.Mutex
+	stopping map[string]struct{}
+
+	exits chan string
+}
+
+func NewManager(dir string, opts Options) *Manager {
+	setOptionsDefaults(&opts)
+
+	return &Manager{
+		dir:             dir,
+		cloudHypervisor: opts.CloudHypervisor,
+		procDir:         opts.ProcDir,
+		stopping:        make(map[string]struct{}),
+		exits:           make(chan string, 100),
+	}
+}
+
+// Exits returns a channel that receives the ids of instances whose VMM exited without being stopped.
+// Only VMMs started by this manager are reported, exits of adopted VMMs have to be detected via Connect.
+// If the channel is not drained, exits are dropped.
+func (m *Manager) Exits() <-chan string {
+	return m.exits
+}
+
+func (m *Manager) instanceDir(id string) string {
+	return filepath.Join(m.dir, id)
+}
+
+// APISocket returns the path of the api socket of the VMM of the instance with the given id.
+func (m *Manager) APISocket(id string) string {
+	return filepath.Join(m.instanceDir(id), apiSocketName)
+}
+
+// SerialSocket returns the path of the serial console socket of the VMM of the instance with the given id.
+func (m *Manager) SerialSocket(id string) string {
+	return filepath.Join(m.instanceDir(id), serialSocketName)
+}
+
+// LogFile returns the path of the log file of the VMM of the instance with the given id.
+func (m *Manager) LogFile(id string) string {
+	return filepath.Join(m.instanceDir(id), logFileName)
+}
+
+func (m *Manager) metadataFile(id string) string {
+	return filepath.Join(m.instanceDir(id), metadataFileName)
+}
+
+// Get returns the metadata of the VMM of the instance with the given id.
+func (m *Manager) Get(id string) (*Metadata, error) {
+	data, err := os.ReadFile(m.metadataFile(id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("error reading vmm metadata: %w", err)
+	}
+
+	md := &Metadata{}
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, fmt.Errorf("error decoding vmm metadata: %w", err)
+	}
+	return md, nil
+}
+
+func (m *Manager) writeMetadata(id string, md *Metadata) error {
+	data, err := json.Marshal(md)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so the metadata is never observed partially written.
+	tmpFile := m.metadataFile(id) + ".tmp"
+	if err := os.WriteFile(tmpFile, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, m.metadataFile(id))
+}
+
+// Alive reports whether the process described by the metadata is still running.
+// To guard against pid reuse, the command line of the process has to reference the api socket.
+func (m *Manager) Alive(md *Metadata) bool {
+	cmdline, err := os.ReadFile(filepath.Join(m.procDir, strconv.Itoa(md.PID), "cmdline"))
+	if err != nil {
+		return false
+	}
+
+	args := bytes.Split(bytes.TrimRight(cmdline, "\x00"), []byte{0})
+	return slices.ContainsFunc(args, func(arg []byte) bool {
+		return string(arg) == md.APISocket
+	})
+}
+
+// Start starts a VMM for the instance with the given id and persists its metadata.
+func (m *Manager) Start(ctx context.Context, id string) (chypclient.Client, error) {
+	if err := os.MkdirAll(m.instanceDir(id), 0755); err != nil {
+		return nil, fmt.Errorf("error creating vmm directory: %w", err)
+	}
+
+	apiSocket := m.APISocket(id)
+	if err := os.Remove(apiSocket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error removing stale api socket: %w", err)
+	}
+
+	p, err := m.cloudHypervisor.StartProcess(ctx, apiSocket, cloudhypervisor.StartOptions{
+		LogFile: m.LogFile(id),
+		Detach:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.writeMetadata(id, &Metadata{
+		PID:       p.PID,
+		APISocket: p.APISocket,
+		StartTime: p.StartTime,
+	}); err != nil {
+		_ = syscall.Kill(p.PID, syscall.SIGKILL)
+		return nil, fmt.Errorf("error writing vmm metadata: %w", err)
+	}
+
+	go m.watch(id, p)
+	return p.Client, nil
+}
+
+func (m *Manager) watch(id string, p *cloudhypervisor.Process) {
+	<-p.Done()
+
+	m.mu.Lock()
+	_, stopping := m.stopping[id]
+	m.mu.Unlock()
+	if stopping {
+		return
+	}
+
+	select {
+	case m.exits <- id:
+	default:
+	}
+}
+
+// Connect returns a client for the VMM of the instance with the given id.
+// If no VMM is known, ErrNotFound is returned. If the VMM exited, its leftovers
+// are reaped and ErrExited is returned.
+func (m *Manager) Connect(id string) (chypclient.Client, error) {
+	md, err := m.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Alive(md) {
+		if err := m.Reap(id); err != nil {
+			return nil, err
+		}
+		return nil, ErrExited
+	}
+	return chypclient.Connect(md.APISocket)
+}
+
+// Stats returns the resource usage of the VMM of the instance with the given id and the counters of its vm.
+func (m *Manager) Stats(ctx context.Context, id string) (*Stats, error) {
+	md, err := m.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Alive(md) {
+		return nil, ErrNotFound
+	}
+
+	usage, err := host.ReadProcessUsage(m.procDir, md.PID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading vmm process usage: %w", err)
+	}
+
+	cHyp, err := chypclient.Connect(md.APISocket)
+	if err != nil {
+		return nil, err
+	}
+	counters, err := cHyp.GetVMCounters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vm counters: %w", err)
+	}
+
+	return &Stats{
+		ProcessUsage: *usage,
+		Counters:     counters,
+	}, nil
+}
+
+// Stop shuts down the VMM of the instance with the given id, if any, and reaps its leftovers.
+// If the VMM does not shut down in time, it is killed.
+func (m *Manager) Stop(ctx context.Context, id string) error {
+	m.mu.Lock()
+	m.stopping[id] = struct{}{}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.stopping, id)
+		m.mu.Unlock()
+	}()
+
+	md, err := m.Get(id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if md != nil && m.Alive(md) {
+		if err := m.shutdown(ctx, md); err != nil {
+			return err
+		}
+	}
+	return m.Reap(id)
+}
+
+func (m *Manager) shutdown(ctx context.Context, md *Metadata) error {
+	if cHyp, err := chypclient.Connect(md.APISocket); err == nil {
+		_ = cHyp.ShutdownVMM(ctx)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, stopTimeout)
+	defer cancel()
+
+	t := time.NewTicker(stopPollInterval)
+	defer t.Stop()
+	for m.Alive(md) {
+		select {
+		case <-timeoutCtx.Done():
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := syscall.Kill(md.PID, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+				return fmt.Errorf("error killing vmm: %w", err)
+			}
+			return nil
+		case <-t.C:
+		}
+	}
+	return nil
+}
+
+// Reap removes the directory of the VMM of the instance with the given id.
+func (m *Manager) Reap(id string) error {
+	if err := os.RemoveAll(m.instanceDir(id)); err != nil {
+		return fmt.Errorf("error removing vmm directory: %w", err)
+	}
+	return nil
+}
+
+// Recover inspects the VMMs left behind by a previous run. VMMs of instances that are no longer known
+// are stopped. Live VMMs of known instances are adopted, dead ones are left in place so that Connect
+// reports their exit.
+func (m *Manager) Recover(ctx context.Context, log logr.Logger, known func(id string) bool) error {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("error reading vmm directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		id := entry.Name()
+		log := log.WithValues("InstanceID", id)
+
+		md, err := m.Get(id)
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				return err
+			}
+
+			log.V(1).Info("Reaping vmm without metadata")
+			if err := m.Reap(id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !known(id) {
+			log.Info("Stopping vmm of unknown instance", "PID", md.PID)
+			if err := m.Stop(ctx, id); err != nil {
+				return fmt.Errorf("[instance %s] error stopping vmm: %w", id, err)
+			}
+			continue
+		}
+
+		if !m.Alive(md) {
+			log.Info("Vmm exited while not being managed", "PID", md.PID)
+			continue
+		}
+
+		log.Info("Adopting vmm", "PID", md.PID, "StartTime", md.StartTime)
+	}
+	return nil
+}
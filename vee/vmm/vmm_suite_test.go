@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vmm_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCloudHypervisorEnv makes the test binary act as a minimal cloud-hypervisor.
+const fakeCloudHypervisorEnv = "VEE_VMM_TEST_FAKE_CLOUD_HYPERVISOR"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(fakeCloudHypervisorEnv) != "" {
+		if err := runFakeCloudHypervisor(); err != nil {
+			_, _ = os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runFakeCloudHypervisor serves the vmm ping and shutdown and the vm counters endpoints of cloud-hypervisor.
+func runFakeCloudHypervisor() error {
+	fs := flag.NewFlagSet("cloud-hypervisor", flag.ContinueOnError)
+	apiSocket := fs.String("api-socket", "", "")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		return err
+	}
+
+	l, err := net.Listen("unix", *apiSocket)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/vmm.ping", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"fake"}`))
+	})
+	mux.HandleFunc("GET /api/v1/vm.counters", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"disk-foo":{"read_bytes":512,"write_bytes":1024}}`))
+	})
+	mux.HandleFunc("PUT /api/v1/vmm.shutdown", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		cancel()
+	})
+
+	srv := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func TestVMM(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "VMM Suite")
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 Axel Christ and Spheric contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package vmm_test
+
+import (
+	"os"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cloudhypervisor "spheric.cloud/spheric/cloud-hypervisor"
+	. "spheric.cloud/spheric/utils/testing"
+	. "spheric.cloud/spheric/vee/vmm"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		dir     string
+		manager *Manager
+	)
+
+	BeforeEach(func() {
+		dir = ShortSocketDir(GinkgoT())
+
+		GinkgoT().Setenv(fakeCloudHypervisorEnv, "1")
+		manager = NewManager(dir, Options{
+			CloudHypervisor: cloudhypervisor.NewCloudHypervisor(os.Args[0]),
+		})
+	})
+
+	It("should start, connect to and stop a vmm", func(ctx SpecContext) {
+		By("starting the vmm")
+		_, err := manager.Start(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(manager.Stop, "foo")
+
+		By("inspecting the persisted metadata")
+		md, err := manager.Get("foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(md.APISocket).To(Equal(manager.APISocket("foo")))
+		Expect(md.StartTime).NotTo(BeZero())
+		Expect(manager.Alive(md)).To(BeTrue())
+
+		By("connecting to the vmm")
+		cHyp, err := manager.Connect("foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cHyp.PingVMM(ctx)).NotTo(BeNil())
+
+		By("stopping the vmm")
+		Expect(manager.Stop(ctx, "foo")).To(Succeed())
+		Expect(manager.Alive(md)).To(BeFalse())
+		_, err = manager.Get("foo")
+		Expect(err).To(MatchError(ErrNotFound))
+	})
+
+	It("should report and reap vmms that exited unexpectedly", func(ctx SpecContext) {
+		_, err := manager.Start(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(manager.Stop, "foo")
+
+		md, err := manager.Get("foo")
+		Expect(err).NotTo(HaveOccurred())
+
+		By("killing the vmm")
+		Expect(syscall.Kill(md.PID, syscall.SIGKILL)).To(Succeed())
+		Eventually(manager.Exits()).Should(Receive(Equal("foo")))
+
+		By("connecting to the exited vmm")
+		_, err = manager.Connect("foo")
+		Expect(err).To(MatchError(ErrExited))
+
+		By("asserting the vmm got reaped")
+		_, err = manager.Get("foo")
+		Expect(err).To(MatchError(ErrNotFound))
+	})
+
+	It("should report the stats of a vmm without reaping exited ones", func(ctx SpecContext) {
+		_, err := manager.Start(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(manager.Stop, "foo")
+
+		stats, err := manager.Stats(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.MemoryBytes).To(BeNumerically(">", 0))
+		Expect(stats.Counters).To(HaveKeyWithValue("disk-foo", map[string]int64{
+			"read_bytes":  512,
+			"write_bytes": 1024,
+		}))
+
+		By("killing the vmm")
+		md, err := manager.Get("foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syscall.Kill(md.PID, syscall.SIGKILL)).To(Succeed())
+		Eventually(manager.Exits()).Should(Receive(Equal("foo")))
+
+		_, err = manager.Stats(ctx, "foo")
+		Expect(err).To(MatchError(ErrNotFound))
+		_, err = manager.Connect("foo")
+		Expect(err).To(MatchError(ErrExited))
+	})
+
+	It("should adopt vmms of a previous manager and stop the ones of unknown instances", func(ctx SpecContext) {
+		_, err := manager.Start(ctx, "known")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(manager.Stop, "known")
+		_, err = manager.Start(ctx, "unknown")
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(manager.Stop, "unknown")
+
+		unknownMD, err := manager.Get("unknown")
+		Expect(err).NotTo(HaveOccurred())
+
+		By("recovering with a new manager")
+		manager = NewManager(dir, Options{})
+		Expect(manager.Recover(ctx, GinkgoLogr, func(id string) bool {
+			return id == "known"
+		})).To(Succeed())
+
+		By("asserting the known vmm got adopted")
+		cHyp, err := manager.Connect("known")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cHyp.PingVMM(ctx)).NotTo(BeNil())
+
+		By("asserting the unknown vmm got stopped")
+		Expect(manager.Alive(unknownMD)).To(BeFalse())
+		_, err = manager.Get("unknown")
+		Expect(err).To(MatchError(ErrNotFound))
+	})
+})